@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/spcameron/dugout/internal/adapters/postgres"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/usecase/readmodel"
+)
+
+var errRosterMismatch = errors.New("current rosters differ from the roster log")
+
+// checkRosters compares the current-roster read model with a replay of the
+// roster log and prints the teams that differ. A mismatch is repaired by
+// rebuilding the projection.
+func checkRosters(ctx context.Context, getenv func(string) string, out io.Writer) error {
+	cfg, err := loadConfig(getenv)
+	if err != nil {
+		return err
+	}
+
+	pool, err := pgxpool.New(ctx, cfg.db.dsn())
+	if err != nil {
+		return fmt.Errorf("opening database pool: %w", err)
+	}
+	defer pool.Close()
+
+	check := readmodel.NewRosterCheck(
+		postgres.NewRosterStore(pool),
+		postgres.NewProjectionCheckpoints(pool),
+		postgres.NewCurrentRosters(pool),
+		postgres.CurrentRostersProjection,
+	)
+
+	result, err := check.Run(ctx)
+	if err != nil {
+		return err
+	}
+
+	return reportRosterCheck(out, result)
+}
+
+// reportRosterCheck prints a line per differing player under each mismatched
+// team, then a summary, and returns errRosterMismatch if any team differs.
+func reportRosterCheck(out io.Writer, result readmodel.RosterCheckResult) error {
+	for _, m := range result.Mismatches {
+		fmt.Fprintf(out, "team %d:\n", m.TeamID)
+		for _, line := range rosterDifferences(m.Stored, m.Replayed) {
+			fmt.Fprintf(out, "  %s\n", line)
+		}
+	}
+
+	fmt.Fprintf(out, "checked %d teams through position %d: %d mismatched\n", result.Teams, result.Through, len(result.Mismatches))
+
+	if len(result.Mismatches) > 0 {
+		return fmt.Errorf("%w: %d teams", errRosterMismatch, len(result.Mismatches))
+	}
+
+	return nil
+}
+
+// rosterDifferences describes each player whose stored entry differs from the
+// replayed one, in replay order and then stored order. Rosters that hold the
+// same entries in a different order are reported as such.
+func rosterDifferences(stored, replayed []domain.RosterEntry) []string {
+	byPlayer := make(map[domain.PlayerID]domain.RosterEntry, len(stored))
+	for _, e := range stored {
+		byPlayer[e.PlayerID] = e
+	}
+
+	var lines []string
+	for _, want := range replayed {
+		got, ok := byPlayer[want.PlayerID]
+		delete(byPlayer, want.PlayerID)
+
+		switch {
+		case !ok:
+			lines = append(lines, fmt.Sprintf("player %d: missing, replay has %s", want.PlayerID, describeEntry(want)))
+		case got != want:
+			lines = append(lines, fmt.Sprintf("player %d: stored %s, replay has %s", want.PlayerID, describeEntry(got), describeEntry(want)))
+		}
+	}
+
+	for _, got := range stored {
+		_, ok := byPlayer[got.PlayerID]
+		if ok {
+			lines = append(lines, fmt.Sprintf("player %d: stored %s, not in replay", got.PlayerID, describeEntry(got)))
+		}
+	}
+
+	if len(lines) == 0 {
+		lines = append(lines, "roster order differs")
+	}
+
+	return lines
+}
+
+func describeEntry(e domain.RosterEntry) string {
+	return fmt.Sprintf("%s (%s)", e.RosterStatus.Code(), strings.Join(e.Eligibility.Codes(), "/"))
+}
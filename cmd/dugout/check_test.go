@@ -0,0 +1,72 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/usecase/readmodel"
+)
+
+func TestReportRosterCheck(t *testing.T) {
+	hitters := domain.MustRoleSet(domain.RoleHitter)
+	entry := func(player domain.PlayerID, status domain.RosterStatus) domain.RosterEntry {
+		return domain.RosterEntry{TeamID: 111, PlayerID: player, RosterStatus: status, Eligibility: hitters}
+	}
+
+	t.Run("reports a clean check", func(t *testing.T) {
+		var out strings.Builder
+
+		err := reportRosterCheck(&out, readmodel.RosterCheckResult{Through: 40, Teams: 3})
+
+		assert.NoError(t, err)
+		assert.Equal(t, out.String(), "checked 3 teams through position 40: 0 mismatched\n")
+	})
+
+	t.Run("describes each differing player", func(t *testing.T) {
+		var out strings.Builder
+
+		err := reportRosterCheck(&out, readmodel.RosterCheckResult{
+			Through: 40,
+			Teams:   3,
+			Mismatches: []readmodel.RosterMismatch{{
+				TeamID:   111,
+				Stored:   []domain.RosterEntry{entry(1, domain.StatusInactive), entry(2, domain.StatusInactive), entry(4, domain.StatusInactive)},
+				Replayed: []domain.RosterEntry{entry(1, domain.StatusInactive), entry(2, domain.StatusActiveHitter), entry(3, domain.StatusInactive)},
+			}},
+		})
+
+		assert.ErrorIs(t, err, errRosterMismatch)
+		assert.Equal(t, out.String(), strings.Join([]string{
+			"team 111:",
+			"  player 2: stored inactive (hitter), replay has active_hitter (hitter)",
+			"  player 3: missing, replay has inactive (hitter)",
+			"  player 4: stored inactive (hitter), not in replay",
+			"checked 3 teams through position 40: 1 mismatched",
+			"",
+		}, "\n"))
+	})
+
+	t.Run("reports rosters that differ only in order", func(t *testing.T) {
+		var out strings.Builder
+
+		err := reportRosterCheck(&out, readmodel.RosterCheckResult{
+			Teams: 1,
+			Mismatches: []readmodel.RosterMismatch{{
+				TeamID:   111,
+				Stored:   []domain.RosterEntry{entry(2, domain.StatusInactive), entry(1, domain.StatusInactive)},
+				Replayed: []domain.RosterEntry{entry(1, domain.StatusInactive), entry(2, domain.StatusInactive)},
+			}},
+		})
+
+		assert.ErrorIs(t, err, errRosterMismatch)
+		assert.Contains(t, out.String(), "  roster order differs\n")
+	})
+}
+
+func TestDispatch(t *testing.T) {
+	err := dispatch(t.Context(), []string{"frobnicate"}, func(string) string { return "" }, nil, nil)
+
+	assert.ErrorIs(t, err, errUnknownCommand)
+}
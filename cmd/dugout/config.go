@@ -0,0 +1,228 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultAddr                = ":4000"
+	defaultShutdownTimeout     = 10 * time.Second
+	defaultSessionTTL          = 14 * 24 * time.Hour
+	defaultOutboxInterval      = 5 * time.Second
+	defaultLiveInterval        = time.Second
+	defaultProjectionInterval  = 2 * time.Second
+	defaultTradeReviewInterval = time.Minute
+)
+
+// Trace exporters OTEL_TRACES_EXPORTER may name. The OTLP exporter reads its
+// endpoint and headers from the standard OTEL_EXPORTER_OTLP_* variables.
+const (
+	tracesNone   = "none"
+	tracesStdout = "stdout"
+	tracesOTLP   = "otlp"
+)
+
+var (
+	errMissingEnv      = errors.New("required environment variable is not set")
+	errNotPositive     = errors.New("must be positive")
+	errUnknownExporter = errors.New("unknown trace exporter")
+)
+
+type dbConfig struct {
+	host    string
+	port    string
+	name    string
+	user    string
+	sslMode string
+}
+
+// dsn connects as the application role. Passwords come from ~/.pgpass, as they
+// do for the Makefile's psql targets.
+func (c dbConfig) dsn() string {
+	return fmt.Sprintf(
+		"host=%s port=%s dbname=%s user=%s sslmode=%s",
+		c.host, c.port, c.name, c.user, c.sslMode,
+	)
+}
+
+// smtpConfig is the relay notifications are mailed through. A zero addr means
+// email is not sent.
+type smtpConfig struct {
+	addr     string
+	from     mail.Address
+	username string
+	password string
+}
+
+// auth is nil for relays that take mail without credentials.
+func (c smtpConfig) auth() smtp.Auth {
+	if c.username == "" {
+		return nil
+	}
+
+	host, _, _ := net.SplitHostPort(c.addr)
+
+	return smtp.PlainAuth("", c.username, c.password, host)
+}
+
+type config struct {
+	addr                string
+	shutdownTimeout     time.Duration
+	sessionTTL          time.Duration
+	secureCookies       bool
+	tracesExporter      string
+	outboxInterval      time.Duration
+	liveInterval        time.Duration
+	projectionInterval  time.Duration
+	tradeReviewInterval time.Duration
+	smtp                smtpConfig
+	db                  dbConfig
+}
+
+// loadConfig reads the variables the Makefile exports from .env. The server and
+// session settings are optional; the database settings are not. Cookies are
+// Secure unless COOKIE_SECURE is false, traces are not exported unless
+// OTEL_TRACES_EXPORTER names an exporter, and notifications are not emailed
+// unless SMTP_ADDR names a relay.
+func loadConfig(getenv func(string) string) (config, error) {
+	cfg := config{
+		addr:                defaultAddr,
+		shutdownTimeout:     defaultShutdownTimeout,
+		sessionTTL:          defaultSessionTTL,
+		secureCookies:       true,
+		tracesExporter:      tracesNone,
+		outboxInterval:      defaultOutboxInterval,
+		liveInterval:        defaultLiveInterval,
+		projectionInterval:  defaultProjectionInterval,
+		tradeReviewInterval: defaultTradeReviewInterval,
+	}
+
+	if addr := getenv("HTTP_ADDR"); addr != "" {
+		cfg.addr = addr
+	}
+
+	if raw := getenv("SHUTDOWN_TIMEOUT"); raw != "" {
+		timeout, err := time.ParseDuration(raw)
+		if err != nil {
+			return config{}, fmt.Errorf("SHUTDOWN_TIMEOUT: %w", err)
+		}
+		cfg.shutdownTimeout = timeout
+	}
+
+	if raw := getenv("SESSION_TTL"); raw != "" {
+		ttl, err := time.ParseDuration(raw)
+		if err != nil {
+			return config{}, fmt.Errorf("SESSION_TTL: %w", err)
+		}
+		cfg.sessionTTL = ttl
+	}
+
+	if raw := getenv("COOKIE_SECURE"); raw != "" {
+		secure, err := strconv.ParseBool(raw)
+		if err != nil {
+			return config{}, fmt.Errorf("COOKIE_SECURE: %w", err)
+		}
+		cfg.secureCookies = secure
+	}
+
+	if exporter := getenv("OTEL_TRACES_EXPORTER"); exporter != "" {
+		switch exporter {
+		case tracesNone, tracesStdout, tracesOTLP:
+			cfg.tracesExporter = exporter
+		default:
+			return config{}, fmt.Errorf("OTEL_TRACES_EXPORTER: %w: %q", errUnknownExporter, exporter)
+		}
+	}
+
+	if raw := getenv("OUTBOX_INTERVAL"); raw != "" {
+		interval, err := time.ParseDuration(raw)
+		if err != nil {
+			return config{}, fmt.Errorf("OUTBOX_INTERVAL: %w", err)
+		}
+		if interval <= 0 {
+			return config{}, fmt.Errorf("OUTBOX_INTERVAL: %w: %s", errNotPositive, raw)
+		}
+		cfg.outboxInterval = interval
+	}
+
+	if raw := getenv("LIVE_INTERVAL"); raw != "" {
+		interval, err := time.ParseDuration(raw)
+		if err != nil {
+			return config{}, fmt.Errorf("LIVE_INTERVAL: %w", err)
+		}
+		if interval <= 0 {
+			return config{}, fmt.Errorf("LIVE_INTERVAL: %w: %s", errNotPositive, raw)
+		}
+		cfg.liveInterval = interval
+	}
+
+	if raw := getenv("PROJECTION_INTERVAL"); raw != "" {
+		interval, err := time.ParseDuration(raw)
+		if err != nil {
+			return config{}, fmt.Errorf("PROJECTION_INTERVAL: %w", err)
+		}
+		if interval <= 0 {
+			return config{}, fmt.Errorf("PROJECTION_INTERVAL: %w: %s", errNotPositive, raw)
+		}
+		cfg.projectionInterval = interval
+	}
+
+	if raw := getenv("TRADE_REVIEW_INTERVAL"); raw != "" {
+		interval, err := time.ParseDuration(raw)
+		if err != nil {
+			return config{}, fmt.Errorf("TRADE_REVIEW_INTERVAL: %w", err)
+		}
+		if interval <= 0 {
+			return config{}, fmt.Errorf("TRADE_REVIEW_INTERVAL: %w: %s", errNotPositive, raw)
+		}
+		cfg.tradeReviewInterval = interval
+	}
+
+	if addr := getenv("SMTP_ADDR"); addr != "" {
+		_, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			return config{}, fmt.Errorf("SMTP_ADDR: %w", err)
+		}
+
+		from, err := mail.ParseAddress(getenv("SMTP_FROM"))
+		if err != nil {
+			return config{}, fmt.Errorf("SMTP_FROM: %w", err)
+		}
+
+		cfg.smtp = smtpConfig{
+			addr:     addr,
+			from:     *from,
+			username: getenv("SMTP_USERNAME"),
+			password: getenv("SMTP_PASSWORD"),
+		}
+	}
+
+	var missing []error
+	require := func(name string) string {
+		v := getenv(name)
+		if v == "" {
+			missing = append(missing, fmt.Errorf("%w: %s", errMissingEnv, name))
+		}
+		return v
+	}
+
+	cfg.db = dbConfig{
+		host:    require("DB_HOST"),
+		port:    require("DB_PORT"),
+		name:    require("DB_NAME"),
+		user:    require("DB_USER_APP"),
+		sslMode: require("DB_SSLMODE"),
+	}
+
+	if len(missing) > 0 {
+		return config{}, errors.Join(missing...)
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+)
+
+func TestLoadConfig(t *testing.T) {
+	baseEnv := map[string]string{
+		"DB_HOST":     "localhost",
+		"DB_PORT":     "5432",
+		"DB_NAME":     "dugout_dev",
+		"DB_USER_APP": "dugout_app",
+		"DB_SSLMODE":  "disable",
+	}
+
+	getenv := func(overrides map[string]string) func(string) string {
+		return func(key string) string {
+			if v, ok := overrides[key]; ok {
+				return v
+			}
+			return baseEnv[key]
+		}
+	}
+
+	t.Run("applies defaults and connects as the app role", func(t *testing.T) {
+		cfg, err := loadConfig(getenv(nil))
+		require.NoError(t, err)
+
+		assert.Equal(t, cfg.addr, defaultAddr)
+		assert.Equal(t, cfg.shutdownTimeout, defaultShutdownTimeout)
+		assert.Equal(t, cfg.sessionTTL, defaultSessionTTL)
+		assert.True(t, cfg.secureCookies)
+		assert.Equal(t, cfg.tracesExporter, tracesNone)
+		assert.Equal(t, cfg.outboxInterval, defaultOutboxInterval)
+		assert.Equal(t, cfg.liveInterval, defaultLiveInterval)
+		assert.Equal(t, cfg.projectionInterval, defaultProjectionInterval)
+		assert.Equal(t, cfg.tradeReviewInterval, defaultTradeReviewInterval)
+		assert.Equal(t, cfg.smtp, smtpConfig{})
+		assert.Nil(t, cfg.smtp.auth())
+		assert.Equal(t, cfg.db.dsn(), "host=localhost port=5432 dbname=dugout_dev user=dugout_app sslmode=disable")
+	})
+
+	t.Run("reads optional server settings", func(t *testing.T) {
+		cfg, err := loadConfig(getenv(map[string]string{
+			"HTTP_ADDR":             "127.0.0.1:8080",
+			"SHUTDOWN_TIMEOUT":      "30s",
+			"SESSION_TTL":           "24h",
+			"COOKIE_SECURE":         "false",
+			"OTEL_TRACES_EXPORTER":  "otlp",
+			"OUTBOX_INTERVAL":       "1s",
+			"LIVE_INTERVAL":         "250ms",
+			"PROJECTION_INTERVAL":   "10s",
+			"TRADE_REVIEW_INTERVAL": "30s",
+		}))
+		require.NoError(t, err)
+
+		assert.Equal(t, cfg.addr, "127.0.0.1:8080")
+		assert.Equal(t, cfg.shutdownTimeout, 30*time.Second)
+		assert.Equal(t, cfg.sessionTTL, 24*time.Hour)
+		assert.False(t, cfg.secureCookies)
+		assert.Equal(t, cfg.tracesExporter, tracesOTLP)
+		assert.Equal(t, cfg.outboxInterval, time.Second)
+		assert.Equal(t, cfg.liveInterval, 250*time.Millisecond)
+		assert.Equal(t, cfg.projectionInterval, 10*time.Second)
+		assert.Equal(t, cfg.tradeReviewInterval, 30*time.Second)
+	})
+
+	t.Run("reads the SMTP relay", func(t *testing.T) {
+		cfg, err := loadConfig(getenv(map[string]string{
+			"SMTP_ADDR":     "mail.example.com:587",
+			"SMTP_FROM":     "Dugout <noreply@example.com>",
+			"SMTP_USERNAME": "dugout",
+			"SMTP_PASSWORD": "secret",
+		}))
+		require.NoError(t, err)
+
+		assert.Equal(t, cfg.smtp.addr, "mail.example.com:587")
+		assert.Equal(t, cfg.smtp.from.Address, "noreply@example.com")
+		assert.Equal(t, cfg.smtp.from.Name, "Dugout")
+		assert.NotNil(t, cfg.smtp.auth())
+	})
+
+	t.Run("rejects an SMTP relay without a sender", func(t *testing.T) {
+		_, err := loadConfig(getenv(map[string]string{"SMTP_ADDR": "mail.example.com:587"}))
+
+		assert.Contains(t, err.Error(), "SMTP_FROM")
+	})
+
+	t.Run("rejects an SMTP relay without a port", func(t *testing.T) {
+		_, err := loadConfig(getenv(map[string]string{"SMTP_ADDR": "mail.example.com", "SMTP_FROM": "noreply@example.com"}))
+
+		assert.Contains(t, err.Error(), "SMTP_ADDR")
+	})
+
+	t.Run("rejects an outbox interval that is not positive", func(t *testing.T) {
+		_, err := loadConfig(getenv(map[string]string{"OUTBOX_INTERVAL": "0s"}))
+
+		assert.ErrorIs(t, err, errNotPositive)
+		assert.Contains(t, err.Error(), "OUTBOX_INTERVAL")
+	})
+
+	t.Run("rejects a live interval that is not positive", func(t *testing.T) {
+		_, err := loadConfig(getenv(map[string]string{"LIVE_INTERVAL": "-1s"}))
+
+		assert.ErrorIs(t, err, errNotPositive)
+		assert.Contains(t, err.Error(), "LIVE_INTERVAL")
+	})
+
+	t.Run("rejects a trade review interval that is not positive", func(t *testing.T) {
+		_, err := loadConfig(getenv(map[string]string{"TRADE_REVIEW_INTERVAL": "0s"}))
+
+		assert.ErrorIs(t, err, errNotPositive)
+		assert.Contains(t, err.Error(), "TRADE_REVIEW_INTERVAL")
+	})
+
+	t.Run("names every missing database variable", func(t *testing.T) {
+		_, err := loadConfig(getenv(map[string]string{"DB_HOST": "", "DB_USER_APP": ""}))
+
+		assert.ErrorIs(t, err, errMissingEnv)
+		assert.Contains(t, err.Error(), "DB_HOST")
+		assert.Contains(t, err.Error(), "DB_USER_APP")
+	})
+
+	t.Run("rejects a malformed shutdown timeout", func(t *testing.T) {
+		_, err := loadConfig(getenv(map[string]string{"SHUTDOWN_TIMEOUT": "soon"}))
+
+		assert.Contains(t, err.Error(), "SHUTDOWN_TIMEOUT")
+	})
+
+	t.Run("rejects a malformed cookie setting", func(t *testing.T) {
+		_, err := loadConfig(getenv(map[string]string{"COOKIE_SECURE": "sometimes"}))
+
+		assert.Contains(t, err.Error(), "COOKIE_SECURE")
+	})
+
+	t.Run("rejects an unknown trace exporter", func(t *testing.T) {
+		_, err := loadConfig(getenv(map[string]string{"OTEL_TRACES_EXPORTER": "zipkin"}))
+
+		assert.ErrorIs(t, err, errUnknownExporter)
+		assert.Contains(t, err.Error(), "OTEL_TRACES_EXPORTER")
+	})
+}
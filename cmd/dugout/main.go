@@ -1,7 +1,292 @@
 package main
 
-import "fmt"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/spcameron/dugout/internal/adapters/leaguetime"
+	"github.com/spcameron/dugout/internal/adapters/passwords"
+	"github.com/spcameron/dugout/internal/adapters/postgres"
+	"github.com/spcameron/dugout/internal/adapters/smtpmail"
+	"github.com/spcameron/dugout/internal/adapters/web"
+	"github.com/spcameron/dugout/internal/database"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/projection"
+	"github.com/spcameron/dugout/internal/telemetry"
+	"github.com/spcameron/dugout/internal/usecase/account"
+	"github.com/spcameron/dugout/internal/usecase/league"
+	"github.com/spcameron/dugout/internal/usecase/live"
+	"github.com/spcameron/dugout/internal/usecase/notify"
+	"github.com/spcameron/dugout/internal/usecase/outbox"
+	"github.com/spcameron/dugout/internal/usecase/readmodel"
+	"github.com/spcameron/dugout/internal/usecase/roster"
+	"github.com/spcameron/dugout/internal/usecase/trade"
+)
+
+// Rosters lock daily at midnight Eastern.
+const (
+	leagueLockZone = "America/New_York"
+	leagueLockHour = 0
+)
+
+var errUnknownCommand = errors.New("unknown command")
 
 func main() {
-	fmt.Println("Hello, Dugout")
+	logger := slog.New(telemetry.NewLogHandler(slog.NewJSONHandler(os.Stderr, nil)))
+
+	err := dispatch(context.Background(), os.Args[1:], os.Getenv, os.Stdout, logger)
+	if err != nil {
+		logger.Error("dugout exited", "err", err)
+		os.Exit(1)
+	}
+}
+
+// dispatch runs the command named by args. With no command, or with serve, it
+// runs the server; the other commands are maintenance tasks that exit when done.
+func dispatch(ctx context.Context, args []string, getenv func(string) string, stdout io.Writer, logger *slog.Logger) error {
+	if len(args) == 0 {
+		return run(ctx, getenv, logger)
+	}
+
+	switch args[0] {
+	case "serve":
+		return run(ctx, getenv, logger)
+	case "check-rosters":
+		return checkRosters(ctx, getenv, stdout)
+	case "verify":
+		return verifyStreams(ctx, args[1:], getenv, stdout)
+	default:
+		return fmt.Errorf("%w: %q", errUnknownCommand, args[0])
+	}
+}
+
+func run(ctx context.Context, getenv func(string) string, logger *slog.Logger) error {
+	cfg, err := loadConfig(getenv)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	shutdownTracing, err := setupTracing(ctx, cfg.tracesExporter, os.Stdout)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		flushCtx, cancel := context.WithTimeout(context.Background(), cfg.shutdownTimeout)
+		defer cancel()
+
+		err := shutdownTracing(flushCtx)
+		if err != nil {
+			logger.Error("flushing traces", "err", err)
+		}
+	}()
+
+	meters, metrics, shutdownMetrics, err := setupMetrics()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := shutdownMetrics(context.Background())
+		if err != nil {
+			logger.Error("stopping metrics", "err", err)
+		}
+	}()
+
+	pool, err := pgxpool.New(ctx, cfg.db.dsn())
+	if err != nil {
+		return fmt.Errorf("opening database pool: %w", err)
+	}
+	defer pool.Close()
+
+	location, err := time.LoadLocation(leagueLockZone)
+	if err != nil {
+		return err
+	}
+
+	rosters := telemetry.NewRosterStore(postgres.NewRosterStore(pool), meters)
+	players := postgres.NewPlayerRepository(pool)
+	users := postgres.NewUserRepository(pool)
+	sessions := postgres.NewSessionStore(pool)
+	members := postgres.NewMembershipRepository(pool)
+	leagues := postgres.NewLeagueStore(pool)
+	trades := postgres.NewTradeStore(pool)
+	hasher := passwords.NewBcryptHasher(0)
+	clock := leaguetime.SystemClock{}
+	lock := leaguetime.NewDailyLock(clock, location, leagueLockHour)
+	access := roster.NewAccess(members, leagues)
+
+	channels := []notify.Channel{
+		{Channel: domain.ChannelInApp, Notifier: postgres.NewNotificationInbox(pool)},
+	}
+	if cfg.smtp.addr != "" {
+		channels = append(channels, notify.Channel{
+			Channel:  domain.ChannelEmail,
+			Notifier: smtpmail.NewNotifier(cfg.smtp.addr, cfg.smtp.from, cfg.smtp.auth(), users),
+		})
+	}
+
+	handled := postgres.NewHandledMessages(pool)
+	dispatcher := notify.NewDispatcher(postgres.NewNotificationPreferences(pool), channels...)
+
+	relay := outbox.NewRelay(postgres.NewRosterOutbox(pool), clock, outbox.Subscription[ports.OutboxMessage]{
+		Name:       notify.SubscriberName,
+		Subscriber: notify.NewRosterSubscriber(rosters, members, players, handled, clock, location, dispatcher),
+	})
+	tradeRelay := outbox.NewRelay(postgres.NewTradeOutbox(pool), clock, outbox.Subscription[ports.TradeOutboxMessage]{
+		Name:       notify.TradeOffersName,
+		Subscriber: notify.NewTradeOffers(members, leagues, players, handled, clock, dispatcher),
+	})
+
+	rosterLog := postgres.NewRosterStore(pool)
+	projections := projection.NewRunner[domain.RosterEvent](rosterLog, postgres.NewProjectionCheckpoints(pool),
+		postgres.NewCurrentRosters(pool).Projection(),
+	)
+
+	// Standings hold their state in memory, so their checkpoints do too, and they
+	// follow the league log from the start on every run.
+	standings := readmodel.NewStandings()
+	leagueModels := projection.NewRunner[domain.LeagueEvent](leagues, projection.NewMemoryCheckpoints(),
+		standings.Projection(),
+	)
+
+	feed := live.NewFeed(rosterLog, trades, trades, members, players)
+	err = feed.Start(ctx)
+	if err != nil {
+		return fmt.Errorf("starting live feed: %w", err)
+	}
+
+	scheduler := trade.NewReviewScheduler(trades, rosters, lock, clock, access)
+
+	var workers sync.WaitGroup
+	workers.Go(func() {
+		relay.Run(ctx, cfg.outboxInterval, func(err error) {
+			logger.Error("relaying roster events", "err", err)
+		})
+	})
+	workers.Go(func() {
+		tradeRelay.Run(ctx, cfg.outboxInterval, func(err error) {
+			logger.Error("relaying trade events", "err", err)
+		})
+	})
+	workers.Go(func() {
+		projections.Run(ctx, cfg.projectionInterval, func(err error) {
+			logger.Error("projecting roster events", "err", err)
+		})
+	})
+	workers.Go(func() {
+		leagueModels.Run(ctx, cfg.projectionInterval, func(err error) {
+			logger.Error("projecting league events into standings", "err", err)
+		})
+	})
+	workers.Go(func() {
+		feed.Run(ctx, cfg.liveInterval, func(err error) {
+			logger.Error("reading roster and trade logs for live pages", "err", err)
+		})
+	})
+	workers.Go(func() {
+		scheduler.Run(ctx, cfg.tradeReviewInterval, func(err error) {
+			logger.Error("settling trades under review", "err", err)
+		})
+	})
+	// The workers stop with ctx; wait for them so the pool is not closed under
+	// them.
+	defer func() {
+		stop()
+		workers.Wait()
+	}()
+
+	app := web.NewServer(web.RosterCommands{
+		Add: telemetry.NewCountedCommand("AddPlayer",
+			roster.NewAddPlayerHandler(rosters, lock, players, access), meters),
+		Remove: telemetry.NewCountedCommand("RemovePlayer",
+			roster.NewRemovePlayerHandler(rosters, lock, access), meters),
+		Activate: telemetry.NewCountedCommand("ActivatePlayer",
+			roster.NewActivatePlayerHandler(rosters, lock, access), meters),
+		Inactivate: telemetry.NewCountedCommand("InactivatePlayer",
+			roster.NewInactivatePlayerHandler(rosters, lock, access), meters),
+	}, web.RosterQueries{
+		View: roster.NewViewRosterHandler(rosters, lock, players),
+	}, web.AccountHandlers{
+		Register:     account.NewRegisterUserHandler(users, hasher),
+		SignIn:       account.NewSignInHandler(users, hasher, sessions, clock, cfg.sessionTTL),
+		SignOut:      account.NewSignOutHandler(sessions),
+		Authenticate: account.NewAuthenticateHandler(sessions, clock),
+	}, web.LeagueCommands{
+		Join:                league.NewJoinLeagueHandler(leagues, members, clock),
+		IssueInvite:         league.NewIssueInviteHandler(leagues, members, clock),
+		RevokeInvite:        league.NewRevokeInviteHandler(leagues, members, clock),
+		AdvancePhase:        league.NewAdvancePhaseHandler(leagues, members, standings, clock),
+		RecordResult:        league.NewRecordMatchupResultHandler(leagues, members, clock),
+		RecordPlayoffResult: league.NewRecordPlayoffResultHandler(leagues, members, clock),
+	}, database.New(pool), logger)
+	app.SecureCookies = cfg.secureCookies
+	app.Metrics = metrics
+	app.Live = feed
+
+	srv := &http.Server{
+		Addr:              cfg.addr,
+		Handler:           app.Routes(),
+		ReadHeaderTimeout: 5 * time.Second,
+		ErrorLog:          slog.NewLogLogger(logger.Handler(), slog.LevelError),
+	}
+	srv.RegisterOnShutdown(app.CloseStreams)
+
+	ln, err := net.Listen("tcp", cfg.addr)
+	if err != nil {
+		return err
+	}
+
+	return serve(ctx, srv, ln, app, cfg.shutdownTimeout, logger)
+}
+
+// serve runs srv on ln until ctx is cancelled, then marks the app not ready and
+// lets in-flight requests finish for up to timeout before closing.
+func serve(ctx context.Context, srv *http.Server, ln net.Listener, app *web.Server, timeout time.Duration, logger *slog.Logger) error {
+	errs := make(chan error, 1)
+	go func() {
+		errs <- srv.Serve(ln)
+	}()
+
+	app.SetReady(true)
+	logger.Info("server started", "addr", ln.Addr().String())
+
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+	}
+
+	app.SetReady(false)
+	logger.Info("shutting down")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	err := srv.Shutdown(shutdownCtx)
+	if err != nil {
+		return fmt.Errorf("shutting down: %w", err)
+	}
+
+	err = <-errs
+	if !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
 }
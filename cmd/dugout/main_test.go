@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/adapters/web"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+)
+
+type okPinger struct{}
+
+func (okPinger) Ping(ctx context.Context) (int32, error) {
+	return 1, nil
+}
+
+func TestServe(t *testing.T) {
+	logger := slog.New(slog.DiscardHandler)
+	app := web.NewServer(web.RosterCommands{}, web.RosterQueries{}, web.AccountHandlers{}, web.LeagueCommands{}, okPinger{}, logger)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	mux := http.NewServeMux()
+	mux.Handle("/", app.Routes())
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		w.WriteHeader(http.StatusOK)
+	})
+
+	// httptest.NewUnstartedServer provides a loopback listener without serving on it.
+	ts := httptest.NewUnstartedServer(nil)
+	ln := ts.Listener
+	t.Cleanup(func() { _ = ln.Close() })
+	base := "http://" + ln.Addr().String()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- serve(ctx, &http.Server{Handler: mux}, ln, app, 5*time.Second, logger)
+	}()
+
+	// Without keep-alives the client never parks a spare connection, which the
+	// server would otherwise wait on during shutdown.
+	client := &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
+
+	waitReady(t, client, base)
+
+	slow := make(chan int, 1)
+	go func() {
+		resp, err := client.Get(base + "/slow")
+		if err != nil {
+			slow <- 0
+			return
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		slow <- resp.StatusCode
+	}()
+	<-started
+
+	cancel()
+
+	select {
+	case err := <-done:
+		t.Fatalf("serve returned before the in-flight request finished: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+
+	assert.Equal(t, <-slow, http.StatusOK)
+	require.NoError(t, <-done)
+
+	_, err := net.Dial("tcp", ln.Addr().String())
+	assert.NotNil(t, err)
+}
+
+func waitReady(t *testing.T, client *http.Client, base string) {
+	t.Helper()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		resp, err := client.Get(base + "/readyz")
+		if err == nil {
+			_ = resp.Body.Close()
+			if resp.StatusCode == http.StatusOK {
+				return
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatal("server never became ready")
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel"
+	otelprometheus "go.opentelemetry.io/otel/exporters/prometheus"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+
+	"github.com/spcameron/dugout/internal/telemetry"
+)
+
+// setupMetrics installs the global meter provider and returns the instruments
+// dugout records to, along with the handler that serves their readings, with the
+// Go runtime and process collectors, in Prometheus format. Metrics are always
+// collected; scraping them is what costs.
+func setupMetrics() (*telemetry.Metrics, http.Handler, func(context.Context) error, error) {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	exporter, err := otelprometheus.New(
+		otelprometheus.WithRegisterer(registry),
+		otelprometheus.WithoutScopeInfo(),
+	)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("creating metrics exporter: %w", err)
+	}
+
+	provider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(exporter),
+		sdkmetric.WithResource(serviceResource()),
+	)
+	otel.SetMeterProvider(provider)
+
+	metrics, err := telemetry.NewMetrics(provider)
+	if err != nil {
+		return nil, nil, nil, errors.Join(fmt.Errorf("creating metrics: %w", err), provider.Shutdown(context.Background()))
+	}
+
+	return metrics, promhttp.HandlerFor(registry, promhttp.HandlerOpts{Registry: registry}), provider.Shutdown, nil
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const serviceName = "dugout"
+
+// setupTracing installs the global tracer provider and W3C trace context
+// propagation. The returned function flushes buffered spans; call it after the
+// server has stopped. With no exporter, spans are never recorded.
+func setupTracing(ctx context.Context, exporter string, stdout io.Writer) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var (
+		exp sdktrace.SpanExporter
+		err error
+	)
+	switch exporter {
+	case tracesNone:
+		return func(context.Context) error { return nil }, nil
+	case tracesStdout:
+		exp, err = stdouttrace.New(stdouttrace.WithWriter(stdout))
+	case tracesOTLP:
+		exp, err = otlptracehttp.New(ctx)
+	default:
+		err = fmt.Errorf("%w: %q", errUnknownExporter, exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("creating trace exporter: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exp),
+		sdktrace.WithResource(serviceResource()),
+	)
+	otel.SetTracerProvider(tp)
+
+	return tp.Shutdown, nil
+}
+
+func serviceResource() *resource.Resource {
+	return resource.NewSchemaless(attribute.String("service.name", serviceName))
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/spcameron/dugout/internal/telemetry"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+)
+
+func TestSetupTracing(t *testing.T) {
+	previous := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	t.Run("stdout exporter writes spans once flushed", func(t *testing.T) {
+		var out bytes.Buffer
+		shutdown, err := setupTracing(t.Context(), tracesStdout, &out)
+		require.NoError(t, err)
+
+		_, span := telemetry.Start(t.Context(), "roster.AddPlayer", telemetry.TeamID.Int(111))
+		span.End()
+
+		require.NoError(t, shutdown(t.Context()))
+		assert.Contains(t, out.String(), `"Name":"roster.AddPlayer"`)
+		assert.Contains(t, out.String(), `"dugout.team.id"`)
+	})
+
+	t.Run("no exporter records nothing", func(t *testing.T) {
+		otel.SetTracerProvider(noop.NewTracerProvider())
+
+		shutdown, err := setupTracing(t.Context(), tracesNone, nil)
+		require.NoError(t, err)
+
+		_, span := telemetry.Start(t.Context(), "roster.AddPlayer")
+		assert.False(t, span.IsRecording())
+		span.End()
+
+		assert.NoError(t, shutdown(t.Context()))
+	})
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/spcameron/dugout/internal/adapters/postgres"
+	"github.com/spcameron/dugout/internal/usecase/verify"
+)
+
+var (
+	errIntegrity          = errors.New("roster streams failed verification")
+	errUnknownFormat      = errors.New("unknown output format")
+	errUnexpectedArgument = errors.New("unexpected argument")
+)
+
+// verifyStreams checks every roster stream for corrupt or inconsistent events
+// and prints what it finds. With -repair it also resets stream versions that
+// have drifted from their events; duplicates, gaps and other issues are only
+// reported.
+func verifyStreams(ctx context.Context, args []string, getenv func(string) string, out io.Writer) error {
+	flags := flag.NewFlagSet("verify", flag.ContinueOnError)
+	flags.SetOutput(out)
+	format := flags.String("format", "text", "output format: text or json")
+	repair := flags.Bool("repair", false, "also reset stream versions that differ from their last sequence; duplicates, gaps and other issues are only reported")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+	if flags.NArg() > 0 {
+		return fmt.Errorf("%w: %q", errUnexpectedArgument, flags.Arg(0))
+	}
+	if *format != "text" && *format != "json" {
+		return fmt.Errorf("%w: %q", errUnknownFormat, *format)
+	}
+
+	cfg, err := loadConfig(getenv)
+	if err != nil {
+		return err
+	}
+
+	pool, err := pgxpool.New(ctx, cfg.db.dsn())
+	if err != nil {
+		return fmt.Errorf("opening database pool: %w", err)
+	}
+	defer pool.Close()
+
+	store := postgres.NewRosterStore(pool)
+	report, err := verify.NewVerifier(store, store).Run(ctx, *repair)
+	if err != nil {
+		return err
+	}
+
+	return reportVerify(out, *format, report)
+}
+
+type issueJSON struct {
+	TeamID   int64  `json:"team_id"`
+	Kind     string `json:"kind"`
+	Sequence int64  `json:"sequence,omitempty"`
+	Detail   string `json:"detail"`
+}
+
+type verifyJSON struct {
+	Streams  int         `json:"streams"`
+	Events   int         `json:"events"`
+	Issues   []issueJSON `json:"issues"`
+	Repaired []int64     `json:"repaired"`
+}
+
+// reportVerify prints the report as a line per issue and a summary, or as a
+// single JSON document, and returns errIntegrity if any issue was found.
+// Issues that were repaired still count, since the run found them.
+func reportVerify(out io.Writer, format string, report verify.Report) error {
+	switch format {
+	case "json":
+		doc := verifyJSON{
+			Streams:  report.Streams,
+			Events:   report.Events,
+			Issues:   make([]issueJSON, len(report.Issues)),
+			Repaired: make([]int64, len(report.Repaired)),
+		}
+		for i, issue := range report.Issues {
+			doc.Issues[i] = issueJSON{
+				TeamID:   int64(issue.TeamID),
+				Kind:     issue.Kind.Code(),
+				Sequence: int64(issue.Sequence),
+				Detail:   issue.Detail,
+			}
+		}
+		for i, team := range report.Repaired {
+			doc.Repaired[i] = int64(team)
+		}
+
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		err := enc.Encode(doc)
+		if err != nil {
+			return err
+		}
+	default:
+		for _, issue := range report.Issues {
+			if issue.Sequence == 0 {
+				fmt.Fprintf(out, "team %d: %s: %s\n", issue.TeamID, issue.Kind.Code(), issue.Detail)
+				continue
+			}
+			fmt.Fprintf(out, "team %d, sequence %d: %s: %s\n", issue.TeamID, issue.Sequence, issue.Kind.Code(), issue.Detail)
+		}
+		for _, team := range report.Repaired {
+			fmt.Fprintf(out, "team %d: version reset\n", team)
+		}
+
+		fmt.Fprintf(out, "verified %d streams, %d events: %d issues\n", report.Streams, report.Events, len(report.Issues))
+	}
+
+	if len(report.Issues) > 0 {
+		return fmt.Errorf("%w: %d issues", errIntegrity, len(report.Issues))
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/usecase/verify"
+)
+
+func TestReportVerify(t *testing.T) {
+	report := verify.Report{
+		Streams: 3,
+		Events:  12,
+		Issues: []verify.Issue{
+			{TeamID: 111, Kind: verify.IssueDuplicateSequence, Sequence: 4, Detail: "sequence 4 is recorded more than once"},
+			{TeamID: 222, Kind: verify.IssueVersionMismatch, Detail: "stream version is 5, last sequence is 3"},
+		},
+		Repaired: []domain.TeamID{222},
+	}
+
+	testCases := []struct {
+		name   string
+		format string
+		report verify.Report
+		want   string
+	}{
+		{
+			name:   "prints a clean run as text",
+			format: "text",
+			report: verify.Report{Streams: 3, Events: 12},
+			want:   "verified 3 streams, 12 events: 0 issues\n",
+		},
+		{
+			name:   "prints each issue as text",
+			format: "text",
+			report: report,
+			want: strings.Join([]string{
+				"team 111, sequence 4: duplicate_sequence: sequence 4 is recorded more than once",
+				"team 222: version_mismatch: stream version is 5, last sequence is 3",
+				"team 222: version reset",
+				"verified 3 streams, 12 events: 2 issues",
+				"",
+			}, "\n"),
+		},
+		{
+			name:   "prints a clean run as json",
+			format: "json",
+			report: verify.Report{Streams: 3, Events: 12},
+			want: strings.Join([]string{
+				`{`,
+				`  "streams": 3,`,
+				`  "events": 12,`,
+				`  "issues": [],`,
+				`  "repaired": []`,
+				`}`,
+				``,
+			}, "\n"),
+		},
+		{
+			name:   "prints each issue as json",
+			format: "json",
+			report: report,
+			want: strings.Join([]string{
+				`{`,
+				`  "streams": 3,`,
+				`  "events": 12,`,
+				`  "issues": [`,
+				`    {`,
+				`      "team_id": 111,`,
+				`      "kind": "duplicate_sequence",`,
+				`      "sequence": 4,`,
+				`      "detail": "sequence 4 is recorded more than once"`,
+				`    },`,
+				`    {`,
+				`      "team_id": 222,`,
+				`      "kind": "version_mismatch",`,
+				`      "detail": "stream version is 5, last sequence is 3"`,
+				`    }`,
+				`  ],`,
+				`  "repaired": [`,
+				`    222`,
+				`  ]`,
+				`}`,
+				``,
+			}, "\n"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var out strings.Builder
+
+			err := reportVerify(&out, tc.format, tc.report)
+
+			if len(tc.report.Issues) > 0 {
+				assert.ErrorIs(t, err, errIntegrity)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, out.String(), tc.want)
+		})
+	}
+}
+
+func TestVerifyStreamsRejectsBadArguments(t *testing.T) {
+	getenv := func(string) string { return "" }
+
+	testCases := []struct {
+		name string
+		args []string
+		want error
+	}{
+		{name: "unknown format", args: []string{"-format", "yaml"}, want: errUnknownFormat},
+		{name: "stray argument", args: []string{"all"}, want: errUnexpectedArgument},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var out strings.Builder
+
+			err := dispatch(t.Context(), append([]string{"verify"}, tc.args...), getenv, &out, nil)
+
+			assert.ErrorIs(t, err, tc.want)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE roster_streams (
+    team_id bigint PRIMARY KEY,
+    version bigint NOT NULL DEFAULT 0 CHECK (version >= 0)
+);
+
+CREATE TABLE roster_events (
+    team_id bigint NOT NULL REFERENCES roster_streams (team_id),
+    sequence bigint NOT NULL CHECK (sequence > 0),
+    event_type text NOT NULL,
+    payload jsonb NOT NULL,
+    effective_at timestamptz NOT NULL,
+    recorded_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (team_id, sequence)
+);
+
+GRANT SELECT, INSERT, UPDATE ON roster_streams TO dugout_app;
+
+GRANT SELECT, INSERT ON roster_events TO dugout_app;
+
+-- +goose Down
+DROP TABLE roster_events;
+
+DROP TABLE roster_streams;
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE players (
+    id bigserial PRIMARY KEY,
+    mlb_id bigint NOT NULL UNIQUE CHECK (mlb_id > 0),
+    name text NOT NULL CHECK (name <> ''),
+    role text NOT NULL CHECK (role IN ('hitter', 'pitcher')),
+    position text NOT NULL DEFAULT '',
+    mlb_team text NOT NULL DEFAULT '',
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX players_lower_name_idx ON players (lower(name) text_pattern_ops);
+
+CREATE INDEX players_position_idx ON players (position);
+
+CREATE INDEX players_mlb_team_idx ON players (mlb_team);
+
+GRANT SELECT, INSERT, UPDATE ON players TO dugout_app;
+
+GRANT USAGE ON SEQUENCE players_id_seq TO dugout_app;
+
+-- +goose Down
+DROP TABLE players;
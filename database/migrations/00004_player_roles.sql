@@ -0,0 +1,27 @@
+-- +goose Up
+ALTER TABLE players
+    ADD COLUMN roles text[];
+
+UPDATE
+    players
+SET
+    roles = ARRAY[role];
+
+ALTER TABLE players
+    ALTER COLUMN roles SET NOT NULL,
+    ADD CONSTRAINT players_roles_check CHECK (cardinality(roles) > 0 AND roles <@ ARRAY['hitter', 'pitcher']::text[]),
+    DROP COLUMN role;
+
+-- +goose Down
+ALTER TABLE players
+    ADD COLUMN role text;
+
+UPDATE
+    players
+SET
+    role = roles[1];
+
+ALTER TABLE players
+    ALTER COLUMN role SET NOT NULL,
+    ADD CONSTRAINT players_role_check CHECK (role IN ('hitter', 'pitcher')),
+    DROP COLUMN roles;
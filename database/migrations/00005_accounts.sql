@@ -0,0 +1,45 @@
+-- +goose Up
+CREATE TABLE users (
+    id bigserial PRIMARY KEY,
+    email text NOT NULL UNIQUE CHECK (email <> '' AND email = lower(email)),
+    display_name text NOT NULL CHECK (display_name <> ''),
+    password_hash bytea NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE sessions (
+    token_hash bytea PRIMARY KEY,
+    user_id bigint NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    expires_at timestamptz NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX sessions_user_id_idx ON sessions (user_id);
+
+CREATE TABLE memberships (
+    user_id bigint NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    league_id bigint NOT NULL CHECK (league_id > 0),
+    team_id bigint CHECK (team_id > 0),
+    role text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT memberships_role_check CHECK ((role IN ('manager', 'co_manager') AND team_id IS NOT NULL) OR (role = 'commissioner' AND team_id IS NULL))
+);
+
+CREATE UNIQUE INDEX memberships_unique_idx ON memberships (user_id, league_id, coalesce(team_id, 0), role);
+
+CREATE INDEX memberships_team_id_idx ON memberships (team_id);
+
+GRANT SELECT, INSERT ON users TO dugout_app;
+
+GRANT USAGE ON SEQUENCE users_id_seq TO dugout_app;
+
+GRANT SELECT, INSERT, DELETE ON sessions TO dugout_app;
+
+GRANT SELECT, INSERT ON memberships TO dugout_app;
+
+-- +goose Down
+DROP TABLE memberships;
+
+DROP TABLE sessions;
+
+DROP TABLE users;
@@ -0,0 +1,36 @@
+-- +goose Up
+CREATE TABLE league_streams (
+    league_id bigint PRIMARY KEY,
+    version bigint NOT NULL DEFAULT 0 CHECK (version >= 0)
+);
+
+CREATE TABLE league_events (
+    league_id bigint NOT NULL REFERENCES league_streams (league_id),
+    sequence bigint NOT NULL CHECK (sequence > 0),
+    event_type text NOT NULL,
+    payload jsonb NOT NULL,
+    occurred_at timestamptz NOT NULL,
+    recorded_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (league_id, sequence)
+);
+
+CREATE SEQUENCE league_id_seq;
+
+CREATE SEQUENCE team_id_seq;
+
+GRANT SELECT, INSERT, UPDATE ON league_streams TO dugout_app;
+
+GRANT SELECT, INSERT ON league_events TO dugout_app;
+
+GRANT USAGE ON SEQUENCE league_id_seq TO dugout_app;
+
+GRANT USAGE ON SEQUENCE team_id_seq TO dugout_app;
+
+-- +goose Down
+DROP SEQUENCE team_id_seq;
+
+DROP SEQUENCE league_id_seq;
+
+DROP TABLE league_events;
+
+DROP TABLE league_streams;
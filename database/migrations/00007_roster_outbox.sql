@@ -0,0 +1,31 @@
+-- +goose Up
+CREATE TABLE roster_outbox (
+    id bigserial PRIMARY KEY,
+    team_id bigint NOT NULL,
+    sequence bigint NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    UNIQUE (team_id, sequence),
+    FOREIGN KEY (team_id, sequence) REFERENCES roster_events (team_id, sequence)
+);
+
+CREATE TABLE roster_outbox_deliveries (
+    outbox_id bigint NOT NULL REFERENCES roster_outbox (id),
+    subscriber text NOT NULL,
+    attempts integer NOT NULL CHECK (attempts > 0),
+    next_attempt_at timestamptz NOT NULL,
+    delivered_at timestamptz,
+    dead_lettered_at timestamptz,
+    last_error text,
+    PRIMARY KEY (outbox_id, subscriber)
+);
+
+GRANT SELECT, INSERT ON roster_outbox TO dugout_app;
+
+GRANT USAGE ON SEQUENCE roster_outbox_id_seq TO dugout_app;
+
+GRANT SELECT, INSERT, UPDATE ON roster_outbox_deliveries TO dugout_app;
+
+-- +goose Down
+DROP TABLE roster_outbox_deliveries;
+
+DROP TABLE roster_outbox;
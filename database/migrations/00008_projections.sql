@@ -0,0 +1,23 @@
+-- +goose Up
+-- position orders roster events across every team. Positions are handed out as
+-- rows are inserted, not as they commit, so readers following the log only read
+-- rows whose transaction is older than every transaction still running; see
+-- ListRosterEventsAfter.
+ALTER TABLE roster_events
+    ADD COLUMN position bigint NOT NULL GENERATED ALWAYS AS IDENTITY UNIQUE,
+    ADD COLUMN transaction_id xid8 NOT NULL DEFAULT pg_current_xact_id();
+
+CREATE TABLE projection_checkpoints (
+    projection text PRIMARY KEY,
+    position bigint NOT NULL CHECK (position >= 0),
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+GRANT SELECT, INSERT, UPDATE ON projection_checkpoints TO dugout_app;
+
+-- +goose Down
+DROP TABLE projection_checkpoints;
+
+ALTER TABLE roster_events
+    DROP COLUMN transaction_id,
+    DROP COLUMN position;
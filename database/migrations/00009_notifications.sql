@@ -0,0 +1,31 @@
+-- +goose Up
+CREATE TABLE notifications (
+    user_id bigint NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    key text NOT NULL CHECK (key <> ''),
+    kind text NOT NULL,
+    subject text NOT NULL,
+    body text NOT NULL,
+    created_at timestamptz NOT NULL,
+    read_at timestamptz,
+    PRIMARY KEY (user_id, key)
+);
+
+CREATE INDEX notifications_user_created_idx ON notifications (user_id, created_at DESC);
+
+-- A row turns a channel off or back on; users without one get every channel.
+CREATE TABLE notification_preferences (
+    user_id bigint NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    channel text NOT NULL CHECK (channel IN ('in_app', 'email')),
+    enabled boolean NOT NULL,
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, channel)
+);
+
+GRANT SELECT, INSERT, UPDATE ON notifications TO dugout_app;
+
+GRANT SELECT, INSERT, UPDATE ON notification_preferences TO dugout_app;
+
+-- +goose Down
+DROP TABLE notification_preferences;
+
+DROP TABLE notifications;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- Appends now serialize on an advisory lock, so positions follow commit order
+-- and readers no longer need each row's transaction to hold it back.
+ALTER TABLE roster_events
+    DROP COLUMN transaction_id;
+
+-- +goose Down
+ALTER TABLE roster_events
+    ADD COLUMN transaction_id xid8 NOT NULL DEFAULT pg_current_xact_id();
@@ -0,0 +1,20 @@
+-- +goose Up
+-- current_roster_entries is kept by the current_rosters projection: each team's
+-- roster once every recorded move has taken effect. It is rebuilt from
+-- roster_events, never edited by hand. added_position is the log position of the
+-- add, which orders a roster as replaying its stream does.
+CREATE TABLE current_roster_entries (
+    team_id bigint NOT NULL,
+    player_id bigint NOT NULL,
+    roster_status text NOT NULL CHECK (roster_status IN ('inactive', 'active_hitter', 'active_pitcher')),
+    eligibility text[] NOT NULL CHECK (cardinality(eligibility) > 0 AND eligibility <@ ARRAY['hitter', 'pitcher']::text[]),
+    added_position bigint NOT NULL,
+    PRIMARY KEY (team_id, player_id)
+);
+
+CREATE INDEX current_roster_entries_player_id_idx ON current_roster_entries (player_id);
+
+GRANT SELECT, INSERT, UPDATE, DELETE ON current_roster_entries TO dugout_app;
+
+-- +goose Down
+DROP TABLE current_roster_entries;
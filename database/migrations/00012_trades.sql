@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE trade_streams (
+    trade_id bigint PRIMARY KEY,
+    version bigint NOT NULL DEFAULT 0 CHECK (version >= 0)
+);
+
+CREATE TABLE trade_events (
+    trade_id bigint NOT NULL REFERENCES trade_streams (trade_id),
+    sequence bigint NOT NULL CHECK (sequence > 0),
+    event_type text NOT NULL,
+    payload jsonb NOT NULL,
+    occurred_at timestamptz NOT NULL,
+    recorded_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (trade_id, sequence)
+);
+
+CREATE INDEX trade_events_event_type_idx ON trade_events (event_type);
+
+GRANT SELECT, INSERT, UPDATE ON trade_streams TO dugout_app;
+
+GRANT SELECT, INSERT ON trade_events TO dugout_app;
+
+-- +goose Down
+DROP TABLE trade_events;
+
+DROP TABLE trade_streams;
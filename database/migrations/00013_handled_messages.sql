@@ -0,0 +1,14 @@
+-- +goose Up
+-- Outbox messages a subscriber has finished acting on, by idempotency key, so a
+-- message redelivered after its delivery failed to be recorded is not acted on twice.
+CREATE TABLE handled_messages (
+    subscriber text NOT NULL,
+    key text NOT NULL CHECK (key <> ''),
+    handled_at timestamptz NOT NULL,
+    PRIMARY KEY (subscriber, key)
+);
+
+GRANT SELECT, INSERT ON handled_messages TO dugout_app;
+
+-- +goose Down
+DROP TABLE handled_messages;
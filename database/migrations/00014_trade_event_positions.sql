@@ -0,0 +1,41 @@
+-- +goose Up
+-- Trade events take their positions from the roster log's sequence, so a reader
+-- following both logs orders them with one cursor. Trade appends take the roster
+-- log's advisory lock too, so positions still follow commit order.
+ALTER TABLE trade_events
+    ADD COLUMN position bigint UNIQUE;
+
+UPDATE
+    trade_events
+SET
+    position = ordered.position
+FROM (
+    SELECT
+        trade_id,
+        sequence,
+        nextval('roster_events_position_seq') AS position
+    FROM (
+        SELECT
+            trade_id,
+            sequence
+        FROM
+            trade_events
+        ORDER BY
+            recorded_at,
+            trade_id,
+            sequence) AS pending) AS ordered
+WHERE
+    trade_events.trade_id = ordered.trade_id
+    AND trade_events.sequence = ordered.sequence;
+
+ALTER TABLE trade_events
+    ALTER COLUMN position SET DEFAULT nextval('roster_events_position_seq'),
+    ALTER COLUMN position SET NOT NULL;
+
+GRANT USAGE ON SEQUENCE roster_events_position_seq TO dugout_app;
+
+-- +goose Down
+REVOKE USAGE ON SEQUENCE roster_events_position_seq FROM dugout_app;
+
+ALTER TABLE trade_events
+    DROP COLUMN position;
@@ -0,0 +1,43 @@
+-- +goose Up
+-- position orders league events across every league, so read models such as
+-- standings can follow the league log as they follow the roster log. Appends
+-- take an advisory lock, so positions follow commit order.
+CREATE SEQUENCE league_events_position_seq;
+
+ALTER TABLE league_events
+    ADD COLUMN position bigint UNIQUE;
+
+UPDATE
+    league_events
+SET
+    position = ordered.position
+FROM (
+    SELECT
+        league_id,
+        sequence,
+        nextval('league_events_position_seq') AS position
+    FROM (
+        SELECT
+            league_id,
+            sequence
+        FROM
+            league_events
+        ORDER BY
+            recorded_at,
+            league_id,
+            sequence) AS pending) AS ordered
+WHERE
+    league_events.league_id = ordered.league_id
+    AND league_events.sequence = ordered.sequence;
+
+ALTER TABLE league_events
+    ALTER COLUMN position SET DEFAULT nextval('league_events_position_seq'),
+    ALTER COLUMN position SET NOT NULL;
+
+GRANT USAGE ON SEQUENCE league_events_position_seq TO dugout_app;
+
+-- +goose Down
+ALTER TABLE league_events
+    DROP COLUMN position;
+
+DROP SEQUENCE league_events_position_seq;
@@ -0,0 +1,31 @@
+-- +goose Up
+CREATE TABLE trade_outbox (
+    id bigserial PRIMARY KEY,
+    trade_id bigint NOT NULL,
+    sequence bigint NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    UNIQUE (trade_id, sequence),
+    FOREIGN KEY (trade_id, sequence) REFERENCES trade_events (trade_id, sequence)
+);
+
+CREATE TABLE trade_outbox_deliveries (
+    outbox_id bigint NOT NULL REFERENCES trade_outbox (id),
+    subscriber text NOT NULL,
+    attempts integer NOT NULL CHECK (attempts > 0),
+    next_attempt_at timestamptz NOT NULL,
+    delivered_at timestamptz,
+    dead_lettered_at timestamptz,
+    last_error text,
+    PRIMARY KEY (outbox_id, subscriber)
+);
+
+GRANT SELECT, INSERT ON trade_outbox TO dugout_app;
+
+GRANT USAGE ON SEQUENCE trade_outbox_id_seq TO dugout_app;
+
+GRANT SELECT, INSERT, UPDATE ON trade_outbox_deliveries TO dugout_app;
+
+-- +goose Down
+DROP TABLE trade_outbox_deliveries;
+
+DROP TABLE trade_outbox;
@@ -0,0 +1,58 @@
+-- +goose Up
+-- Adds recorded before eligibility was tracked carry no roles. Each takes the
+-- roles its player has in the catalog, or hitter alone for a player the catalog
+-- no longer lists. The current roster entry an add produced is corrected first,
+-- while the add can still be told apart. A RoleSet stores each role as the bit
+-- 1 << role: 2 for a hitter and 4 for a pitcher.
+WITH legacy_adds AS (
+    SELECT
+        e.team_id,
+        e.position,
+        ARRAY (
+            SELECT
+                role
+            FROM
+                unnest(ARRAY['hitter', 'pitcher']) AS role
+            WHERE
+                role = ANY (coalesce(p.roles, ARRAY['hitter']))) AS roles
+    FROM
+        roster_events e
+        LEFT JOIN players p ON p.id = (e.payload ->> 'PlayerID')::bigint
+    WHERE
+        e.event_type = 'AddedPlayerToRoster'
+        AND coalesce((e.payload ->> 'Eligibility')::integer, 0) = 0)
+UPDATE
+    current_roster_entries c
+SET
+    eligibility = l.roles
+FROM
+    legacy_adds l
+WHERE
+    c.team_id = l.team_id
+    AND c.added_position = l.position;
+
+WITH legacy_adds AS (
+    SELECT
+        e.team_id,
+        e.sequence,
+        coalesce(p.roles, ARRAY['hitter']) AS roles
+    FROM
+        roster_events e
+        LEFT JOIN players p ON p.id = (e.payload ->> 'PlayerID')::bigint
+    WHERE
+        e.event_type = 'AddedPlayerToRoster'
+        AND coalesce((e.payload ->> 'Eligibility')::integer, 0) = 0)
+UPDATE
+    roster_events e
+SET
+    payload = jsonb_set(e.payload, '{Eligibility}', to_jsonb(
+            CASE WHEN 'hitter' = ANY (l.roles) THEN 2 ELSE 0 END + CASE WHEN 'pitcher' = ANY (l.roles) THEN 4 ELSE 0 END))
+FROM
+    legacy_adds l
+WHERE
+    e.team_id = l.team_id
+    AND e.sequence = l.sequence;
+
+-- +goose Down
+-- The roles given to legacy adds cannot be told apart from recorded ones, so
+-- they are kept.
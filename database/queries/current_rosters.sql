@@ -0,0 +1,104 @@
+-- name: UpsertCurrentRosterEntry :exec
+INSERT INTO current_roster_entries (team_id, player_id, roster_status, eligibility, added_position)
+    VALUES (@team_id, @player_id, 'inactive', @eligibility, @added_position)
+ON CONFLICT (team_id, player_id)
+    DO UPDATE SET
+        roster_status = 'inactive',
+        eligibility = excluded.eligibility,
+        added_position = excluded.added_position;
+
+-- name: DeleteCurrentRosterEntry :exec
+DELETE FROM current_roster_entries
+WHERE team_id = @team_id
+    AND player_id = @player_id;
+
+-- name: SetCurrentRosterStatus :execrows
+UPDATE
+    current_roster_entries
+SET
+    roster_status = @roster_status
+WHERE
+    team_id = @team_id
+    AND player_id = @player_id;
+
+-- name: DeleteAllCurrentRosterEntries :exec
+DELETE FROM current_roster_entries;
+
+-- name: ListTeamRoster :many
+SELECT
+    team_id,
+    player_id,
+    roster_status,
+    eligibility
+FROM
+    current_roster_entries
+WHERE
+    team_id = $1
+ORDER BY
+    added_position;
+
+-- name: ListLeagueRosters :many
+SELECT
+    team_id,
+    player_id,
+    roster_status,
+    eligibility
+FROM
+    current_roster_entries
+WHERE
+    team_id IN (
+        SELECT
+            m.team_id
+        FROM
+            memberships m
+        WHERE
+            m.league_id = $1)
+ORDER BY
+    team_id,
+    added_position;
+
+-- name: GetPlayerOwner :one
+SELECT
+    team_id
+FROM
+    current_roster_entries
+WHERE
+    player_id = @player_id
+    AND team_id IN (
+        SELECT
+            m.team_id
+        FROM
+            memberships m
+        WHERE
+            m.league_id = @league_id)
+LIMIT 1;
+
+-- name: CountLeagueRosters :many
+SELECT
+    team_id,
+    count(*)::int AS total,
+    (count(*) FILTER (WHERE roster_status = 'active_hitter'))::int AS active_hitters,
+    (count(*) FILTER (WHERE roster_status = 'active_pitcher'))::int AS active_pitchers,
+    (count(*) FILTER (WHERE roster_status = 'inactive'))::int AS inactive
+FROM
+    current_roster_entries
+WHERE
+    team_id IN (
+        SELECT
+            m.team_id
+        FROM
+            memberships m
+        WHERE
+            m.league_id = $1)
+GROUP BY
+    team_id
+ORDER BY
+    team_id;
+
+-- name: ListCurrentRosterTeams :many
+SELECT DISTINCT
+    team_id
+FROM
+    current_roster_entries
+ORDER BY
+    team_id;
@@ -0,0 +1,16 @@
+-- name: MessageHandled :one
+SELECT
+    EXISTS (
+        SELECT
+            1
+        FROM
+            handled_messages
+        WHERE
+            subscriber = $1
+            AND key = $2);
+
+-- name: MarkMessageHandled :exec
+INSERT INTO handled_messages (subscriber, key, handled_at)
+    VALUES ($1, $2, $3)
+ON CONFLICT (subscriber, key)
+    DO NOTHING;
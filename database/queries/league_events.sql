@@ -0,0 +1,67 @@
+-- name: ListLeagueEvents :many
+SELECT
+    sequence,
+    event_type,
+    payload
+FROM
+    league_events
+WHERE
+    league_id = $1
+ORDER BY
+    sequence;
+
+-- name: LockLeagueLog :exec
+-- Taken before any stream is touched and held until commit, so appends commit
+-- one at a time and positions are handed out in commit order.
+SELECT
+    pg_advisory_xact_lock(hashtext('league_events'));
+
+-- name: EnsureLeagueStream :exec
+INSERT INTO league_streams (league_id)
+    VALUES ($1)
+ON CONFLICT (league_id)
+    DO NOTHING;
+
+-- name: LockLeagueStream :one
+SELECT
+    version
+FROM
+    league_streams
+WHERE
+    league_id = $1
+FOR UPDATE;
+
+-- name: SetLeagueStreamVersion :exec
+UPDATE
+    league_streams
+SET
+    version = @version
+WHERE
+    league_id = @league_id;
+
+-- name: InsertLeagueEvent :exec
+INSERT INTO league_events (league_id, sequence, event_type, payload, occurred_at)
+    VALUES ($1, $2, $3, $4, $5);
+
+-- name: NextLeagueID :one
+SELECT
+    nextval('league_id_seq')::bigint;
+
+-- name: NextTeamID :one
+SELECT
+    nextval('team_id_seq')::bigint;
+
+-- name: ListLeagueEventsAfter :many
+SELECT
+    position,
+    league_id,
+    sequence,
+    event_type,
+    payload
+FROM
+    league_events
+WHERE
+    position > @after
+ORDER BY
+    position
+LIMIT @max_events;
@@ -0,0 +1,40 @@
+-- name: GrantMembership :exec
+INSERT INTO memberships (user_id, league_id, team_id, role)
+    VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id, league_id, coalesce(team_id, 0), role)
+    DO NOTHING;
+
+-- name: ListMembershipsForUser :many
+SELECT
+    user_id,
+    league_id,
+    team_id,
+    role
+FROM
+    memberships
+WHERE
+    user_id = $1
+ORDER BY
+    league_id,
+    team_id NULLS FIRST,
+    role;
+
+-- name: GetTeamLeague :one
+SELECT
+    league_id
+FROM
+    memberships
+WHERE
+    team_id = $1
+LIMIT 1;
+
+-- name: ListTeamManagers :many
+SELECT
+    user_id
+FROM
+    memberships
+WHERE
+    team_id = $1
+    AND role IN ('manager', 'co_manager')
+ORDER BY
+    user_id;
@@ -0,0 +1,49 @@
+-- name: InsertNotification :exec
+INSERT INTO notifications (user_id, key, kind, subject, body, created_at)
+    VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (user_id, key)
+    DO NOTHING;
+
+-- name: ListNotifications :many
+SELECT
+    user_id,
+    key,
+    kind,
+    subject,
+    body,
+    created_at,
+    read_at
+FROM
+    notifications
+WHERE
+    user_id = @user_id
+ORDER BY
+    created_at DESC,
+    key DESC
+LIMIT @max_notifications;
+
+-- name: MarkNotificationRead :exec
+UPDATE
+    notifications
+SET
+    read_at = coalesce(read_at, @read_at)
+WHERE
+    user_id = @user_id
+    AND key = @key;
+
+-- name: GetNotificationPreference :one
+SELECT
+    enabled
+FROM
+    notification_preferences
+WHERE
+    user_id = $1
+    AND channel = $2;
+
+-- name: SetNotificationPreference :exec
+INSERT INTO notification_preferences (user_id, channel, enabled)
+    VALUES ($1, $2, $3)
+ON CONFLICT (user_id, channel)
+    DO UPDATE SET
+        enabled = excluded.enabled,
+        updated_at = now();
@@ -0,0 +1,55 @@
+-- name: GetPlayer :one
+SELECT
+    id,
+    mlb_id,
+    name,
+    roles,
+    position,
+    mlb_team
+FROM
+    players
+WHERE
+    id = $1;
+
+-- name: UpsertPlayer :one
+INSERT INTO players (mlb_id, name, roles, position, mlb_team)
+    VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (mlb_id)
+    DO UPDATE SET
+        name = EXCLUDED.name,
+        roles = EXCLUDED.roles,
+        position = EXCLUDED.position,
+        mlb_team = EXCLUDED.mlb_team,
+        updated_at = now()
+    WHERE (players.name, players.roles, players.position, players.mlb_team) IS DISTINCT FROM (EXCLUDED.name, EXCLUDED.roles, EXCLUDED.position, EXCLUDED.mlb_team)
+    RETURNING
+        id;
+
+-- name: GetPlayerIDByMLBID :one
+SELECT
+    id
+FROM
+    players
+WHERE
+    mlb_id = $1;
+
+-- name: SearchPlayers :many
+SELECT
+    id,
+    mlb_id,
+    name,
+    roles,
+    position,
+    mlb_team
+FROM
+    players
+WHERE
+    lower(name) LIKE @name_prefix::text || '%'
+    AND (@position::text = ''
+        OR position = @position::text)
+    AND (@mlb_team::text = ''
+        OR mlb_team = @mlb_team::text)
+ORDER BY
+    name,
+    id
+LIMIT @max_results;
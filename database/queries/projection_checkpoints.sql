@@ -0,0 +1,15 @@
+-- name: GetProjectionCheckpoint :one
+SELECT
+    position
+FROM
+    projection_checkpoints
+WHERE
+    projection = $1;
+
+-- name: SaveProjectionCheckpoint :exec
+INSERT INTO projection_checkpoints (projection, position)
+    VALUES (@projection, @position)
+ON CONFLICT (projection)
+    DO UPDATE SET
+        position = excluded.position,
+        updated_at = now();
@@ -0,0 +1,75 @@
+-- name: ListRosterEvents :many
+SELECT
+    sequence,
+    event_type,
+    payload
+FROM
+    roster_events
+WHERE
+    team_id = $1
+ORDER BY
+    sequence;
+
+-- name: LockRosterLog :exec
+-- Taken before any stream is touched and held until commit, so appends commit
+-- one at a time and positions are handed out in commit order. Trade appends
+-- take it too, since trade events share the roster log's positions.
+SELECT
+    pg_advisory_xact_lock(hashtext('roster_events'));
+
+-- name: EnsureRosterStream :exec
+INSERT INTO roster_streams (team_id)
+    VALUES ($1)
+ON CONFLICT (team_id)
+    DO NOTHING;
+
+-- name: LockRosterStream :one
+SELECT
+    version
+FROM
+    roster_streams
+WHERE
+    team_id = $1
+FOR UPDATE;
+
+-- name: SetRosterStreamVersion :exec
+UPDATE
+    roster_streams
+SET
+    version = @version
+WHERE
+    team_id = @team_id;
+
+-- name: InsertRosterEvent :exec
+INSERT INTO roster_events (team_id, sequence, event_type, payload, effective_at)
+    VALUES ($1, $2, $3, $4, $5);
+
+-- name: ListRosterEventsAfter :many
+SELECT
+    position,
+    team_id,
+    sequence,
+    event_type,
+    payload
+FROM
+    roster_events
+WHERE
+    position > @after
+ORDER BY
+    position
+LIMIT @max_events;
+
+-- name: GetLastRosterEventPosition :one
+SELECT
+    coalesce(max(position), 0)::bigint
+FROM
+    roster_events;
+
+-- name: ListRosterStreams :many
+SELECT
+    team_id,
+    version
+FROM
+    roster_streams
+ORDER BY
+    team_id;
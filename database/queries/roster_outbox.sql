@@ -0,0 +1,45 @@
+-- name: InsertRosterOutbox :exec
+INSERT INTO roster_outbox (team_id, sequence)
+    VALUES ($1, $2);
+
+-- name: ListPendingRosterOutbox :many
+SELECT
+    o.id,
+    o.team_id,
+    o.sequence,
+    e.event_type,
+    e.payload,
+    coalesce(d.attempts, 0)::integer AS attempts
+FROM
+    roster_outbox o
+    JOIN roster_events e ON e.team_id = o.team_id
+        AND e.sequence = o.sequence
+    LEFT JOIN roster_outbox_deliveries d ON d.outbox_id = o.id
+        AND d.subscriber = @subscriber
+WHERE
+    d.outbox_id IS NULL
+    OR (d.delivered_at IS NULL
+        AND d.dead_lettered_at IS NULL
+        AND d.next_attempt_at <= @now)
+ORDER BY
+    o.id
+LIMIT @max_messages;
+
+-- name: MarkRosterOutboxDelivered :exec
+INSERT INTO roster_outbox_deliveries (outbox_id, subscriber, attempts, next_attempt_at, delivered_at)
+    VALUES (@outbox_id, @subscriber, 1, @delivered_at, @delivered_at)
+ON CONFLICT (outbox_id, subscriber)
+    DO UPDATE SET
+        attempts = roster_outbox_deliveries.attempts + 1,
+        delivered_at = excluded.delivered_at,
+        last_error = NULL;
+
+-- name: RecordRosterOutboxFailure :exec
+INSERT INTO roster_outbox_deliveries (outbox_id, subscriber, attempts, next_attempt_at, dead_lettered_at, last_error)
+    VALUES (@outbox_id, @subscriber, 1, @next_attempt_at, @dead_lettered_at, @last_error)
+ON CONFLICT (outbox_id, subscriber)
+    DO UPDATE SET
+        attempts = roster_outbox_deliveries.attempts + 1,
+        next_attempt_at = excluded.next_attempt_at,
+        dead_lettered_at = excluded.dead_lettered_at,
+        last_error = excluded.last_error;
@@ -0,0 +1,17 @@
+-- name: CreateSession :exec
+INSERT INTO sessions (token_hash, user_id, expires_at)
+    VALUES ($1, $2, $3);
+
+-- name: GetSession :one
+SELECT
+    token_hash,
+    user_id,
+    expires_at
+FROM
+    sessions
+WHERE
+    token_hash = $1;
+
+-- name: DeleteSession :exec
+DELETE FROM sessions
+WHERE token_hash = $1;
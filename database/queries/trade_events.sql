@@ -0,0 +1,79 @@
+-- name: ListTradeEvents :many
+SELECT
+    sequence,
+    event_type,
+    payload
+FROM
+    trade_events
+WHERE
+    trade_id = $1
+ORDER BY
+    sequence;
+
+-- name: EnsureTradeStream :exec
+INSERT INTO trade_streams (trade_id)
+    VALUES ($1)
+ON CONFLICT (trade_id)
+    DO NOTHING;
+
+-- name: LockTradeStream :one
+SELECT
+    version
+FROM
+    trade_streams
+WHERE
+    trade_id = $1
+FOR UPDATE;
+
+-- name: SetTradeStreamVersion :exec
+UPDATE
+    trade_streams
+SET
+    version = @version
+WHERE
+    trade_id = @trade_id;
+
+-- name: InsertTradeEvent :exec
+INSERT INTO trade_events (trade_id, sequence, event_type, payload, occurred_at)
+    VALUES ($1, $2, $3, $4, $5);
+
+-- name: ListTradesUnderReview :many
+-- A trade is under review from its AcceptedTrade event until a veto, execution
+-- or failure settles it, as TradeView.Apply has it.
+SELECT
+    accepted.trade_id
+FROM
+    trade_events accepted
+WHERE
+    accepted.event_type = 'AcceptedTrade'
+    AND NOT EXISTS (
+        SELECT
+            1
+        FROM
+            trade_events settled
+        WHERE
+            settled.trade_id = accepted.trade_id
+            AND settled.event_type IN ('VetoedTrade', 'ExecutedTrade', 'FailedTrade'))
+ORDER BY
+    accepted.trade_id;
+
+-- name: ListTradeEventsAfter :many
+SELECT
+    position,
+    trade_id,
+    sequence,
+    event_type,
+    payload
+FROM
+    trade_events
+WHERE
+    position > @after
+ORDER BY
+    position
+LIMIT @max_events;
+
+-- name: GetLastTradeEventPosition :one
+SELECT
+    coalesce(max(position), 0)::bigint
+FROM
+    trade_events;
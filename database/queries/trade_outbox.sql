@@ -0,0 +1,45 @@
+-- name: InsertTradeOutbox :exec
+INSERT INTO trade_outbox (trade_id, sequence)
+    VALUES ($1, $2);
+
+-- name: ListPendingTradeOutbox :many
+SELECT
+    o.id,
+    o.trade_id,
+    o.sequence,
+    e.event_type,
+    e.payload,
+    coalesce(d.attempts, 0)::integer AS attempts
+FROM
+    trade_outbox o
+    JOIN trade_events e ON e.trade_id = o.trade_id
+        AND e.sequence = o.sequence
+    LEFT JOIN trade_outbox_deliveries d ON d.outbox_id = o.id
+        AND d.subscriber = @subscriber
+WHERE
+    d.outbox_id IS NULL
+    OR (d.delivered_at IS NULL
+        AND d.dead_lettered_at IS NULL
+        AND d.next_attempt_at <= @now)
+ORDER BY
+    o.id
+LIMIT @max_messages;
+
+-- name: MarkTradeOutboxDelivered :exec
+INSERT INTO trade_outbox_deliveries (outbox_id, subscriber, attempts, next_attempt_at, delivered_at)
+    VALUES (@outbox_id, @subscriber, 1, @delivered_at, @delivered_at)
+ON CONFLICT (outbox_id, subscriber)
+    DO UPDATE SET
+        attempts = trade_outbox_deliveries.attempts + 1,
+        delivered_at = excluded.delivered_at,
+        last_error = NULL;
+
+-- name: RecordTradeOutboxFailure :exec
+INSERT INTO trade_outbox_deliveries (outbox_id, subscriber, attempts, next_attempt_at, dead_lettered_at, last_error)
+    VALUES (@outbox_id, @subscriber, 1, @next_attempt_at, @dead_lettered_at, @last_error)
+ON CONFLICT (outbox_id, subscriber)
+    DO UPDATE SET
+        attempts = trade_outbox_deliveries.attempts + 1,
+        next_attempt_at = excluded.next_attempt_at,
+        dead_lettered_at = excluded.dead_lettered_at,
+        last_error = excluded.last_error;
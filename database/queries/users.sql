@@ -0,0 +1,26 @@
+-- name: CreateUser :one
+INSERT INTO users (email, display_name, password_hash)
+    VALUES ($1, $2, $3)
+RETURNING
+    id;
+
+-- name: GetUser :one
+SELECT
+    id,
+    email,
+    display_name
+FROM
+    users
+WHERE
+    id = $1;
+
+-- name: GetUserCredentials :one
+SELECT
+    id,
+    email,
+    display_name,
+    password_hash
+FROM
+    users
+WHERE
+    email = $1;
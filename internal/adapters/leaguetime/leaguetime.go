@@ -0,0 +1,69 @@
+// Package leaguetime provides the wall-clock implementations of ports.Clock and
+// ports.LeagueLock used by the running server.
+package leaguetime
+
+import (
+	"context"
+	"time"
+
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// SystemClock reports the current time.
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// DailyLock locks rosters once a day at Hour:00 in Location.
+type DailyLock struct {
+	Clock    ports.Clock
+	Location *time.Location
+	Hour     int
+}
+
+// LastLock returns the most recent lock at or before now.
+func (l DailyLock) LastLock(ctx context.Context) time.Time {
+	now := l.Clock.Now().In(l.Location)
+
+	lock := l.lockOn(now.Year(), now.Month(), now.Day())
+	if lock.After(now) {
+		lock = l.lockOn(now.Year(), now.Month(), now.Day()-1)
+	}
+
+	return lock
+}
+
+// NextLock returns the first lock after now.
+func (l DailyLock) NextLock(ctx context.Context) time.Time {
+	last := l.LastLock(ctx)
+
+	return l.lockOn(last.Year(), last.Month(), last.Day()+1)
+}
+
+// LockAtOrAfter returns the first lock at or after t.
+func (l DailyLock) LockAtOrAfter(ctx context.Context, t time.Time) time.Time {
+	local := t.In(l.Location)
+
+	lock := l.lockOn(local.Year(), local.Month(), local.Day())
+	if lock.Before(local) {
+		lock = l.lockOn(local.Year(), local.Month(), local.Day()+1)
+	}
+
+	return lock
+}
+
+// lockOn builds the lock time for a calendar day, which keeps the wall-clock hour
+// fixed across daylight saving transitions.
+func (l DailyLock) lockOn(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, l.Hour, 0, 0, 0, l.Location)
+}
+
+func NewDailyLock(clock ports.Clock, location *time.Location, hour int) DailyLock {
+	return DailyLock{
+		Clock:    clock,
+		Location: location,
+		Hour:     hour,
+	}
+}
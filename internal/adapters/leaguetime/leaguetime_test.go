@@ -0,0 +1,100 @@
+package leaguetime_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/adapters/leaguetime"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+func TestDailyLock(t *testing.T) {
+	nyc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name     string
+		now      time.Time
+		wantLast time.Time
+		wantNext time.Time
+	}{
+		{
+			name:     "before the lock hour the last lock was yesterday",
+			now:      time.Date(2025, time.May, 10, 8, 0, 0, 0, nyc),
+			wantLast: time.Date(2025, time.May, 9, 11, 0, 0, 0, nyc),
+			wantNext: time.Date(2025, time.May, 10, 11, 0, 0, 0, nyc),
+		},
+		{
+			name:     "exactly at the lock hour the last lock is now",
+			now:      time.Date(2025, time.May, 10, 11, 0, 0, 0, nyc),
+			wantLast: time.Date(2025, time.May, 10, 11, 0, 0, 0, nyc),
+			wantNext: time.Date(2025, time.May, 11, 11, 0, 0, 0, nyc),
+		},
+		{
+			name:     "the lock keeps its wall-clock hour across a daylight saving change",
+			now:      time.Date(2025, time.March, 8, 12, 0, 0, 0, nyc),
+			wantLast: time.Date(2025, time.March, 8, 11, 0, 0, 0, nyc),
+			wantNext: time.Date(2025, time.March, 9, 11, 0, 0, 0, nyc),
+		},
+		{
+			name:     "a clock in another zone is converted to the league's zone",
+			now:      time.Date(2025, time.May, 10, 14, 0, 0, 0, time.UTC),
+			wantLast: time.Date(2025, time.May, 9, 11, 0, 0, 0, nyc),
+			wantNext: time.Date(2025, time.May, 10, 11, 0, 0, 0, nyc),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lock := leaguetime.NewDailyLock(testkit.NewStubClock(tc.now), nyc, 11)
+
+			assert.True(t, lock.LastLock(t.Context()).Equal(tc.wantLast))
+			assert.True(t, lock.NextLock(t.Context()).Equal(tc.wantNext))
+		})
+	}
+}
+
+func TestDailyLockAtOrAfter(t *testing.T) {
+	nyc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lock := leaguetime.NewDailyLock(testkit.NewStubClock(time.Date(2025, time.May, 10, 8, 0, 0, 0, nyc)), nyc, 11)
+
+	testCases := []struct {
+		name string
+		at   time.Time
+		want time.Time
+	}{
+		{
+			name: "a time before the lock hour snaps to that day's lock",
+			at:   time.Date(2025, time.May, 12, 0, 0, 0, 0, nyc),
+			want: time.Date(2025, time.May, 12, 11, 0, 0, 0, nyc),
+		},
+		{
+			name: "a lock is its own lock",
+			at:   time.Date(2025, time.May, 12, 11, 0, 0, 0, nyc),
+			want: time.Date(2025, time.May, 12, 11, 0, 0, 0, nyc),
+		},
+		{
+			name: "a time after the lock hour snaps to the next day's lock",
+			at:   time.Date(2025, time.May, 12, 11, 1, 0, 0, nyc),
+			want: time.Date(2025, time.May, 13, 11, 0, 0, 0, nyc),
+		},
+		{
+			name: "a time in another zone is converted to the league's zone",
+			at:   time.Date(2025, time.May, 12, 4, 0, 0, 0, time.UTC),
+			want: time.Date(2025, time.May, 12, 11, 0, 0, 0, nyc),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.True(t, lock.LockAtOrAfter(t.Context(), tc.at).Equal(tc.want))
+		})
+	}
+}
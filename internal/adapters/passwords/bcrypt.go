@@ -0,0 +1,43 @@
+// Package passwords hashes account passwords for storage.
+package passwords
+
+import (
+	"errors"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// BcryptHasher must be constructed with NewBcryptHasher.
+type BcryptHasher struct {
+	cost int
+}
+
+func (h BcryptHasher) Hash(password string) ([]byte, error) {
+	return bcrypt.GenerateFromPassword([]byte(password), h.cost)
+}
+
+// Matches reports a wrong password as false rather than as an error, which is
+// reserved for hashes that cannot be read.
+func (h BcryptHasher) Matches(hash []byte, password string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword(hash, []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// NewBcryptHasher uses bcrypt.DefaultCost when cost is zero. Tests pass
+// bcrypt.MinCost to stay fast.
+func NewBcryptHasher(cost int) BcryptHasher {
+	if cost == 0 {
+		cost = bcrypt.DefaultCost
+	}
+
+	return BcryptHasher{
+		cost: cost,
+	}
+}
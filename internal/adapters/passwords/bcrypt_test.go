@@ -0,0 +1,30 @@
+package passwords_test
+
+import (
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/spcameron/dugout/internal/adapters/passwords"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+)
+
+func TestBcryptHasher(t *testing.T) {
+	h := passwords.NewBcryptHasher(bcrypt.MinCost)
+
+	hash, err := h.Hash("correct horse battery")
+	require.NoError(t, err)
+	assert.False(t, string(hash) == "correct horse battery")
+
+	ok, err := h.Matches(hash, "correct horse battery")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = h.Matches(hash, "incorrect horse battery")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, err = h.Matches([]byte("not a bcrypt hash"), "correct horse battery")
+	assert.NotNil(t, err)
+}
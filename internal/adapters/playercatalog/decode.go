@@ -0,0 +1,126 @@
+// Package playercatalog decodes MLB player catalog files for bulk import.
+//
+// Both formats carry the same fields: mlb_id, name, role, position, and mlb_team.
+// Role is "hitter", "pitcher", or "hitter/pitcher" for two-way players; position
+// and mlb_team may be empty.
+package playercatalog
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/spcameron/dugout/internal/domain"
+)
+
+var ErrMissingColumn = errors.New("catalog is missing a required column")
+
+var requiredColumns = []string{"mlb_id", "name", "role"}
+
+// DecodeCSV reads a catalog with a header row. Columns may appear in any order,
+// and unknown columns are ignored.
+func DecodeCSV(r io.Reader) ([]domain.Player, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	for _, name := range requiredColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrMissingColumn, name)
+		}
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var players []domain.Player
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		p, err := newPlayer(
+			field(record, "mlb_id"),
+			field(record, "name"),
+			field(record, "role"),
+			field(record, "position"),
+			field(record, "mlb_team"),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		players = append(players, p)
+	}
+
+	return players, nil
+}
+
+type jsonPlayer struct {
+	MLBID    json.Number `json:"mlb_id"`
+	Name     string      `json:"name"`
+	Role     string      `json:"role"`
+	Position string      `json:"position"`
+	MLBTeam  string      `json:"mlb_team"`
+}
+
+// DecodeJSON reads a catalog encoded as a JSON array of player objects.
+func DecodeJSON(r io.Reader) ([]domain.Player, error) {
+	var entries []jsonPlayer
+	err := json.NewDecoder(r).Decode(&entries)
+	if err != nil {
+		return nil, err
+	}
+
+	players := make([]domain.Player, len(entries))
+	for i, e := range entries {
+		players[i], err = newPlayer(e.MLBID.String(), e.Name, e.Role, e.Position, e.MLBTeam)
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i, err)
+		}
+	}
+
+	return players, nil
+}
+
+func newPlayer(mlbID, name, role, position, mlbTeam string) (domain.Player, error) {
+	id, err := strconv.Atoi(mlbID)
+	if err != nil {
+		return domain.Player{}, fmt.Errorf("%w: MLB ID %q", domain.ErrInvalidPlayer, mlbID)
+	}
+
+	roles, err := domain.ParseRoleSet(role)
+	if err != nil {
+		return domain.Player{}, err
+	}
+
+	return domain.Player{
+		MLBID:    domain.MLBPlayerID(id),
+		Name:     strings.TrimSpace(name),
+		Roles:    roles,
+		Position: domain.Position(strings.ToUpper(strings.TrimSpace(position))),
+		MLBTeam:  strings.ToUpper(strings.TrimSpace(mlbTeam)),
+	}, nil
+}
@@ -0,0 +1,75 @@
+package playercatalog_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spcameron/dugout/internal/adapters/playercatalog"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+)
+
+func TestDecodeCSV(t *testing.T) {
+	t.Run("decodes columns in any order and normalizes case", func(t *testing.T) {
+		input := "name,mlb_id,role,mlb_team,position\n" +
+			"Francisco Lindor,596019,hitter,nym,ss\n" +
+			"Kodai Senga,673540,Pitcher,NYM,SP\n"
+
+		players, err := playercatalog.DecodeCSV(strings.NewReader(input))
+		require.NoError(t, err)
+		require.Equal(t, len(players), 2)
+
+		assert.Equal(t, players[0], domain.Player{
+			MLBID:    596019,
+			Name:     "Francisco Lindor",
+			Roles:    domain.MustRoleSet(domain.RoleHitter),
+			Position: "SS",
+			MLBTeam:  "NYM",
+		})
+		assert.Equal(t, players[1].Roles, domain.MustRoleSet(domain.RolePitcher))
+	})
+
+	t.Run("decodes a two-way player", func(t *testing.T) {
+		input := "mlb_id,name,role\n660271,Shohei Ohtani,hitter/pitcher\n"
+
+		players, err := playercatalog.DecodeCSV(strings.NewReader(input))
+		require.NoError(t, err)
+		require.Equal(t, len(players), 1)
+
+		assert.True(t, players[0].EligibleFor(domain.RoleHitter))
+		assert.True(t, players[0].EligibleFor(domain.RolePitcher))
+	})
+
+	t.Run("rejects a header without a required column", func(t *testing.T) {
+		_, err := playercatalog.DecodeCSV(strings.NewReader("mlb_id,name\n1,Someone\n"))
+
+		assert.ErrorIs(t, err, playercatalog.ErrMissingColumn)
+	})
+
+	t.Run("reports the line of an unrecognized role", func(t *testing.T) {
+		_, err := playercatalog.DecodeCSV(strings.NewReader("mlb_id,name,role\n1,Someone,closer\n"))
+
+		assert.ErrorIs(t, err, domain.ErrUnrecognizedPlayerRole)
+		assert.Contains(t, err.Error(), "line 2")
+	})
+}
+
+func TestDecodeJSON(t *testing.T) {
+	t.Run("decodes an array of players", func(t *testing.T) {
+		input := `[{"mlb_id": 596019, "name": "Francisco Lindor", "role": "hitter", "position": "SS", "mlb_team": "NYM"}]`
+
+		players, err := playercatalog.DecodeJSON(strings.NewReader(input))
+		require.NoError(t, err)
+		require.Equal(t, len(players), 1)
+
+		assert.Equal(t, players[0].MLBID, domain.MLBPlayerID(596019))
+		assert.Equal(t, players[0].Position, domain.Position("SS"))
+	})
+
+	t.Run("rejects a non-numeric MLB ID", func(t *testing.T) {
+		_, err := playercatalog.DecodeJSON(strings.NewReader(`[{"mlb_id": "abc", "name": "X", "role": "hitter"}]`))
+
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,92 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/adapters/postgres"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/token"
+)
+
+func TestAccountRepositories(t *testing.T) {
+	pool := newTestPool(t)
+	users := postgres.NewUserRepository(pool)
+	sessions := postgres.NewSessionStore(pool)
+	members := postgres.NewMembershipRepository(pool)
+
+	seed := uniqueTeamID()
+	email := fmt.Sprintf("manager-%d@example.com", seed)
+
+	user, err := users.Create(t.Context(), domain.User{Email: email, DisplayName: "Manager"}, []byte("hash"))
+	require.NoError(t, err)
+	require.True(t, user.ID > 0)
+
+	t.Run("duplicate email is rejected", func(t *testing.T) {
+		_, err := users.Create(t.Context(), domain.User{Email: email, DisplayName: "Other"}, []byte("hash"))
+		assert.ErrorIs(t, err, ports.ErrEmailTaken)
+	})
+
+	t.Run("credentials are found by email", func(t *testing.T) {
+		creds, err := users.GetCredentials(t.Context(), email)
+		require.NoError(t, err)
+		assert.Equal(t, creds.User, user)
+		assert.Equal(t, string(creds.PasswordHash), "hash")
+
+		_, err = users.GetCredentials(t.Context(), "nobody-"+email)
+		assert.ErrorIs(t, err, ports.ErrUserNotFound)
+	})
+
+	t.Run("sessions are stored by token hash and deleted", func(t *testing.T) {
+		_, hash := token.New()
+		expires := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+
+		require.NoError(t, sessions.Create(t.Context(), ports.Session{TokenHash: hash, UserID: user.ID, ExpiresAt: expires}))
+
+		got, err := sessions.Get(t.Context(), hash)
+		require.NoError(t, err)
+		assert.Equal(t, got.UserID, user.ID)
+		assert.True(t, got.ExpiresAt.Equal(expires))
+
+		require.NoError(t, sessions.Delete(t.Context(), hash))
+		_, err = sessions.Get(t.Context(), hash)
+		assert.ErrorIs(t, err, ports.ErrSessionNotFound)
+	})
+
+	t.Run("memberships place a team in one league", func(t *testing.T) {
+		league := domain.LeagueID(seed)
+		team := domain.TeamID(seed)
+
+		manager := domain.Membership{UserID: user.ID, LeagueID: league, TeamID: team, Role: domain.MembershipManager}
+		require.NoError(t, members.Grant(t.Context(), manager))
+		require.NoError(t, members.Grant(t.Context(), manager))
+		require.NoError(t, members.Grant(t.Context(), domain.Membership{UserID: user.ID, LeagueID: league, Role: domain.MembershipCommissioner}))
+
+		got, err := members.LeagueOf(t.Context(), team)
+		require.NoError(t, err)
+		assert.Equal(t, got, league)
+
+		ms, err := members.ListForUser(t.Context(), user.ID)
+		require.NoError(t, err)
+		assert.Equal(t, len(ms), 2)
+		assert.True(t, ms.CanManageRoster(league, team))
+		assert.True(t, ms.IsCommissioner(league))
+
+		err = members.Grant(t.Context(), domain.Membership{UserID: user.ID, LeagueID: league + 1, TeamID: team, Role: domain.MembershipCoManager})
+		assert.ErrorIs(t, err, domain.ErrInvalidMembership)
+
+		_, err = members.LeagueOf(t.Context(), team+1)
+		assert.ErrorIs(t, err, ports.ErrTeamNotFound)
+
+		managers, err := members.ManagersOf(t.Context(), team)
+		require.NoError(t, err)
+		require.Equal(t, len(managers), 1)
+		assert.Equal(t, managers[0], user.ID)
+	})
+}
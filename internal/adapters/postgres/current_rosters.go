@@ -0,0 +1,189 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/spcameron/dugout/internal/database"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/projection"
+)
+
+const CurrentRostersProjection = "current_rosters"
+
+// CurrentRosters is the read model in current_roster_entries. Projection keeps
+// it up to date; the other methods read it.
+type CurrentRosters struct {
+	db DB
+}
+
+func (c *CurrentRosters) ListLeague(ctx context.Context, league domain.LeagueID) ([]domain.RosterEntry, error) {
+	rows, err := database.New(c.db).ListLeagueRosters(ctx, int64(league))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]domain.RosterEntry, len(rows))
+	for i, row := range rows {
+		entries[i], err = rosterEntryFromRow(row.TeamID, row.PlayerID, row.RosterStatus, row.Eligibility)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return entries, nil
+}
+
+func (c *CurrentRosters) ListTeam(ctx context.Context, team domain.TeamID) ([]domain.RosterEntry, error) {
+	rows, err := database.New(c.db).ListTeamRoster(ctx, int64(team))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]domain.RosterEntry, len(rows))
+	for i, row := range rows {
+		entries[i], err = rosterEntryFromRow(row.TeamID, row.PlayerID, row.RosterStatus, row.Eligibility)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return entries, nil
+}
+
+func (c *CurrentRosters) Owner(ctx context.Context, league domain.LeagueID, player domain.PlayerID) (domain.TeamID, error) {
+	team, err := database.New(c.db).GetPlayerOwner(ctx, database.GetPlayerOwnerParams{
+		LeagueID: int64(league),
+		PlayerID: int64(player),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, fmt.Errorf("%w: player %v in league %v", ports.ErrPlayerNotRostered, player, league)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return domain.TeamID(team), nil
+}
+
+func (c *CurrentRosters) Counts(ctx context.Context, league domain.LeagueID) (map[domain.TeamID]domain.RosterCounts, error) {
+	rows, err := database.New(c.db).CountLeagueRosters(ctx, int64(league))
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[domain.TeamID]domain.RosterCounts, len(rows))
+	for _, row := range rows {
+		counts[domain.TeamID(row.TeamID)] = domain.RosterCounts{
+			Total:          int(row.Total),
+			ActiveHitters:  int(row.ActiveHitters),
+			ActivePitchers: int(row.ActivePitchers),
+			Inactive:       int(row.Inactive),
+		}
+	}
+
+	return counts, nil
+}
+
+func (c *CurrentRosters) Teams(ctx context.Context) ([]domain.TeamID, error) {
+	rows, err := database.New(c.db).ListCurrentRosterTeams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	teams := make([]domain.TeamID, len(rows))
+	for i, id := range rows {
+		teams[i] = domain.TeamID(id)
+	}
+
+	return teams, nil
+}
+
+// Projection applies roster events to current_roster_entries the way
+// RosterView.Apply applies them to a view. Each handler sets the state its event
+// leaves behind, so reapplying a batch after a crash converges on the same rows.
+func (c *CurrentRosters) Projection() *projection.Projection[domain.RosterEvent] {
+	p := projection.New[domain.RosterEvent](CurrentRostersProjection)
+
+	projection.On(p, func(ctx context.Context, e domain.AddedPlayerToRoster, entry eventlog.Entry[domain.RosterEvent]) error {
+		return database.New(c.db).UpsertCurrentRosterEntry(ctx, database.UpsertCurrentRosterEntryParams{
+			TeamID:        int64(e.TeamID),
+			PlayerID:      int64(e.PlayerID),
+			Eligibility:   e.EligibleRoles().Codes(),
+			AddedPosition: int64(entry.Position),
+		})
+	})
+
+	projection.On(p, func(ctx context.Context, e domain.RemovedPlayerFromRoster, entry eventlog.Entry[domain.RosterEvent]) error {
+		return database.New(c.db).DeleteCurrentRosterEntry(ctx, database.DeleteCurrentRosterEntryParams{
+			TeamID:   int64(e.TeamID),
+			PlayerID: int64(e.PlayerID),
+		})
+	})
+
+	projection.On(p, func(ctx context.Context, e domain.ActivatedPlayerOnRoster, entry eventlog.Entry[domain.RosterEvent]) error {
+		status := domain.StatusActiveHitter
+		if e.PlayerRole == domain.RolePitcher {
+			status = domain.StatusActivePitcher
+		}
+
+		return c.setStatus(ctx, e.TeamID, e.PlayerID, status)
+	})
+
+	projection.On(p, func(ctx context.Context, e domain.InactivatedPlayerOnRoster, entry eventlog.Entry[domain.RosterEvent]) error {
+		return c.setStatus(ctx, e.TeamID, e.PlayerID, domain.StatusInactive)
+	})
+
+	p.OnReset(func(ctx context.Context) error {
+		return database.New(c.db).DeleteAllCurrentRosterEntries(ctx)
+	})
+
+	return p
+}
+
+func (c *CurrentRosters) setStatus(ctx context.Context, team domain.TeamID, player domain.PlayerID, status domain.RosterStatus) error {
+	n, err := database.New(c.db).SetCurrentRosterStatus(ctx, database.SetCurrentRosterStatusParams{
+		TeamID:       int64(team),
+		PlayerID:     int64(player),
+		RosterStatus: status.Code(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return fmt.Errorf("%w: team %v, player %v", domain.ErrPlayerNotOnRoster, team, player)
+	}
+
+	return nil
+}
+
+func rosterEntryFromRow(teamID, playerID int64, statusCode string, eligibilityCodes []string) (domain.RosterEntry, error) {
+	status, err := domain.ParseRosterStatus(statusCode)
+	if err != nil {
+		return domain.RosterEntry{}, err
+	}
+
+	eligibility, err := domain.ParseRoleCodes(eligibilityCodes)
+	if err != nil {
+		return domain.RosterEntry{}, err
+	}
+
+	return domain.RosterEntry{
+		TeamID:       domain.TeamID(teamID),
+		PlayerID:     domain.PlayerID(playerID),
+		RosterStatus: status,
+		Eligibility:  eligibility,
+	}, nil
+}
+
+func NewCurrentRosters(db DB) *CurrentRosters {
+	return &CurrentRosters{
+		db: db,
+	}
+}
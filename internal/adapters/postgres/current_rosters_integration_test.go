@@ -0,0 +1,110 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/spcameron/dugout/internal/adapters/postgres"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+func TestCurrentRosters(t *testing.T) {
+	pool := newTestPool(t)
+	rosters := postgres.NewCurrentRosters(pool)
+	members := postgres.NewMembershipRepository(pool)
+	users := postgres.NewUserRepository(pool)
+	p := rosters.Projection()
+
+	// Events are applied directly rather than through a runner, since the log and
+	// the projection's checkpoint are shared with earlier runs.
+	var pos eventlog.Position
+	apply := func(t *testing.T, events ...domain.RosterEvent) {
+		t.Helper()
+
+		for _, e := range events {
+			pos++
+			err := p.Apply(t.Context(), eventlog.Entry[domain.RosterEvent]{Position: pos, Recorded: eventlog.Recorded[domain.RosterEvent]{Event: e}})
+			require.NoError(t, err)
+		}
+	}
+
+	hitters := domain.MustRoleSet(domain.RoleHitter)
+	twoWay := domain.MustRoleSet(domain.RoleHitter, domain.RolePitcher)
+
+	t.Run("follows adds, lineup moves and drops", func(t *testing.T) {
+		team := uniqueTeamID()
+		apply(t,
+			domain.AddedPlayerToRoster{TeamID: team, PlayerID: 1, Eligibility: hitters, EffectiveAt: testkit.TodayLock()},
+			domain.AddedPlayerToRoster{TeamID: team, PlayerID: 2, Eligibility: twoWay, EffectiveAt: testkit.TodayLock()},
+			domain.AddedPlayerToRoster{TeamID: team, PlayerID: 3, Eligibility: hitters, EffectiveAt: testkit.TodayLock()},
+			domain.ActivatedPlayerOnRoster{TeamID: team, PlayerID: 2, PlayerRole: domain.RolePitcher, EffectiveAt: testkit.TomorrowLock()},
+			domain.ActivatedPlayerOnRoster{TeamID: team, PlayerID: 3, PlayerRole: domain.RoleHitter, EffectiveAt: testkit.TomorrowLock()},
+			domain.InactivatedPlayerOnRoster{TeamID: team, PlayerID: 3, EffectiveAt: testkit.TomorrowLock()},
+			domain.RemovedPlayerFromRoster{TeamID: team, PlayerID: 1, EffectiveAt: testkit.TomorrowLock()},
+		)
+
+		got, err := rosters.ListTeam(t.Context(), team)
+		require.NoError(t, err)
+		assert.Equal(t, got, []domain.RosterEntry{
+			{TeamID: team, PlayerID: 2, RosterStatus: domain.StatusActivePitcher, Eligibility: twoWay},
+			{TeamID: team, PlayerID: 3, RosterStatus: domain.StatusInactive, Eligibility: hitters},
+		})
+
+		teams, err := rosters.Teams(t.Context())
+		require.NoError(t, err)
+		assert.True(t, len(teams) > 0)
+	})
+
+	t.Run("a lineup move for a player not on the roster fails", func(t *testing.T) {
+		team := uniqueTeamID()
+		pos++
+		err := p.Apply(t.Context(), eventlog.Entry[domain.RosterEvent]{Position: pos, Recorded: eventlog.Recorded[domain.RosterEvent]{
+			Event: domain.ActivatedPlayerOnRoster{TeamID: team, PlayerID: 9, PlayerRole: domain.RoleHitter, EffectiveAt: testkit.TodayLock()},
+		}})
+
+		assert.ErrorIs(t, err, domain.ErrPlayerNotOnRoster)
+	})
+
+	t.Run("lists, counts and finds owners within a league", func(t *testing.T) {
+		seed := uniqueTeamID()
+		league := domain.LeagueID(seed)
+		mine, theirs := uniqueTeamID(), uniqueTeamID()
+
+		user, err := users.Create(t.Context(), domain.User{Email: fmt.Sprintf("rosters-%d@example.com", seed), DisplayName: "Manager"}, []byte("hash"))
+		require.NoError(t, err)
+		require.NoError(t, members.Grant(t.Context(), domain.Membership{UserID: user.ID, LeagueID: league, TeamID: mine, Role: domain.MembershipManager}))
+
+		apply(t,
+			domain.AddedPlayerToRoster{TeamID: mine, PlayerID: 7, Eligibility: hitters, EffectiveAt: testkit.TodayLock()},
+			domain.ActivatedPlayerOnRoster{TeamID: mine, PlayerID: 7, PlayerRole: domain.RoleHitter, EffectiveAt: testkit.TodayLock()},
+			domain.AddedPlayerToRoster{TeamID: mine, PlayerID: 8, Eligibility: hitters, EffectiveAt: testkit.TodayLock()},
+			domain.AddedPlayerToRoster{TeamID: theirs, PlayerID: 9, Eligibility: hitters, EffectiveAt: testkit.TodayLock()},
+		)
+
+		entries, err := rosters.ListLeague(t.Context(), league)
+		require.NoError(t, err)
+		require.Equal(t, len(entries), 2)
+		assert.Equal(t, entries[0].PlayerID, domain.PlayerID(7))
+		assert.Equal(t, entries[1].PlayerID, domain.PlayerID(8))
+
+		counts, err := rosters.Counts(t.Context(), league)
+		require.NoError(t, err)
+		assert.Equal(t, counts, map[domain.TeamID]domain.RosterCounts{
+			mine: {Total: 2, ActiveHitters: 1, Inactive: 1},
+		})
+
+		owner, err := rosters.Owner(t.Context(), league, 8)
+		require.NoError(t, err)
+		assert.Equal(t, owner, mine)
+
+		_, err = rosters.Owner(t.Context(), league, 9)
+		assert.ErrorIs(t, err, ports.ErrPlayerNotRostered)
+	})
+}
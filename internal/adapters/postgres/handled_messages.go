@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/spcameron/dugout/internal/database"
+)
+
+// HandledMessages keeps one row per subscriber and handled message key. Marking
+// a key twice keeps the first time it was handled.
+type HandledMessages struct {
+	db DB
+}
+
+func (m *HandledMessages) Handled(ctx context.Context, subscriber, key string) (bool, error) {
+	return database.New(m.db).MessageHandled(ctx, database.MessageHandledParams{
+		Subscriber: subscriber,
+		Key:        key,
+	})
+}
+
+func (m *HandledMessages) MarkHandled(ctx context.Context, subscriber, key string, at time.Time) error {
+	return database.New(m.db).MarkMessageHandled(ctx, database.MarkMessageHandledParams{
+		Subscriber: subscriber,
+		Key:        key,
+		HandledAt:  pgtype.Timestamptz{Time: at, Valid: true},
+	})
+}
+
+func NewHandledMessages(db DB) *HandledMessages {
+	return &HandledMessages{
+		db: db,
+	}
+}
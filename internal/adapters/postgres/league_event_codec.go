@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spcameron/dugout/internal/domain"
+)
+
+const (
+	eventTypeCreatedLeague         = "CreatedLeague"
+	eventTypeJoinedLeague          = "JoinedLeague"
+	eventTypeRenamedTeam           = "RenamedTeam"
+	eventTypeChangedLeagueSettings = "ChangedLeagueSettings"
+	eventTypeAdvancedLeaguePhase   = "AdvancedLeaguePhase"
+	eventTypeIssuedLeagueInvite    = "IssuedLeagueInvite"
+	eventTypeRedeemedLeagueInvite  = "RedeemedLeagueInvite"
+	eventTypeRevokedLeagueInvite   = "RevokedLeagueInvite"
+	eventTypeGeneratedBracket      = "GeneratedPlayoffBracket"
+	eventTypeRecordedPlayoffResult = "RecordedPlayoffResult"
+	eventTypeRecordedMatchupResult = "RecordedMatchupResult"
+)
+
+// encodeLeagueEvent returns the stored type name and JSON payload for a league event.
+func encodeLeagueEvent(event domain.LeagueEvent) (string, []byte, error) {
+	var eventType string
+	switch event.(type) {
+	case domain.CreatedLeague:
+		eventType = eventTypeCreatedLeague
+	case domain.JoinedLeague:
+		eventType = eventTypeJoinedLeague
+	case domain.RenamedTeam:
+		eventType = eventTypeRenamedTeam
+	case domain.ChangedLeagueSettings:
+		eventType = eventTypeChangedLeagueSettings
+	case domain.AdvancedLeaguePhase:
+		eventType = eventTypeAdvancedLeaguePhase
+	case domain.IssuedLeagueInvite:
+		eventType = eventTypeIssuedLeagueInvite
+	case domain.RedeemedLeagueInvite:
+		eventType = eventTypeRedeemedLeagueInvite
+	case domain.RevokedLeagueInvite:
+		eventType = eventTypeRevokedLeagueInvite
+	case domain.GeneratedPlayoffBracket:
+		eventType = eventTypeGeneratedBracket
+	case domain.RecordedPlayoffResult:
+		eventType = eventTypeRecordedPlayoffResult
+	case domain.RecordedMatchupResult:
+		eventType = eventTypeRecordedMatchupResult
+	default:
+		return "", nil, fmt.Errorf("%w: %T", domain.ErrUnrecognizedLeagueEvent, event)
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return eventType, payload, nil
+}
+
+// decodeLeagueEvent rebuilds a league event from its stored type name and JSON payload.
+func decodeLeagueEvent(eventType string, payload []byte) (domain.LeagueEvent, error) {
+	switch eventType {
+	case eventTypeCreatedLeague:
+		return decodeLeagueAs[domain.CreatedLeague](payload)
+	case eventTypeJoinedLeague:
+		return decodeLeagueAs[domain.JoinedLeague](payload)
+	case eventTypeRenamedTeam:
+		return decodeLeagueAs[domain.RenamedTeam](payload)
+	case eventTypeChangedLeagueSettings:
+		return decodeLeagueAs[domain.ChangedLeagueSettings](payload)
+	case eventTypeAdvancedLeaguePhase:
+		return decodeLeagueAs[domain.AdvancedLeaguePhase](payload)
+	case eventTypeIssuedLeagueInvite:
+		return decodeLeagueAs[domain.IssuedLeagueInvite](payload)
+	case eventTypeRedeemedLeagueInvite:
+		return decodeLeagueAs[domain.RedeemedLeagueInvite](payload)
+	case eventTypeRevokedLeagueInvite:
+		return decodeLeagueAs[domain.RevokedLeagueInvite](payload)
+	case eventTypeGeneratedBracket:
+		return decodeLeagueAs[domain.GeneratedPlayoffBracket](payload)
+	case eventTypeRecordedPlayoffResult:
+		return decodeLeagueAs[domain.RecordedPlayoffResult](payload)
+	case eventTypeRecordedMatchupResult:
+		return decodeLeagueAs[domain.RecordedMatchupResult](payload)
+	default:
+		return nil, fmt.Errorf("%w: %q", domain.ErrUnrecognizedLeagueEvent, eventType)
+	}
+}
+
+func decodeLeagueAs[E domain.LeagueEvent](payload []byte) (domain.LeagueEvent, error) {
+	var event E
+	err := json.Unmarshal(payload, &event)
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
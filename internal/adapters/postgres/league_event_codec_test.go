@@ -0,0 +1,165 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+func TestLeagueEventCodec(t *testing.T) {
+	testCases := []struct {
+		name     string
+		event    domain.LeagueEvent
+		wantType string
+	}{
+		{
+			name: "round trips CreatedLeague",
+			event: domain.CreatedLeague{
+				LeagueID:     testkit.LeagueA(),
+				Name:         "Flushing Meadows",
+				Commissioner: testkit.Commissioner(),
+				Settings:     domain.DefaultLeagueSettings(),
+				CreatedAt:    testkit.TodayLock(),
+			},
+			wantType: eventTypeCreatedLeague,
+		},
+		{
+			name: "round trips JoinedLeague",
+			event: domain.JoinedLeague{
+				LeagueID: testkit.LeagueA(),
+				TeamID:   testkit.TeamA(),
+				TeamName: "Team A",
+				Manager:  testkit.ManagerA(),
+				JoinedAt: testkit.TodayLock(),
+			},
+			wantType: eventTypeJoinedLeague,
+		},
+		{
+			name: "round trips RenamedTeam",
+			event: domain.RenamedTeam{
+				LeagueID:  testkit.LeagueA(),
+				TeamID:    testkit.TeamA(),
+				Name:      "Amazins",
+				RenamedAt: testkit.TodayLock(),
+			},
+			wantType: eventTypeRenamedTeam,
+		},
+		{
+			name: "round trips ChangedLeagueSettings",
+			event: domain.ChangedLeagueSettings{
+				LeagueID:  testkit.LeagueA(),
+				Settings:  domain.LeagueSettings{MaxTeams: 8},
+				ChangedAt: testkit.TodayLock(),
+			},
+			wantType: eventTypeChangedLeagueSettings,
+		},
+		{
+			name: "round trips AdvancedLeaguePhase",
+			event: domain.AdvancedLeaguePhase{
+				LeagueID:   testkit.LeagueA(),
+				Phase:      domain.PhaseInSeason,
+				AdvancedAt: testkit.TodayLock(),
+			},
+			wantType: eventTypeAdvancedLeaguePhase,
+		},
+		{
+			name: "round trips IssuedLeagueInvite",
+			event: domain.IssuedLeagueInvite{
+				LeagueID:  testkit.LeagueA(),
+				InviteID:  1,
+				TokenHash: []byte{0xde, 0xad, 0xbe, 0xef},
+				ExpiresAt: testkit.TomorrowLock(),
+				SingleUse: true,
+				IssuedBy:  testkit.Commissioner(),
+				IssuedAt:  testkit.TodayLock(),
+			},
+			wantType: eventTypeIssuedLeagueInvite,
+		},
+		{
+			name: "round trips RedeemedLeagueInvite",
+			event: domain.RedeemedLeagueInvite{
+				LeagueID:   testkit.LeagueA(),
+				InviteID:   1,
+				TeamID:     testkit.TeamC(),
+				RedeemedBy: 33,
+				RedeemedAt: testkit.TodayLock(),
+			},
+			wantType: eventTypeRedeemedLeagueInvite,
+		},
+		{
+			name: "round trips RevokedLeagueInvite",
+			event: domain.RevokedLeagueInvite{
+				LeagueID:  testkit.LeagueA(),
+				InviteID:  1,
+				RevokedAt: testkit.TodayLock(),
+			},
+			wantType: eventTypeRevokedLeagueInvite,
+		},
+		{
+			name: "round trips GeneratedPlayoffBracket",
+			event: domain.GeneratedPlayoffBracket{
+				LeagueID:    testkit.LeagueA(),
+				Settings:    domain.PlayoffSettings{Teams: 2},
+				Seeds:       []domain.TeamID{testkit.TeamB(), testkit.TeamA()},
+				GeneratedAt: testkit.TodayLock(),
+			},
+			wantType: eventTypeGeneratedBracket,
+		},
+		{
+			name: "round trips RecordedPlayoffResult",
+			event: domain.RecordedPlayoffResult{
+				LeagueID:   testkit.LeagueA(),
+				Matchup:    domain.MatchupID{Bracket: domain.BracketChampionship, Round: 1, Slot: 0},
+				HighScore:  81.5,
+				LowScore:   92,
+				Winner:     testkit.TeamA(),
+				RecordedAt: testkit.TodayLock(),
+			},
+			wantType: eventTypeRecordedPlayoffResult,
+		},
+		{
+			name: "round trips RecordedMatchupResult",
+			event: domain.RecordedMatchupResult{
+				LeagueID: testkit.LeagueA(),
+				Result: domain.MatchupResult{
+					Period:    3,
+					Home:      testkit.TeamA(),
+					Away:      testkit.TeamB(),
+					HomeScore: 104.5,
+					AwayScore: 99,
+				},
+				RecordedAt: testkit.TodayLock(),
+			},
+			wantType: eventTypeRecordedMatchupResult,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			eventType, payload, err := encodeLeagueEvent(tc.event)
+			require.NoError(t, err)
+			assert.Equal(t, eventType, tc.wantType)
+
+			decoded, err := decodeLeagueEvent(eventType, payload)
+			require.NoError(t, err)
+
+			assert.Equal(t, decoded.League(), tc.event.League())
+			assert.True(t, decoded.OccurredAt().Equal(tc.event.OccurredAt()))
+
+			reencodedType, reencoded, err := encodeLeagueEvent(decoded)
+			require.NoError(t, err)
+			assert.Equal(t, reencodedType, eventType)
+			assert.Equal(t, string(reencoded), string(payload))
+		})
+	}
+
+	t.Run("decode rejects unknown event type", func(t *testing.T) {
+		event, err := decodeLeagueEvent("DisbandedLeague", []byte(`{}`))
+
+		assert.Nil(t, event)
+		assert.ErrorIs(t, err, domain.ErrUnrecognizedLeagueEvent)
+	})
+}
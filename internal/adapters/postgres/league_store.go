@@ -0,0 +1,166 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/spcameron/dugout/internal/database"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// LeagueStore persists league event streams in Postgres, locking each league's
+// row in league_streams while appending as RosterStore does for teams. League
+// and team IDs come from database sequences.
+type LeagueStore struct {
+	db DB
+}
+
+func (s *LeagueStore) Load(ctx context.Context, id domain.LeagueID) ([]eventlog.Recorded[domain.LeagueEvent], ports.Version, error) {
+	rows, err := database.New(s.db).ListLeagueEvents(ctx, int64(id))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	history := make([]eventlog.Recorded[domain.LeagueEvent], len(rows))
+	for i, row := range rows {
+		event, err := decodeLeagueEvent(row.EventType, row.Payload)
+		if err != nil {
+			return nil, 0, fmt.Errorf("league %v, sequence %v: %w", id, row.Sequence, err)
+		}
+
+		history[i] = eventlog.Recorded[domain.LeagueEvent]{
+			Sequence: eventlog.Sequence(row.Sequence),
+			Event:    event,
+		}
+	}
+
+	var lastSeq eventlog.Sequence
+	if len(history) > 0 {
+		lastSeq = history[len(history)-1].Sequence
+	}
+
+	return history, ports.Version(lastSeq), nil
+}
+
+func (s *LeagueStore) Append(ctx context.Context, id domain.LeagueID, newEvents []domain.LeagueEvent, expected ports.Version) (_ ports.Version, err error) {
+	leagueID := int64(id)
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, ignoreTxClosed(tx.Rollback(ctx)))
+		}
+	}()
+
+	q := database.New(tx)
+
+	err = q.LockLeagueLog(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	err = q.EnsureLeagueStream(ctx, leagueID)
+	if err != nil {
+		return 0, err
+	}
+
+	current, err := q.LockLeagueStream(ctx, leagueID)
+	if err != nil {
+		return 0, err
+	}
+
+	if ports.Version(current) != expected {
+		return 0, fmt.Errorf("%w: league %v, current - %v, expected - %v", ports.ErrVersionConflict, id, current, expected)
+	}
+
+	nextSeq := current
+	for _, ev := range newEvents {
+		if ev.League() != id {
+			return 0, fmt.Errorf("%w: event league %v, stream league %v", domain.ErrWrongLeagueID, ev.League(), id)
+		}
+
+		eventType, payload, err := encodeLeagueEvent(ev)
+		if err != nil {
+			return 0, err
+		}
+
+		nextSeq++
+		err = q.InsertLeagueEvent(ctx, database.InsertLeagueEventParams{
+			LeagueID:   leagueID,
+			Sequence:   nextSeq,
+			EventType:  eventType,
+			Payload:    payload,
+			OccurredAt: pgtype.Timestamptz{Time: ev.OccurredAt(), Valid: true},
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = q.SetLeagueStreamVersion(ctx, database.SetLeagueStreamVersionParams{
+		Version:  nextSeq,
+		LeagueID: leagueID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return ports.Version(nextSeq), nil
+}
+
+func (s *LeagueStore) ReadAfter(ctx context.Context, after eventlog.Position, limit int) ([]eventlog.Entry[domain.LeagueEvent], error) {
+	rows, err := database.New(s.db).ListLeagueEventsAfter(ctx, database.ListLeagueEventsAfterParams{
+		After:     int64(after),
+		MaxEvents: int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]eventlog.Entry[domain.LeagueEvent], len(rows))
+	for i, row := range rows {
+		event, err := decodeLeagueEvent(row.EventType, row.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("league %v, sequence %v: %w", row.LeagueID, row.Sequence, err)
+		}
+
+		entries[i] = eventlog.Entry[domain.LeagueEvent]{
+			Position: eventlog.Position(row.Position),
+			Recorded: eventlog.Recorded[domain.LeagueEvent]{
+				Sequence: eventlog.Sequence(row.Sequence),
+				Event:    event,
+			},
+		}
+	}
+
+	return entries, nil
+}
+
+func (s *LeagueStore) NextLeagueID(ctx context.Context) (domain.LeagueID, error) {
+	id, err := database.New(s.db).NextLeagueID(ctx)
+	return domain.LeagueID(id), err
+}
+
+func (s *LeagueStore) NextTeamID(ctx context.Context) (domain.TeamID, error) {
+	id, err := database.New(s.db).NextTeamID(ctx)
+	return domain.TeamID(id), err
+}
+
+func NewLeagueStore(db DB) *LeagueStore {
+	return &LeagueStore{
+		db: db,
+	}
+}
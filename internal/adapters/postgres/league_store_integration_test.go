@@ -0,0 +1,116 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/spcameron/dugout/internal/adapters/postgres"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+func TestLeagueStore_Append(t *testing.T) {
+	store := postgres.NewLeagueStore(newTestPool(t))
+
+	t.Run("append then load round trips events in sequence order", func(t *testing.T) {
+		leagueID, err := store.NextLeagueID(t.Context())
+		require.NoError(t, err)
+		teamID, err := store.NextTeamID(t.Context())
+		require.NoError(t, err)
+
+		events := []domain.LeagueEvent{
+			domain.CreatedLeague{LeagueID: leagueID, Name: "Flushing Meadows", Commissioner: 1, Settings: domain.DefaultLeagueSettings(), CreatedAt: testkit.TodayLock()},
+			domain.JoinedLeague{LeagueID: leagueID, TeamID: teamID, TeamName: "Team A", Manager: 2, JoinedAt: testkit.TodayLock()},
+		}
+
+		version, err := store.Append(t.Context(), leagueID, events, 0)
+		require.NoError(t, err)
+		assert.Equal(t, version, ports.Version(2))
+
+		history, loaded, err := store.Load(t.Context(), leagueID)
+		require.NoError(t, err)
+		assert.Equal(t, loaded, version)
+		require.Equal(t, len(history), 2)
+
+		created, ok := history[0].Event.(domain.CreatedLeague)
+		require.True(t, ok)
+		assert.Equal(t, created.Settings, domain.DefaultLeagueSettings())
+	})
+
+	t.Run("stale expected version is a conflict", func(t *testing.T) {
+		leagueID, err := store.NextLeagueID(t.Context())
+		require.NoError(t, err)
+		event := domain.CreatedLeague{LeagueID: leagueID, Name: "Flushing Meadows", Commissioner: 1, Settings: domain.DefaultLeagueSettings(), CreatedAt: testkit.TodayLock()}
+
+		_, err = store.Append(t.Context(), leagueID, []domain.LeagueEvent{event}, 0)
+		require.NoError(t, err)
+
+		_, err = store.Append(t.Context(), leagueID, []domain.LeagueEvent{event}, 0)
+		assert.ErrorIs(t, err, ports.ErrVersionConflict)
+	})
+
+	t.Run("events for another league are rejected", func(t *testing.T) {
+		leagueID, err := store.NextLeagueID(t.Context())
+		require.NoError(t, err)
+		event := domain.CreatedLeague{LeagueID: leagueID + 1, Name: "Flushing Meadows", Commissioner: 1, Settings: domain.DefaultLeagueSettings(), CreatedAt: testkit.TodayLock()}
+
+		_, err = store.Append(t.Context(), leagueID, []domain.LeagueEvent{event}, 0)
+		assert.ErrorIs(t, err, domain.ErrWrongLeagueID)
+	})
+}
+
+func TestLeagueStore_ReadAfter(t *testing.T) {
+	store := postgres.NewLeagueStore(newTestPool(t))
+
+	t.Run("reads events across leagues in position order", func(t *testing.T) {
+		first, err := store.NextLeagueID(t.Context())
+		require.NoError(t, err)
+		second, err := store.NextLeagueID(t.Context())
+		require.NoError(t, err)
+
+		for _, id := range []domain.LeagueID{first, second} {
+			event := domain.CreatedLeague{LeagueID: id, Name: "Flushing Meadows", Commissioner: 1, Settings: domain.DefaultLeagueSettings(), CreatedAt: testkit.TodayLock()}
+			_, err := store.Append(t.Context(), id, []domain.LeagueEvent{event}, 0)
+			require.NoError(t, err)
+		}
+
+		entries, err := store.ReadAfter(t.Context(), 0, 100000)
+		require.NoError(t, err)
+
+		var positions []eventlog.Position
+		for _, entry := range entries {
+			if entry.Event.League() == first || entry.Event.League() == second {
+				positions = append(positions, entry.Position)
+			}
+		}
+
+		require.Equal(t, len(positions), 2)
+		assert.True(t, positions[0] < positions[1])
+
+		after, err := store.ReadAfter(t.Context(), positions[0], 100000)
+		require.NoError(t, err)
+		for _, entry := range after {
+			assert.True(t, entry.Position > positions[0])
+		}
+		assert.True(t, slices.ContainsFunc(after, func(entry eventlog.Entry[domain.LeagueEvent]) bool {
+			return entry.Position == positions[1]
+		}))
+	})
+}
+
+func TestLeagueStore_NextIDs(t *testing.T) {
+	store := postgres.NewLeagueStore(newTestPool(t))
+
+	first, err := store.NextTeamID(t.Context())
+	require.NoError(t, err)
+	second, err := store.NextTeamID(t.Context())
+	require.NoError(t, err)
+
+	assert.True(t, second > first)
+}
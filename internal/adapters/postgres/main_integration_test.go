@@ -0,0 +1,47 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/spcameron/dugout/internal/domain"
+)
+
+// newTestPool connects to the migrated test database as the application role,
+// using the same connection facts the Makefile loads from .env.
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	dsn := fmt.Sprintf(
+		"host=%s port=%s dbname=%s user=%s sslmode=%s",
+		os.Getenv("DB_HOST"),
+		os.Getenv("DB_PORT"),
+		os.Getenv("DB_NAME_TEST"),
+		os.Getenv("DB_USER_APP"),
+		os.Getenv("DB_SSLMODE"),
+	)
+
+	pool, err := pgxpool.New(context.Background(), dsn)
+	if err != nil {
+		t.Fatalf("connecting to test database: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	return pool
+}
+
+var teamSeed atomic.Int64
+
+// uniqueTeamID returns a TeamID that no other test run has written to, since the
+// application role cannot truncate event tables between runs.
+func uniqueTeamID() domain.TeamID {
+	return domain.TeamID(time.Now().UnixNano()/1000 + teamSeed.Add(1))
+}
@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/spcameron/dugout/internal/database"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// MembershipRepository stores league and team memberships in Postgres. A team
+// belongs to the league named by its first membership; Grant refuses team
+// memberships that name a different one.
+type MembershipRepository struct {
+	db DB
+}
+
+func (r *MembershipRepository) Grant(ctx context.Context, m domain.Membership) (err error) {
+	err = m.Validate()
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, ignoreTxClosed(tx.Rollback(ctx)))
+		}
+	}()
+
+	q := database.New(tx)
+	teamID := pgtype.Int8{Int64: int64(m.TeamID), Valid: m.TeamID != 0}
+
+	if teamID.Valid {
+		league, err := q.GetTeamLeague(ctx, teamID)
+		if err == nil && domain.LeagueID(league) != m.LeagueID {
+			return fmt.Errorf("%w: team %v plays in league %v", domain.ErrInvalidMembership, m.TeamID, league)
+		}
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+	}
+
+	err = q.GrantMembership(ctx, database.GrantMembershipParams{
+		UserID:   int64(m.UserID),
+		LeagueID: int64(m.LeagueID),
+		TeamID:   teamID,
+		Role:     m.Role.Code(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *MembershipRepository) ListForUser(ctx context.Context, id domain.UserID) (domain.Memberships, error) {
+	rows, err := database.New(r.db).ListMembershipsForUser(ctx, int64(id))
+	if err != nil {
+		return nil, err
+	}
+
+	memberships := make(domain.Memberships, len(rows))
+	for i, row := range rows {
+		role, err := domain.ParseMembershipRole(row.Role)
+		if err != nil {
+			return nil, fmt.Errorf("user %v: %w", id, err)
+		}
+
+		memberships[i] = domain.Membership{
+			UserID:   domain.UserID(row.UserID),
+			LeagueID: domain.LeagueID(row.LeagueID),
+			TeamID:   domain.TeamID(row.TeamID.Int64),
+			Role:     role,
+		}
+	}
+
+	return memberships, nil
+}
+
+func (r *MembershipRepository) LeagueOf(ctx context.Context, team domain.TeamID) (domain.LeagueID, error) {
+	league, err := database.New(r.db).GetTeamLeague(ctx, pgtype.Int8{Int64: int64(team), Valid: true})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, fmt.Errorf("%w: %v", ports.ErrTeamNotFound, team)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return domain.LeagueID(league), nil
+}
+
+func (r *MembershipRepository) ManagersOf(ctx context.Context, team domain.TeamID) ([]domain.UserID, error) {
+	rows, err := database.New(r.db).ListTeamManagers(ctx, pgtype.Int8{Int64: int64(team), Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	managers := make([]domain.UserID, len(rows))
+	for i, id := range rows {
+		managers[i] = domain.UserID(id)
+	}
+
+	return managers, nil
+}
+
+func NewMembershipRepository(db DB) *MembershipRepository {
+	return &MembershipRepository{
+		db: db,
+	}
+}
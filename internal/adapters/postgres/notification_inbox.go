@@ -0,0 +1,77 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/spcameron/dugout/internal/database"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// NotificationInbox keeps in-app notifications in the notifications table, one
+// row per user and key.
+type NotificationInbox struct {
+	db DB
+}
+
+func (i *NotificationInbox) Notify(ctx context.Context, n ports.Notification) error {
+	return database.New(i.db).InsertNotification(ctx, database.InsertNotificationParams{
+		UserID:    int64(n.UserID),
+		Key:       n.Key,
+		Kind:      n.Kind.Code(),
+		Subject:   n.Subject,
+		Body:      n.Body,
+		CreatedAt: pgtype.Timestamptz{Time: n.CreatedAt, Valid: true},
+	})
+}
+
+func (i *NotificationInbox) List(ctx context.Context, user domain.UserID, limit int) ([]ports.InboxEntry, error) {
+	rows, err := database.New(i.db).ListNotifications(ctx, database.ListNotificationsParams{
+		UserID:           int64(user),
+		MaxNotifications: int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]ports.InboxEntry, len(rows))
+	for j, row := range rows {
+		kind, err := domain.ParseNotificationKind(row.Kind)
+		if err != nil {
+			return nil, fmt.Errorf("user %v, notification %q: %w", user, row.Key, err)
+		}
+
+		entries[j] = ports.InboxEntry{
+			Notification: ports.Notification{
+				Key:       row.Key,
+				UserID:    domain.UserID(row.UserID),
+				Kind:      kind,
+				Subject:   row.Subject,
+				Body:      row.Body,
+				CreatedAt: row.CreatedAt.Time,
+			},
+			ReadAt: row.ReadAt.Time,
+		}
+	}
+
+	return entries, nil
+}
+
+// MarkRead keeps the time a notification was first read.
+func (i *NotificationInbox) MarkRead(ctx context.Context, user domain.UserID, key string, at time.Time) error {
+	return database.New(i.db).MarkNotificationRead(ctx, database.MarkNotificationReadParams{
+		ReadAt: pgtype.Timestamptz{Time: at, Valid: true},
+		UserID: int64(user),
+		Key:    key,
+	})
+}
+
+func NewNotificationInbox(db DB) *NotificationInbox {
+	return &NotificationInbox{
+		db: db,
+	}
+}
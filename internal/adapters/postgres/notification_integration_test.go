@@ -0,0 +1,101 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/adapters/postgres"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+)
+
+func TestNotifications(t *testing.T) {
+	pool := newTestPool(t)
+	users := postgres.NewUserRepository(pool)
+	inbox := postgres.NewNotificationInbox(pool)
+	preferences := postgres.NewNotificationPreferences(pool)
+
+	user, err := users.Create(t.Context(), domain.User{
+		Email:       fmt.Sprintf("notified-%d@example.com", uniqueTeamID()),
+		DisplayName: "Manager",
+	}, []byte("hash"))
+	require.NoError(t, err)
+
+	created := time.Now().Truncate(time.Microsecond)
+	notification := func(key string, at time.Time) ports.Notification {
+		return ports.Notification{
+			Key:       key,
+			UserID:    user.ID,
+			Kind:      domain.NotifyCommissionerDrop,
+			Subject:   "Player 7 was dropped from your roster",
+			Body:      "A commissioner dropped Player 7.",
+			CreatedAt: at,
+		}
+	}
+
+	t.Run("inbox keeps one entry per key, newest first", func(t *testing.T) {
+		require.NoError(t, inbox.Notify(t.Context(), notification("roster/1/2/drop/7", created)))
+		require.NoError(t, inbox.Notify(t.Context(), notification("roster/1/2/drop/7", created)))
+		require.NoError(t, inbox.Notify(t.Context(), notification("roster/1/5/drop/8", created.Add(time.Minute))))
+
+		entries, err := inbox.List(t.Context(), user.ID, 10)
+		require.NoError(t, err)
+		require.Equal(t, len(entries), 2)
+		assert.Equal(t, entries[0].Key, "roster/1/5/drop/8")
+		assert.Equal(t, entries[1].Kind, domain.NotifyCommissionerDrop)
+		assert.True(t, entries[1].CreatedAt.Equal(created))
+		assert.True(t, entries[1].ReadAt.IsZero())
+	})
+
+	t.Run("marking read keeps the first read time", func(t *testing.T) {
+		first := created.Add(time.Hour)
+		require.NoError(t, inbox.MarkRead(t.Context(), user.ID, "roster/1/2/drop/7", first))
+		require.NoError(t, inbox.MarkRead(t.Context(), user.ID, "roster/1/2/drop/7", first.Add(time.Hour)))
+
+		entries, err := inbox.List(t.Context(), user.ID, 10)
+		require.NoError(t, err)
+		require.Equal(t, len(entries), 2)
+		assert.True(t, entries[1].ReadAt.Equal(first))
+	})
+
+	t.Run("channels are on until turned off", func(t *testing.T) {
+		enabled, err := preferences.Enabled(t.Context(), user.ID, domain.ChannelEmail)
+		require.NoError(t, err)
+		assert.True(t, enabled)
+
+		require.NoError(t, preferences.SetEnabled(t.Context(), user.ID, domain.ChannelEmail, false))
+
+		enabled, err = preferences.Enabled(t.Context(), user.ID, domain.ChannelEmail)
+		require.NoError(t, err)
+		assert.False(t, enabled)
+
+		enabled, err = preferences.Enabled(t.Context(), user.ID, domain.ChannelInApp)
+		require.NoError(t, err)
+		assert.True(t, enabled)
+	})
+
+	t.Run("handled messages are remembered per subscriber", func(t *testing.T) {
+		handled := postgres.NewHandledMessages(pool)
+		key := fmt.Sprintf("roster/%d/3", uniqueTeamID())
+
+		seen, err := handled.Handled(t.Context(), "notifications", key)
+		require.NoError(t, err)
+		assert.False(t, seen)
+
+		require.NoError(t, handled.MarkHandled(t.Context(), "notifications", key, created))
+		require.NoError(t, handled.MarkHandled(t.Context(), "notifications", key, created.Add(time.Hour)))
+
+		seen, err = handled.Handled(t.Context(), "notifications", key)
+		require.NoError(t, err)
+		assert.True(t, seen)
+
+		seen, err = handled.Handled(t.Context(), "feed", key)
+		require.NoError(t, err)
+		assert.False(t, seen)
+	})
+}
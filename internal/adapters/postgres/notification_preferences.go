@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/spcameron/dugout/internal/database"
+	"github.com/spcameron/dugout/internal/domain"
+)
+
+// NotificationPreferences stores a row only for the channels a user has set.
+type NotificationPreferences struct {
+	db DB
+}
+
+func (p *NotificationPreferences) Enabled(ctx context.Context, user domain.UserID, channel domain.NotificationChannel) (bool, error) {
+	enabled, err := database.New(p.db).GetNotificationPreference(ctx, database.GetNotificationPreferenceParams{
+		UserID:  int64(user),
+		Channel: channel.Code(),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return enabled, nil
+}
+
+func (p *NotificationPreferences) SetEnabled(ctx context.Context, user domain.UserID, channel domain.NotificationChannel, enabled bool) error {
+	return database.New(p.db).SetNotificationPreference(ctx, database.SetNotificationPreferenceParams{
+		UserID:  int64(user),
+		Channel: channel.Code(),
+		Enabled: enabled,
+	})
+}
+
+func NewNotificationPreferences(db DB) *NotificationPreferences {
+	return &NotificationPreferences{
+		db: db,
+	}
+}
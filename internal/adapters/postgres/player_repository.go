@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/spcameron/dugout/internal/database"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// PlayerRepository stores the MLB player catalog in Postgres, keyed by MLB player ID.
+type PlayerRepository struct {
+	db DB
+}
+
+func (r *PlayerRepository) Get(ctx context.Context, id domain.PlayerID) (domain.Player, error) {
+	row, err := database.New(r.db).GetPlayer(ctx, int64(id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.Player{}, fmt.Errorf("%w: %v", ports.ErrPlayerNotFound, id)
+	}
+	if err != nil {
+		return domain.Player{}, err
+	}
+
+	return playerFromRow(row.ID, row.MlbID, row.Name, row.Roles, row.Position, row.MlbTeam)
+}
+
+// Upsert inserts new players and updates the name, role, position, and team of
+// existing ones in a single transaction. Unchanged rows are left untouched.
+func (r *PlayerRepository) Upsert(ctx context.Context, players []domain.Player) (_ []domain.Player, err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, ignoreTxClosed(tx.Rollback(ctx)))
+		}
+	}()
+
+	q := database.New(tx)
+	stored := make([]domain.Player, len(players))
+
+	for i, p := range players {
+		id, err := q.UpsertPlayer(ctx, database.UpsertPlayerParams{
+			MlbID:    int64(p.MLBID),
+			Name:     p.Name,
+			Roles:    p.Roles.Codes(),
+			Position: string(p.Position),
+			MlbTeam:  p.MLBTeam,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			id, err = q.GetPlayerIDByMLBID(ctx, int64(p.MLBID))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("MLB ID %v: %w", p.MLBID, err)
+		}
+
+		p.ID = domain.PlayerID(id)
+		stored[i] = p
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return stored, nil
+}
+
+func (r *PlayerRepository) Search(ctx context.Context, query ports.PlayerQuery) ([]domain.Player, error) {
+	rows, err := database.New(r.db).SearchPlayers(ctx, database.SearchPlayersParams{
+		NamePrefix: escapeLike(strings.ToLower(query.NamePrefix)),
+		Position:   string(query.Position),
+		MlbTeam:    query.MLBTeam,
+		MaxResults: int32(query.MaxResults()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	players := make([]domain.Player, len(rows))
+	for i, row := range rows {
+		players[i], err = playerFromRow(row.ID, row.MlbID, row.Name, row.Roles, row.Position, row.MlbTeam)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return players, nil
+}
+
+func NewPlayerRepository(db DB) *PlayerRepository {
+	return &PlayerRepository{
+		db: db,
+	}
+}
+
+func playerFromRow(id, mlbID int64, name string, roleCodes []string, position, mlbTeam string) (domain.Player, error) {
+	roles, err := domain.ParseRoleCodes(roleCodes)
+	if err != nil {
+		return domain.Player{}, fmt.Errorf("player %v: %w", id, err)
+	}
+
+	return domain.Player{
+		ID:       domain.PlayerID(id),
+		MLBID:    domain.MLBPlayerID(mlbID),
+		Name:     name,
+		Roles:    roles,
+		Position: domain.Position(position),
+		MLBTeam:  mlbTeam,
+	}, nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike makes s safe to use as a literal LIKE prefix.
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
@@ -0,0 +1,72 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/spcameron/dugout/internal/adapters/postgres"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+)
+
+func TestPlayerRepository(t *testing.T) {
+	repo := postgres.NewPlayerRepository(newTestPool(t))
+
+	mlbID := domain.MLBPlayerID(uniqueTeamID())
+	team := fmt.Sprintf("T%d", mlbID%100000)
+
+	stored, err := repo.Upsert(t.Context(), []domain.Player{
+		{MLBID: mlbID, Name: "Zed_Test Player", Roles: domain.MustRoleSet(domain.RoleHitter), Position: "SS", MLBTeam: team},
+	})
+	require.NoError(t, err)
+	require.Equal(t, len(stored), 1)
+	require.True(t, stored[0].ID > 0)
+
+	t.Run("get returns the stored player", func(t *testing.T) {
+		got, err := repo.Get(t.Context(), stored[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, got, stored[0])
+	})
+
+	t.Run("upsert by MLB ID keeps the player ID and applies role changes", func(t *testing.T) {
+		changed := stored[0]
+		changed.Roles = domain.MustRoleSet(domain.RoleHitter, domain.RolePitcher)
+
+		again, err := repo.Upsert(t.Context(), []domain.Player{changed})
+		require.NoError(t, err)
+		assert.Equal(t, again[0].ID, stored[0].ID)
+
+		unchanged, err := repo.Upsert(t.Context(), []domain.Player{changed})
+		require.NoError(t, err)
+		assert.Equal(t, unchanged[0].ID, stored[0].ID)
+
+		got, err := repo.Get(t.Context(), stored[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, got.Roles, domain.MustRoleSet(domain.RoleHitter, domain.RolePitcher))
+	})
+
+	t.Run("search treats LIKE wildcards in the prefix literally", func(t *testing.T) {
+		found, err := repo.Search(t.Context(), ports.PlayerQuery{NamePrefix: "zed_t", MLBTeam: team})
+		require.NoError(t, err)
+		assert.Equal(t, len(found), 1)
+
+		found, err = repo.Search(t.Context(), ports.PlayerQuery{NamePrefix: "zed%", MLBTeam: team})
+		require.NoError(t, err)
+		assert.Equal(t, len(found), 0)
+	})
+
+	t.Run("search with a negative limit returns the default page", func(t *testing.T) {
+		found, err := repo.Search(t.Context(), ports.PlayerQuery{MLBTeam: team, Limit: -1})
+		require.NoError(t, err)
+		assert.Equal(t, len(found), 1)
+	})
+
+	t.Run("get unknown player returns ErrPlayerNotFound", func(t *testing.T) {
+		_, err := repo.Get(t.Context(), -1)
+		assert.ErrorIs(t, err, ports.ErrPlayerNotFound)
+	})
+}
@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/spcameron/dugout/internal/database"
+	"github.com/spcameron/dugout/internal/eventlog"
+)
+
+// ProjectionCheckpoints keeps one row per projection in projection_checkpoints.
+type ProjectionCheckpoints struct {
+	db DB
+}
+
+func (c *ProjectionCheckpoints) Checkpoint(ctx context.Context, projection string) (eventlog.Position, error) {
+	pos, err := database.New(c.db).GetProjectionCheckpoint(ctx, projection)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return eventlog.Position(pos), nil
+}
+
+func (c *ProjectionCheckpoints) SaveCheckpoint(ctx context.Context, projection string, pos eventlog.Position) error {
+	return database.New(c.db).SaveProjectionCheckpoint(ctx, database.SaveProjectionCheckpointParams{
+		Projection: projection,
+		Position:   int64(pos),
+	})
+}
+
+func NewProjectionCheckpoints(db DB) *ProjectionCheckpoints {
+	return &ProjectionCheckpoints{
+		db: db,
+	}
+}
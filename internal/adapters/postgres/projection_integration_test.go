@@ -0,0 +1,129 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/adapters/postgres"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+func TestRosterStore_ReadAfter(t *testing.T) {
+	pool := newTestPool(t)
+	store := postgres.NewRosterStore(pool)
+
+	// The log is shared with earlier runs, so each test reads from the end of it
+	// and keeps only its own teams' events.
+	read := func(t *testing.T, after eventlog.Position, teams ...domain.TeamID) []eventlog.Entry[domain.RosterEvent] {
+		t.Helper()
+
+		all, err := store.ReadAfter(t.Context(), after, 100000)
+		require.NoError(t, err)
+
+		var mine []eventlog.Entry[domain.RosterEvent]
+		for _, entry := range all {
+			for _, team := range teams {
+				if entry.Event.Team() == team {
+					mine = append(mine, entry)
+				}
+			}
+		}
+
+		return mine
+	}
+
+	end := func(t *testing.T) eventlog.Position {
+		t.Helper()
+
+		var pos int64
+		err := pool.QueryRow(t.Context(), "SELECT coalesce(max(position), 0) FROM roster_events").Scan(&pos)
+		require.NoError(t, err)
+
+		return eventlog.Position(pos)
+	}
+
+	t.Run("reads every stream in commit order", func(t *testing.T) {
+		start := end(t)
+		teamA, teamB := uniqueTeamID(), uniqueTeamID()
+
+		_, err := store.Append(t.Context(), teamA, []domain.RosterEvent{
+			domain.AddedPlayerToRoster{TeamID: teamA, PlayerID: 1, EffectiveAt: testkit.TodayLock()},
+		}, 0)
+		require.NoError(t, err)
+		_, err = store.Append(t.Context(), teamB, []domain.RosterEvent{
+			domain.AddedPlayerToRoster{TeamID: teamB, PlayerID: 2, EffectiveAt: testkit.TodayLock()},
+		}, 0)
+		require.NoError(t, err)
+
+		entries := read(t, start, teamA, teamB)
+		require.Equal(t, len(entries), 2)
+		assert.Equal(t, entries[0].Event.Team(), teamA)
+		assert.Equal(t, entries[0].Sequence, eventlog.Sequence(1))
+		assert.Equal(t, entries[1].Event.Team(), teamB)
+		assert.True(t, entries[0].Position < entries[1].Position)
+	})
+
+	t.Run("a later append waits for an earlier one to commit", func(t *testing.T) {
+		start := end(t)
+		slow, fast := uniqueTeamID(), uniqueTeamID()
+
+		tx, err := pool.Begin(t.Context())
+		require.NoError(t, err)
+		defer tx.Rollback(t.Context())
+
+		_, err = postgres.NewRosterStore(tx).Append(t.Context(), slow, []domain.RosterEvent{
+			domain.AddedPlayerToRoster{TeamID: slow, PlayerID: 1, EffectiveAt: testkit.TodayLock()},
+		}, 0)
+		require.NoError(t, err)
+
+		appended := make(chan error, 1)
+		go func() {
+			_, err := store.Append(t.Context(), fast, []domain.RosterEvent{
+				domain.AddedPlayerToRoster{TeamID: fast, PlayerID: 2, EffectiveAt: testkit.TodayLock()},
+			}, 0)
+			appended <- err
+		}()
+
+		select {
+		case err := <-appended:
+			t.Fatalf("append finished before the earlier one committed: %v", err)
+		case <-time.After(100 * time.Millisecond):
+		}
+		assert.Equal(t, len(read(t, start, slow, fast)), 0)
+
+		require.NoError(t, tx.Commit(t.Context()))
+		require.NoError(t, <-appended)
+
+		entries := read(t, start, slow, fast)
+		require.Equal(t, len(entries), 2)
+		assert.Equal(t, entries[0].Event.Team(), slow)
+		assert.Equal(t, entries[1].Event.Team(), fast)
+
+		last, err := store.LastPosition(t.Context())
+		require.NoError(t, err)
+		assert.True(t, last >= entries[1].Position)
+	})
+}
+
+func TestProjectionCheckpoints(t *testing.T) {
+	checkpoints := postgres.NewProjectionCheckpoints(newTestPool(t))
+	name := fmt.Sprintf("test-%d", uniqueTeamID())
+
+	pos, err := checkpoints.Checkpoint(t.Context(), name)
+	require.NoError(t, err)
+	assert.Equal(t, pos, eventlog.Position(0))
+
+	require.NoError(t, checkpoints.SaveCheckpoint(t.Context(), name, 42))
+	require.NoError(t, checkpoints.SaveCheckpoint(t.Context(), name, 57))
+
+	pos, err = checkpoints.Checkpoint(t.Context(), name)
+	require.NoError(t, err)
+	assert.Equal(t, pos, eventlog.Position(57))
+}
@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spcameron/dugout/internal/domain"
+)
+
+const (
+	eventTypeAddedPlayerToRoster       = "AddedPlayerToRoster"
+	eventTypeRemovedPlayerFromRoster   = "RemovedPlayerFromRoster"
+	eventTypeActivatedPlayerOnRoster   = "ActivatedPlayerOnRoster"
+	eventTypeInactivatedPlayerOnRoster = "InactivatedPlayerOnRoster"
+	eventTypeRecordedRosterOverride    = "RecordedRosterOverride"
+	eventTypeAwardedWaiverClaim        = "AwardedWaiverClaim"
+	eventTypeReceivedInTrade           = "ReceivedInTrade"
+)
+
+// encodeRosterEvent returns the stored type name and JSON payload for a roster event.
+func encodeRosterEvent(event domain.RosterEvent) (string, []byte, error) {
+	var eventType string
+	switch event.(type) {
+	case domain.AddedPlayerToRoster:
+		eventType = eventTypeAddedPlayerToRoster
+	case domain.RemovedPlayerFromRoster:
+		eventType = eventTypeRemovedPlayerFromRoster
+	case domain.ActivatedPlayerOnRoster:
+		eventType = eventTypeActivatedPlayerOnRoster
+	case domain.InactivatedPlayerOnRoster:
+		eventType = eventTypeInactivatedPlayerOnRoster
+	case domain.RecordedRosterOverride:
+		eventType = eventTypeRecordedRosterOverride
+	case domain.AwardedWaiverClaim:
+		eventType = eventTypeAwardedWaiverClaim
+	case domain.ReceivedInTrade:
+		eventType = eventTypeReceivedInTrade
+	default:
+		return "", nil, fmt.Errorf("%w: %T", domain.ErrUnrecognizedRosterEvent, event)
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return eventType, payload, nil
+}
+
+// decodeRosterEvent rebuilds a roster event from its stored type name and JSON payload.
+func decodeRosterEvent(eventType string, payload []byte) (domain.RosterEvent, error) {
+	switch eventType {
+	case eventTypeAddedPlayerToRoster:
+		return decodeAs[domain.AddedPlayerToRoster](payload)
+	case eventTypeRemovedPlayerFromRoster:
+		return decodeAs[domain.RemovedPlayerFromRoster](payload)
+	case eventTypeActivatedPlayerOnRoster:
+		return decodeAs[domain.ActivatedPlayerOnRoster](payload)
+	case eventTypeInactivatedPlayerOnRoster:
+		return decodeAs[domain.InactivatedPlayerOnRoster](payload)
+	case eventTypeRecordedRosterOverride:
+		return decodeAs[domain.RecordedRosterOverride](payload)
+	case eventTypeAwardedWaiverClaim:
+		return decodeAs[domain.AwardedWaiverClaim](payload)
+	case eventTypeReceivedInTrade:
+		return decodeAs[domain.ReceivedInTrade](payload)
+	default:
+		return nil, fmt.Errorf("%w: %q", domain.ErrUnrecognizedRosterEvent, eventType)
+	}
+}
+
+func decodeAs[E domain.RosterEvent](payload []byte) (domain.RosterEvent, error) {
+	var event E
+	err := json.Unmarshal(payload, &event)
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
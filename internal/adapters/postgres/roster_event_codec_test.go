@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+func TestRosterEventCodec(t *testing.T) {
+	testCases := []struct {
+		name     string
+		event    domain.RosterEvent
+		wantType string
+	}{
+		{
+			name: "round trips AddedPlayerToRoster",
+			event: domain.AddedPlayerToRoster{
+				TeamID:      testkit.TeamA(),
+				PlayerID:    1,
+				Eligibility: domain.MustRoleSet(domain.RolePitcher),
+				EffectiveAt: testkit.TodayLock(),
+			},
+			wantType: eventTypeAddedPlayerToRoster,
+		},
+		{
+			name: "round trips RemovedPlayerFromRoster",
+			event: domain.RemovedPlayerFromRoster{
+				TeamID:      testkit.TeamA(),
+				PlayerID:    1,
+				EffectiveAt: testkit.TodayLock(),
+			},
+			wantType: eventTypeRemovedPlayerFromRoster,
+		},
+		{
+			name: "round trips ActivatedPlayerOnRoster",
+			event: domain.ActivatedPlayerOnRoster{
+				TeamID:      testkit.TeamA(),
+				PlayerID:    1,
+				PlayerRole:  domain.RolePitcher,
+				EffectiveAt: testkit.TodayLock(),
+			},
+			wantType: eventTypeActivatedPlayerOnRoster,
+		},
+		{
+			name: "round trips InactivatedPlayerOnRoster",
+			event: domain.InactivatedPlayerOnRoster{
+				TeamID:      testkit.TeamA(),
+				PlayerID:    1,
+				EffectiveAt: testkit.TodayLock(),
+			},
+			wantType: eventTypeInactivatedPlayerOnRoster,
+		},
+		{
+			name: "round trips RecordedRosterOverride",
+			event: domain.RecordedRosterOverride{
+				TeamID:      testkit.TeamA(),
+				Actor:       7,
+				Kind:        domain.OverrideReversal,
+				Reason:      "entered the wrong player",
+				Reverses:    3,
+				EffectiveAt: testkit.TodayLock(),
+			},
+			wantType: eventTypeRecordedRosterOverride,
+		},
+		{
+			name: "round trips AwardedWaiverClaim",
+			event: domain.AwardedWaiverClaim{
+				TeamID:      testkit.TeamA(),
+				PlayerID:    1,
+				EffectiveAt: testkit.TodayLock(),
+			},
+			wantType: eventTypeAwardedWaiverClaim,
+		},
+		{
+			name: "round trips ReceivedInTrade",
+			event: domain.ReceivedInTrade{
+				TeamID:      testkit.TeamA(),
+				PlayerID:    1,
+				TradeID:     5,
+				EffectiveAt: testkit.TodayLock(),
+			},
+			wantType: eventTypeReceivedInTrade,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			eventType, payload, err := encodeRosterEvent(tc.event)
+			require.NoError(t, err)
+			assert.Equal(t, eventType, tc.wantType)
+
+			decoded, err := decodeRosterEvent(eventType, payload)
+			require.NoError(t, err)
+
+			assert.Equal(t, decoded.Team(), tc.event.Team())
+			assert.True(t, decoded.OccurredAt().Equal(tc.event.OccurredAt()))
+
+			reencodedType, reencoded, err := encodeRosterEvent(decoded)
+			require.NoError(t, err)
+			assert.Equal(t, reencodedType, eventType)
+			assert.Equal(t, string(reencoded), string(payload))
+		})
+	}
+
+	t.Run("decode rejects unknown event type", func(t *testing.T) {
+		event, err := decodeRosterEvent("TradedPlayer", []byte(`{}`))
+
+		assert.Nil(t, event)
+		assert.ErrorIs(t, err, domain.ErrUnrecognizedRosterEvent)
+	})
+}
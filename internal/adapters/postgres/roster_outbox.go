@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/spcameron/dugout/internal/database"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// RosterOutbox reads the outbox rows RosterStore writes alongside each event and
+// records their delivery in roster_outbox_deliveries, one row per subscriber.
+type RosterOutbox struct {
+	db DB
+}
+
+func (o *RosterOutbox) Pending(ctx context.Context, subscriber string, now time.Time, limit int) ([]ports.OutboxMessage, error) {
+	rows, err := database.New(o.db).ListPendingRosterOutbox(ctx, database.ListPendingRosterOutboxParams{
+		Subscriber:  subscriber,
+		Now:         pgtype.Timestamptz{Time: now, Valid: true},
+		MaxMessages: int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]ports.OutboxMessage, len(rows))
+	for i, row := range rows {
+		event, err := decodeRosterEvent(row.EventType, row.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("team %v, sequence %v: %w", row.TeamID, row.Sequence, err)
+		}
+
+		messages[i] = ports.OutboxMessage{
+			ID:       ports.OutboxID(row.ID),
+			TeamID:   domain.TeamID(row.TeamID),
+			Sequence: eventlog.Sequence(row.Sequence),
+			Event:    event,
+			Attempts: int(row.Attempts),
+		}
+	}
+
+	return messages, nil
+}
+
+func (o *RosterOutbox) MarkDelivered(ctx context.Context, subscriber string, id ports.OutboxID, at time.Time) error {
+	return database.New(o.db).MarkRosterOutboxDelivered(ctx, database.MarkRosterOutboxDeliveredParams{
+		OutboxID:    int64(id),
+		Subscriber:  subscriber,
+		DeliveredAt: pgtype.Timestamptz{Time: at, Valid: true},
+	})
+}
+
+func (o *RosterOutbox) Retry(ctx context.Context, subscriber string, id ports.OutboxID, retryAt time.Time, cause string) error {
+	return database.New(o.db).RecordRosterOutboxFailure(ctx, database.RecordRosterOutboxFailureParams{
+		OutboxID:      int64(id),
+		Subscriber:    subscriber,
+		NextAttemptAt: pgtype.Timestamptz{Time: retryAt, Valid: true},
+		LastError:     pgtype.Text{String: cause, Valid: true},
+	})
+}
+
+func (o *RosterOutbox) DeadLetter(ctx context.Context, subscriber string, id ports.OutboxID, at time.Time, cause string) error {
+	return database.New(o.db).RecordRosterOutboxFailure(ctx, database.RecordRosterOutboxFailureParams{
+		OutboxID:       int64(id),
+		Subscriber:     subscriber,
+		NextAttemptAt:  pgtype.Timestamptz{Time: at, Valid: true},
+		DeadLetteredAt: pgtype.Timestamptz{Time: at, Valid: true},
+		LastError:      pgtype.Text{String: cause, Valid: true},
+	})
+}
+
+func NewRosterOutbox(db DB) *RosterOutbox {
+	return &RosterOutbox{
+		db: db,
+	}
+}
@@ -0,0 +1,102 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/adapters/postgres"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+func TestRosterOutbox(t *testing.T) {
+	pool := newTestPool(t)
+	store := postgres.NewRosterStore(pool)
+	outbox := postgres.NewRosterOutbox(pool)
+	now := time.Now()
+
+	// The outbox is shared with earlier runs, so each test reads only its own
+	// team's messages.
+	pending := func(t *testing.T, subscriber string, teamID domain.TeamID, at time.Time) []ports.OutboxMessage {
+		t.Helper()
+
+		all, err := outbox.Pending(t.Context(), subscriber, at, 100000)
+		require.NoError(t, err)
+
+		var mine []ports.OutboxMessage
+		for _, msg := range all {
+			if msg.TeamID == teamID {
+				mine = append(mine, msg)
+			}
+		}
+
+		return mine
+	}
+
+	appendTwo := func(t *testing.T) domain.TeamID {
+		t.Helper()
+
+		teamID := uniqueTeamID()
+		_, err := store.Append(t.Context(), teamID, []domain.RosterEvent{
+			domain.AddedPlayerToRoster{TeamID: teamID, PlayerID: 1, EffectiveAt: testkit.TodayLock()},
+			domain.AddedPlayerToRoster{TeamID: teamID, PlayerID: 2, EffectiveAt: testkit.TodayLock()},
+		}, 0)
+		require.NoError(t, err)
+
+		return teamID
+	}
+
+	t.Run("append writes a message for every event", func(t *testing.T) {
+		teamID := appendTwo(t)
+
+		messages := pending(t, fmt.Sprintf("sub-%d", teamID), teamID, now)
+		require.Equal(t, len(messages), 2)
+		assert.Equal(t, messages[0].Sequence, eventlog.Sequence(1))
+		assert.Equal(t, messages[1].Sequence, eventlog.Sequence(2))
+		assert.True(t, messages[0].ID < messages[1].ID)
+		assert.Equal(t, messages[0].Attempts, 0)
+
+		_, ok := messages[1].Event.(domain.AddedPlayerToRoster)
+		assert.True(t, ok)
+	})
+
+	t.Run("conflicting append writes no messages", func(t *testing.T) {
+		teamID := appendTwo(t)
+
+		_, err := store.Append(t.Context(), teamID, []domain.RosterEvent{
+			domain.RemovedPlayerFromRoster{TeamID: teamID, PlayerID: 1, EffectiveAt: testkit.TodayLock()},
+		}, 0)
+		require.ErrorIs(t, err, ports.ErrVersionConflict)
+
+		assert.Equal(t, len(pending(t, fmt.Sprintf("sub-%d", teamID), teamID, now)), 2)
+	})
+
+	t.Run("delivery state is kept per subscriber", func(t *testing.T) {
+		teamID := appendTwo(t)
+		feed, alerts := fmt.Sprintf("feed-%d", teamID), fmt.Sprintf("alerts-%d", teamID)
+
+		messages := pending(t, feed, teamID, now)
+		require.Equal(t, len(messages), 2)
+
+		require.NoError(t, outbox.MarkDelivered(t.Context(), feed, messages[0].ID, now))
+		require.NoError(t, outbox.Retry(t.Context(), feed, messages[1].ID, now.Add(time.Minute), "unavailable"))
+
+		assert.Equal(t, len(pending(t, feed, teamID, now)), 0)
+		assert.Equal(t, len(pending(t, alerts, teamID, now)), 2)
+
+		due := pending(t, feed, teamID, now.Add(time.Minute))
+		require.Equal(t, len(due), 1)
+		assert.Equal(t, due[0].ID, messages[1].ID)
+		assert.Equal(t, due[0].Attempts, 1)
+
+		require.NoError(t, outbox.DeadLetter(t.Context(), feed, messages[1].ID, now, "still unavailable"))
+		assert.Equal(t, len(pending(t, feed, teamID, now.Add(24*time.Hour))), 0)
+	})
+}
@@ -0,0 +1,310 @@
+package postgres
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/spcameron/dugout/internal/database"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// DB is satisfied by *pgxpool.Pool and *pgx.Conn.
+type DB interface {
+	database.DBTX
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// RosterStore persists roster event streams in Postgres.
+//
+// Each team's stream version is tracked in roster_streams, and appends lock that
+// row for the duration of the transaction so concurrent writers cannot interleave.
+// Every appended event also gets a roster_outbox row in the same transaction, for
+// RosterOutbox to deliver once the append commits.
+//
+// Appends to different streams also take one advisory lock until they commit,
+// so that events are given log positions in commit order. A reader that has
+// seen a position has then seen every position before it.
+type RosterStore struct {
+	db DB
+}
+
+func (s *RosterStore) Load(ctx context.Context, id domain.TeamID) ([]eventlog.Recorded[domain.RosterEvent], ports.Version, error) {
+	rows, err := database.New(s.db).ListRosterEvents(ctx, int64(id))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	history := make([]eventlog.Recorded[domain.RosterEvent], len(rows))
+	for i, row := range rows {
+		event, err := decodeRosterEvent(row.EventType, row.Payload)
+		if err != nil {
+			return nil, 0, fmt.Errorf("team %v, sequence %v: %w", id, row.Sequence, err)
+		}
+
+		history[i] = eventlog.Recorded[domain.RosterEvent]{
+			Sequence: eventlog.Sequence(row.Sequence),
+			Event:    event,
+		}
+	}
+
+	var lastSeq eventlog.Sequence
+	if len(history) > 0 {
+		lastSeq = history[len(history)-1].Sequence
+	}
+
+	return history, ports.Version(lastSeq), nil
+}
+
+func (s *RosterStore) ReadAfter(ctx context.Context, after eventlog.Position, limit int) ([]eventlog.Entry[domain.RosterEvent], error) {
+	rows, err := database.New(s.db).ListRosterEventsAfter(ctx, database.ListRosterEventsAfterParams{
+		After:     int64(after),
+		MaxEvents: int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]eventlog.Entry[domain.RosterEvent], len(rows))
+	for i, row := range rows {
+		event, err := decodeRosterEvent(row.EventType, row.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("team %v, sequence %v: %w", row.TeamID, row.Sequence, err)
+		}
+
+		entries[i] = eventlog.Entry[domain.RosterEvent]{
+			Position: eventlog.Position(row.Position),
+			Recorded: eventlog.Recorded[domain.RosterEvent]{
+				Sequence: eventlog.Sequence(row.Sequence),
+				Event:    event,
+			},
+		}
+	}
+
+	return entries, nil
+}
+
+func (s *RosterStore) LastPosition(ctx context.Context) (eventlog.Position, error) {
+	pos, err := database.New(s.db).GetLastRosterEventPosition(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return eventlog.Position(pos), nil
+}
+
+func (s *RosterStore) Streams(ctx context.Context) ([]ports.StreamVersion, error) {
+	rows, err := database.New(s.db).ListRosterStreams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	streams := make([]ports.StreamVersion, len(rows))
+	for i, row := range rows {
+		streams[i] = ports.StreamVersion{
+			TeamID:  domain.TeamID(row.TeamID),
+			Version: ports.Version(row.Version),
+		}
+	}
+
+	return streams, nil
+}
+
+// ResetVersion takes the stream's row lock, as appends do, so it cannot land in
+// the middle of one.
+func (s *RosterStore) ResetVersion(ctx context.Context, team domain.TeamID, version ports.Version) (err error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, ignoreTxClosed(tx.Rollback(ctx)))
+		}
+	}()
+
+	q := database.New(tx)
+	_, err = q.LockRosterStream(ctx, int64(team))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: %v", ports.ErrTeamNotFound, team)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = q.SetRosterStreamVersion(ctx, database.SetRosterStreamVersionParams{
+		Version: int64(version),
+		TeamID:  int64(team),
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (s *RosterStore) Append(ctx context.Context, id domain.TeamID, newEvents []domain.RosterEvent, expected ports.Version) (ports.Version, error) {
+	versions, err := s.AppendMany(ctx, []ports.StreamAppend{
+		{
+			TeamID:   id,
+			Events:   newEvents,
+			Expected: expected,
+		},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return versions[0], nil
+}
+
+// AppendMany appends to every stream in a single transaction.
+//
+// Stream rows are locked in TeamID order to avoid deadlocks between overlapping batches.
+// If any stream is stale, nothing is written and a *ports.VersionConflictError naming
+// that stream is returned. Versions are returned in the same order as appends.
+func (s *RosterStore) AppendMany(ctx context.Context, appends []ports.StreamAppend) (_ []ports.Version, err error) {
+	order, err := lockOrder(appends)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, ignoreTxClosed(tx.Rollback(ctx)))
+		}
+	}()
+
+	q := database.New(tx)
+
+	err = q.LockRosterLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]ports.Version, len(appends))
+
+	for _, i := range order {
+		versions[i], err = appendStream(ctx, q, appends[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+func NewRosterStore(db DB) *RosterStore {
+	return &RosterStore{
+		db: db,
+	}
+}
+
+func appendStream(ctx context.Context, q *database.Queries, a ports.StreamAppend) (ports.Version, error) {
+	teamID := int64(a.TeamID)
+
+	err := q.EnsureRosterStream(ctx, teamID)
+	if err != nil {
+		return 0, err
+	}
+
+	current, err := q.LockRosterStream(ctx, teamID)
+	if err != nil {
+		return 0, err
+	}
+
+	if ports.Version(current) != a.Expected {
+		return 0, &ports.VersionConflictError{
+			TeamID:   a.TeamID,
+			Current:  ports.Version(current),
+			Expected: a.Expected,
+		}
+	}
+
+	nextSeq := current
+	for _, ev := range a.Events {
+		if ev.Team() != a.TeamID {
+			return 0, fmt.Errorf("%w: event team %v, stream team %v", domain.ErrWrongTeamID, ev.Team(), a.TeamID)
+		}
+
+		eventType, payload, err := encodeRosterEvent(ev)
+		if err != nil {
+			return 0, err
+		}
+
+		nextSeq++
+		err = q.InsertRosterEvent(ctx, database.InsertRosterEventParams{
+			TeamID:      teamID,
+			Sequence:    nextSeq,
+			EventType:   eventType,
+			Payload:     payload,
+			EffectiveAt: pgtype.Timestamptz{Time: ev.OccurredAt(), Valid: true},
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		err = q.InsertRosterOutbox(ctx, database.InsertRosterOutboxParams{
+			TeamID:   teamID,
+			Sequence: nextSeq,
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = q.SetRosterStreamVersion(ctx, database.SetRosterStreamVersionParams{
+		Version: nextSeq,
+		TeamID:  teamID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return ports.Version(nextSeq), nil
+}
+
+// lockOrder returns the indexes of appends sorted by TeamID, rejecting batches
+// that name the same stream twice.
+func lockOrder(appends []ports.StreamAppend) ([]int, error) {
+	order := make([]int, len(appends))
+	for i := range appends {
+		order[i] = i
+	}
+
+	slices.SortFunc(order, func(a, b int) int {
+		return cmp.Compare(appends[a].TeamID, appends[b].TeamID)
+	})
+
+	for i := 1; i < len(order); i++ {
+		if appends[order[i]].TeamID == appends[order[i-1]].TeamID {
+			return nil, fmt.Errorf("%w: team %v", ports.ErrDuplicateStreamAppend, appends[order[i]].TeamID)
+		}
+	}
+
+	return order, nil
+}
+
+func ignoreTxClosed(err error) error {
+	if errors.Is(err, pgx.ErrTxClosed) {
+		return nil
+	}
+
+	return err
+}
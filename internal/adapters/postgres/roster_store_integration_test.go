@@ -0,0 +1,156 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/spcameron/dugout/internal/adapters/postgres"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+func TestRosterStore_Append(t *testing.T) {
+	store := postgres.NewRosterStore(newTestPool(t))
+
+	t.Run("append then load round trips events in sequence order", func(t *testing.T) {
+		teamID := uniqueTeamID()
+		events := []domain.RosterEvent{
+			domain.AddedPlayerToRoster{TeamID: teamID, PlayerID: 1, EffectiveAt: testkit.TodayLock()},
+			domain.ActivatedPlayerOnRoster{TeamID: teamID, PlayerID: 1, PlayerRole: domain.RoleHitter, EffectiveAt: testkit.TodayLock()},
+		}
+
+		version, err := store.Append(t.Context(), teamID, events, 0)
+		require.NoError(t, err)
+		assert.Equal(t, version, ports.Version(2))
+
+		history, loaded, err := store.Load(t.Context(), teamID)
+		require.NoError(t, err)
+		assert.Equal(t, loaded, version)
+		require.Equal(t, len(history), 2)
+
+		_, ok := history[1].Event.(domain.ActivatedPlayerOnRoster)
+		assert.True(t, ok)
+	})
+
+	t.Run("stale expected version returns VersionConflictError", func(t *testing.T) {
+		teamID := uniqueTeamID()
+		event := domain.AddedPlayerToRoster{TeamID: teamID, PlayerID: 1, EffectiveAt: testkit.TodayLock()}
+
+		_, err := store.Append(t.Context(), teamID, []domain.RosterEvent{event}, 0)
+		require.NoError(t, err)
+
+		_, err = store.Append(t.Context(), teamID, []domain.RosterEvent{event}, 0)
+		assert.ErrorIs(t, err, ports.ErrVersionConflict)
+	})
+}
+
+func TestRosterStore_AppendMany(t *testing.T) {
+	store := postgres.NewRosterStore(newTestPool(t))
+
+	t.Run("commits every stream and returns versions in input order", func(t *testing.T) {
+		teamA, teamB := uniqueTeamID(), uniqueTeamID()
+
+		_, err := store.Append(t.Context(), teamB, []domain.RosterEvent{
+			domain.AddedPlayerToRoster{TeamID: teamB, PlayerID: 2, EffectiveAt: testkit.TodayLock()},
+		}, 0)
+		require.NoError(t, err)
+
+		versions, err := store.AppendMany(t.Context(), []ports.StreamAppend{
+			{
+				TeamID:   teamB,
+				Events:   []domain.RosterEvent{domain.RemovedPlayerFromRoster{TeamID: teamB, PlayerID: 2, EffectiveAt: testkit.TodayLock()}},
+				Expected: 1,
+			},
+			{
+				TeamID:   teamA,
+				Events:   []domain.RosterEvent{domain.AddedPlayerToRoster{TeamID: teamA, PlayerID: 2, EffectiveAt: testkit.TodayLock()}},
+				Expected: 0,
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, versions, []ports.Version{2, 1})
+	})
+
+	t.Run("stale stream rolls back the whole batch and is named in the error", func(t *testing.T) {
+		teamA, teamB := uniqueTeamID(), uniqueTeamID()
+
+		_, err := store.Append(t.Context(), teamB, []domain.RosterEvent{
+			domain.AddedPlayerToRoster{TeamID: teamB, PlayerID: 2, EffectiveAt: testkit.TodayLock()},
+		}, 0)
+		require.NoError(t, err)
+
+		_, err = store.AppendMany(t.Context(), []ports.StreamAppend{
+			{
+				TeamID:   teamA,
+				Events:   []domain.RosterEvent{domain.AddedPlayerToRoster{TeamID: teamA, PlayerID: 2, EffectiveAt: testkit.TodayLock()}},
+				Expected: 0,
+			},
+			{
+				TeamID:   teamB,
+				Events:   []domain.RosterEvent{domain.RemovedPlayerFromRoster{TeamID: teamB, PlayerID: 2, EffectiveAt: testkit.TodayLock()}},
+				Expected: 0,
+			},
+		})
+		require.ErrorIs(t, err, ports.ErrVersionConflict)
+
+		var conflict *ports.VersionConflictError
+		require.ErrorAs(t, err, &conflict)
+		assert.Equal(t, conflict.TeamID, teamB)
+
+		history, version, err := store.Load(t.Context(), teamA)
+		require.NoError(t, err)
+		assert.Equal(t, len(history), 0)
+		assert.Equal(t, version, ports.Version(0))
+	})
+
+	t.Run("event for another team rolls back the whole batch", func(t *testing.T) {
+		teamA, teamB := uniqueTeamID(), uniqueTeamID()
+
+		_, err := store.AppendMany(t.Context(), []ports.StreamAppend{
+			{
+				TeamID:   teamA,
+				Events:   []domain.RosterEvent{domain.AddedPlayerToRoster{TeamID: teamA, PlayerID: 2, EffectiveAt: testkit.TodayLock()}},
+				Expected: 0,
+			},
+			{
+				TeamID:   teamB,
+				Events:   []domain.RosterEvent{domain.AddedPlayerToRoster{TeamID: teamA, PlayerID: 3, EffectiveAt: testkit.TodayLock()}},
+				Expected: 0,
+			},
+		})
+		require.ErrorIs(t, err, domain.ErrWrongTeamID)
+
+		history, _, err := store.Load(t.Context(), teamA)
+		require.NoError(t, err)
+		assert.Equal(t, len(history), 0)
+	})
+}
+
+func TestRosterStore_Streams(t *testing.T) {
+	store := postgres.NewRosterStore(newTestPool(t))
+
+	t.Run("lists streams with their versions and resets one", func(t *testing.T) {
+		teamID := uniqueTeamID()
+		_, err := store.Append(t.Context(), teamID, []domain.RosterEvent{
+			domain.AddedPlayerToRoster{TeamID: teamID, PlayerID: 1, EffectiveAt: testkit.TodayLock()},
+		}, 0)
+		require.NoError(t, err)
+
+		require.NoError(t, store.ResetVersion(t.Context(), teamID, 5))
+
+		streams, err := store.Streams(t.Context())
+		require.NoError(t, err)
+		assert.True(t, slices.Contains(streams, ports.StreamVersion{TeamID: teamID, Version: 5}))
+	})
+
+	t.Run("resetting a missing stream returns ErrTeamNotFound", func(t *testing.T) {
+		err := store.ResetVersion(t.Context(), uniqueTeamID(), 1)
+
+		assert.ErrorIs(t, err, ports.ErrTeamNotFound)
+	})
+}
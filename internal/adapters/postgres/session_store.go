@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/spcameron/dugout/internal/database"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// SessionStore keeps sessions in Postgres. Expired rows are left for the
+// authenticate use case to delete when it meets them.
+type SessionStore struct {
+	db DB
+}
+
+func (s *SessionStore) Create(ctx context.Context, session ports.Session) error {
+	return database.New(s.db).CreateSession(ctx, database.CreateSessionParams{
+		TokenHash: session.TokenHash,
+		UserID:    int64(session.UserID),
+		ExpiresAt: pgtype.Timestamptz{Time: session.ExpiresAt, Valid: true},
+	})
+}
+
+func (s *SessionStore) Get(ctx context.Context, tokenHash []byte) (ports.Session, error) {
+	row, err := database.New(s.db).GetSession(ctx, tokenHash)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ports.Session{}, ports.ErrSessionNotFound
+	}
+	if err != nil {
+		return ports.Session{}, err
+	}
+
+	return ports.Session{
+		TokenHash: row.TokenHash,
+		UserID:    domain.UserID(row.UserID),
+		ExpiresAt: row.ExpiresAt.Time,
+	}, nil
+}
+
+func (s *SessionStore) Delete(ctx context.Context, tokenHash []byte) error {
+	return database.New(s.db).DeleteSession(ctx, tokenHash)
+}
+
+func NewSessionStore(db DB) *SessionStore {
+	return &SessionStore{
+		db: db,
+	}
+}
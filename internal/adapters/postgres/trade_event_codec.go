@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spcameron/dugout/internal/domain"
+)
+
+// The settling type names are also listed in ListTradesUnderReview.
+const (
+	eventTypeProposedTrade     = "ProposedTrade"
+	eventTypeAcceptedTrade     = "AcceptedTrade"
+	eventTypeCastTradeVetoVote = "CastTradeVetoVote"
+	eventTypeVetoedTrade       = "VetoedTrade"
+	eventTypeExecutedTrade     = "ExecutedTrade"
+	eventTypeFailedTrade       = "FailedTrade"
+)
+
+// encodeTradeEvent returns the stored type name and JSON payload for a trade event.
+func encodeTradeEvent(event domain.TradeEvent) (string, []byte, error) {
+	var eventType string
+	switch event.(type) {
+	case domain.ProposedTrade:
+		eventType = eventTypeProposedTrade
+	case domain.AcceptedTrade:
+		eventType = eventTypeAcceptedTrade
+	case domain.CastTradeVetoVote:
+		eventType = eventTypeCastTradeVetoVote
+	case domain.VetoedTrade:
+		eventType = eventTypeVetoedTrade
+	case domain.ExecutedTrade:
+		eventType = eventTypeExecutedTrade
+	case domain.FailedTrade:
+		eventType = eventTypeFailedTrade
+	default:
+		return "", nil, fmt.Errorf("%w: %T", domain.ErrUnrecognizedTradeEvent, event)
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return eventType, payload, nil
+}
+
+// decodeTradeEvent rebuilds a trade event from its stored type name and JSON payload.
+func decodeTradeEvent(eventType string, payload []byte) (domain.TradeEvent, error) {
+	switch eventType {
+	case eventTypeProposedTrade:
+		return decodeTradeAs[domain.ProposedTrade](payload)
+	case eventTypeAcceptedTrade:
+		return decodeTradeAs[domain.AcceptedTrade](payload)
+	case eventTypeCastTradeVetoVote:
+		return decodeTradeAs[domain.CastTradeVetoVote](payload)
+	case eventTypeVetoedTrade:
+		return decodeTradeAs[domain.VetoedTrade](payload)
+	case eventTypeExecutedTrade:
+		return decodeTradeAs[domain.ExecutedTrade](payload)
+	case eventTypeFailedTrade:
+		return decodeTradeAs[domain.FailedTrade](payload)
+	default:
+		return nil, fmt.Errorf("%w: %q", domain.ErrUnrecognizedTradeEvent, eventType)
+	}
+}
+
+func decodeTradeAs[E domain.TradeEvent](payload []byte) (domain.TradeEvent, error) {
+	var event E
+	err := json.Unmarshal(payload, &event)
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
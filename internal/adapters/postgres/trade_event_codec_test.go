@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+func TestTradeEventCodec(t *testing.T) {
+	testCases := []struct {
+		name     string
+		event    domain.TradeEvent
+		wantType string
+	}{
+		{
+			name: "round trips ProposedTrade",
+			event: domain.ProposedTrade{
+				TradeID: 1,
+				Terms: domain.TradeTerms{
+					Proposer:      testkit.TeamA(),
+					Receiver:      testkit.TeamB(),
+					ProposerSends: []domain.PlayerID{1},
+				},
+				ProposedBy: testkit.ManagerA(),
+				ProposedAt: testkit.TodayLock(),
+			},
+			wantType: eventTypeProposedTrade,
+		},
+		{
+			name: "round trips AcceptedTrade",
+			event: domain.AcceptedTrade{
+				TradeID: 1,
+				Terms: domain.TradeTerms{
+					Proposer:      testkit.TeamA(),
+					Receiver:      testkit.TeamB(),
+					ProposerSends: []domain.PlayerID{1},
+					ReceiverSends: []domain.PlayerID{2, 3},
+				},
+				ReviewEndsAt:      testkit.TomorrowLock(),
+				VetoVotesRequired: 2,
+				AcceptedAt:        testkit.TodayLock(),
+			},
+			wantType: eventTypeAcceptedTrade,
+		},
+		{
+			name:     "round trips CastTradeVetoVote",
+			event:    domain.CastTradeVetoVote{TradeID: 1, Voter: testkit.TeamC(), CastAt: testkit.TodayLock()},
+			wantType: eventTypeCastTradeVetoVote,
+		},
+		{
+			name:     "round trips VetoedTrade",
+			event:    domain.VetoedTrade{TradeID: 1, Commissioner: testkit.Commissioner(), VetoedAt: testkit.TodayLock()},
+			wantType: eventTypeVetoedTrade,
+		},
+		{
+			name:     "round trips ExecutedTrade",
+			event:    domain.ExecutedTrade{TradeID: 1, EffectiveAt: testkit.TomorrowLock(), ExecutedAt: testkit.TodayLock()},
+			wantType: eventTypeExecutedTrade,
+		},
+		{
+			name:     "round trips FailedTrade",
+			event:    domain.FailedTrade{TradeID: 1, Reason: "roster full", FailedAt: testkit.TodayLock()},
+			wantType: eventTypeFailedTrade,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			eventType, payload, err := encodeTradeEvent(tc.event)
+			require.NoError(t, err)
+			assert.Equal(t, eventType, tc.wantType)
+
+			decoded, err := decodeTradeEvent(eventType, payload)
+			require.NoError(t, err)
+
+			assert.Equal(t, decoded.Trade(), tc.event.Trade())
+			assert.True(t, decoded.OccurredAt().Equal(tc.event.OccurredAt()))
+
+			reencodedType, reencoded, err := encodeTradeEvent(decoded)
+			require.NoError(t, err)
+			assert.Equal(t, reencodedType, eventType)
+			assert.Equal(t, string(reencoded), string(payload))
+		})
+	}
+
+	t.Run("decode rejects unknown event type", func(t *testing.T) {
+		event, err := decodeTradeEvent("WithdrewTrade", []byte(`{}`))
+
+		assert.Nil(t, event)
+		assert.ErrorIs(t, err, domain.ErrUnrecognizedTradeEvent)
+	})
+}
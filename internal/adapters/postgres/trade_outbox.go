@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/spcameron/dugout/internal/database"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// TradeOutbox reads the outbox rows TradeStore writes alongside each event and
+// records their delivery in trade_outbox_deliveries, one row per subscriber.
+type TradeOutbox struct {
+	db DB
+}
+
+func (o *TradeOutbox) Pending(ctx context.Context, subscriber string, now time.Time, limit int) ([]ports.TradeOutboxMessage, error) {
+	rows, err := database.New(o.db).ListPendingTradeOutbox(ctx, database.ListPendingTradeOutboxParams{
+		Subscriber:  subscriber,
+		Now:         pgtype.Timestamptz{Time: now, Valid: true},
+		MaxMessages: int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]ports.TradeOutboxMessage, len(rows))
+	for i, row := range rows {
+		event, err := decodeTradeEvent(row.EventType, row.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("trade %v, sequence %v: %w", row.TradeID, row.Sequence, err)
+		}
+
+		messages[i] = ports.TradeOutboxMessage{
+			ID:       ports.OutboxID(row.ID),
+			TradeID:  domain.TradeID(row.TradeID),
+			Sequence: eventlog.Sequence(row.Sequence),
+			Event:    event,
+			Attempts: int(row.Attempts),
+		}
+	}
+
+	return messages, nil
+}
+
+func (o *TradeOutbox) MarkDelivered(ctx context.Context, subscriber string, id ports.OutboxID, at time.Time) error {
+	return database.New(o.db).MarkTradeOutboxDelivered(ctx, database.MarkTradeOutboxDeliveredParams{
+		OutboxID:    int64(id),
+		Subscriber:  subscriber,
+		DeliveredAt: pgtype.Timestamptz{Time: at, Valid: true},
+	})
+}
+
+func (o *TradeOutbox) Retry(ctx context.Context, subscriber string, id ports.OutboxID, retryAt time.Time, cause string) error {
+	return database.New(o.db).RecordTradeOutboxFailure(ctx, database.RecordTradeOutboxFailureParams{
+		OutboxID:      int64(id),
+		Subscriber:    subscriber,
+		NextAttemptAt: pgtype.Timestamptz{Time: retryAt, Valid: true},
+		LastError:     pgtype.Text{String: cause, Valid: true},
+	})
+}
+
+func (o *TradeOutbox) DeadLetter(ctx context.Context, subscriber string, id ports.OutboxID, at time.Time, cause string) error {
+	return database.New(o.db).RecordTradeOutboxFailure(ctx, database.RecordTradeOutboxFailureParams{
+		OutboxID:       int64(id),
+		Subscriber:     subscriber,
+		NextAttemptAt:  pgtype.Timestamptz{Time: at, Valid: true},
+		DeadLetteredAt: pgtype.Timestamptz{Time: at, Valid: true},
+		LastError:      pgtype.Text{String: cause, Valid: true},
+	})
+}
+
+func NewTradeOutbox(db DB) *TradeOutbox {
+	return &TradeOutbox{
+		db: db,
+	}
+}
@@ -0,0 +1,99 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/adapters/postgres"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+func TestTradeOutbox(t *testing.T) {
+	pool := newTestPool(t)
+	store := postgres.NewTradeStore(pool)
+	outbox := postgres.NewTradeOutbox(pool)
+	now := time.Now()
+
+	// The outbox is shared with earlier runs, so each test reads only its own
+	// trade's messages.
+	pending := func(t *testing.T, subscriber string, id domain.TradeID, at time.Time) []ports.TradeOutboxMessage {
+		t.Helper()
+
+		all, err := outbox.Pending(t.Context(), subscriber, at, 100000)
+		require.NoError(t, err)
+
+		var mine []ports.TradeOutboxMessage
+		for _, msg := range all {
+			if msg.TradeID == id {
+				mine = append(mine, msg)
+			}
+		}
+
+		return mine
+	}
+
+	appendTwo := func(t *testing.T) domain.TradeID {
+		t.Helper()
+
+		id := uniqueTradeID()
+		_, err := store.Append(t.Context(), id, []domain.TradeEvent{
+			acceptedTrade(id),
+			domain.CastTradeVetoVote{TradeID: id, Voter: testkit.TeamC(), CastAt: testkit.TodayLock()},
+		}, 0)
+		require.NoError(t, err)
+
+		return id
+	}
+
+	t.Run("append writes a message for every event", func(t *testing.T) {
+		id := appendTwo(t)
+
+		messages := pending(t, fmt.Sprintf("sub-%d", id), id, now)
+		require.Equal(t, len(messages), 2)
+		assert.Equal(t, messages[0].Sequence, eventlog.Sequence(1))
+		assert.Equal(t, messages[1].Sequence, eventlog.Sequence(2))
+		assert.True(t, messages[0].ID < messages[1].ID)
+		assert.Equal(t, messages[0].IdempotencyKey(), fmt.Sprintf("trade/%d/1", id))
+
+		_, ok := messages[0].Event.(domain.AcceptedTrade)
+		assert.True(t, ok)
+	})
+
+	t.Run("conflicting append writes no messages", func(t *testing.T) {
+		id := appendTwo(t)
+
+		_, err := store.Append(t.Context(), id, []domain.TradeEvent{acceptedTrade(id)}, 0)
+		require.ErrorIs(t, err, ports.ErrVersionConflict)
+
+		assert.Equal(t, len(pending(t, fmt.Sprintf("sub-%d", id), id, now)), 2)
+	})
+
+	t.Run("delivery state is kept per subscriber", func(t *testing.T) {
+		id := appendTwo(t)
+		offers, alerts := fmt.Sprintf("offers-%d", id), fmt.Sprintf("alerts-%d", id)
+
+		messages := pending(t, offers, id, now)
+		require.Equal(t, len(messages), 2)
+
+		require.NoError(t, outbox.MarkDelivered(t.Context(), offers, messages[0].ID, now))
+		require.NoError(t, outbox.Retry(t.Context(), offers, messages[1].ID, now.Add(time.Minute), "unavailable"))
+
+		assert.Equal(t, len(pending(t, offers, id, now)), 0)
+		assert.Equal(t, len(pending(t, alerts, id, now)), 2)
+
+		due := pending(t, offers, id, now.Add(time.Minute))
+		require.Equal(t, len(due), 1)
+		assert.Equal(t, due[0].Attempts, 1)
+
+		require.NoError(t, outbox.DeadLetter(t.Context(), offers, messages[1].ID, now, "still unavailable"))
+		assert.Equal(t, len(pending(t, offers, id, now.Add(24*time.Hour))), 0)
+	})
+}
@@ -0,0 +1,195 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/spcameron/dugout/internal/database"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// TradeStore persists trade event streams in Postgres, locking each trade's row
+// in trade_streams while appending as LeagueStore does for leagues.
+//
+// Trade events are positioned in the roster log's sequence, and appends take the
+// roster log's advisory lock until they commit, so the two logs read as one in
+// commit order.
+//
+// Every appended event also gets a trade_outbox row in the same transaction, for
+// TradeOutbox to deliver once the append commits.
+type TradeStore struct {
+	db DB
+}
+
+func (s *TradeStore) Load(ctx context.Context, id domain.TradeID) ([]eventlog.Recorded[domain.TradeEvent], ports.Version, error) {
+	rows, err := database.New(s.db).ListTradeEvents(ctx, int64(id))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	history := make([]eventlog.Recorded[domain.TradeEvent], len(rows))
+	for i, row := range rows {
+		event, err := decodeTradeEvent(row.EventType, row.Payload)
+		if err != nil {
+			return nil, 0, fmt.Errorf("trade %v, sequence %v: %w", id, row.Sequence, err)
+		}
+
+		history[i] = eventlog.Recorded[domain.TradeEvent]{
+			Sequence: eventlog.Sequence(row.Sequence),
+			Event:    event,
+		}
+	}
+
+	var lastSeq eventlog.Sequence
+	if len(history) > 0 {
+		lastSeq = history[len(history)-1].Sequence
+	}
+
+	return history, ports.Version(lastSeq), nil
+}
+
+func (s *TradeStore) Append(ctx context.Context, id domain.TradeID, newEvents []domain.TradeEvent, expected ports.Version) (_ ports.Version, err error) {
+	tradeID := int64(id)
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, ignoreTxClosed(tx.Rollback(ctx)))
+		}
+	}()
+
+	q := database.New(tx)
+
+	err = q.LockRosterLog(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	err = q.EnsureTradeStream(ctx, tradeID)
+	if err != nil {
+		return 0, err
+	}
+
+	current, err := q.LockTradeStream(ctx, tradeID)
+	if err != nil {
+		return 0, err
+	}
+
+	if ports.Version(current) != expected {
+		return 0, fmt.Errorf("%w: trade %v, current - %v, expected - %v", ports.ErrVersionConflict, id, current, expected)
+	}
+
+	nextSeq := current
+	for _, ev := range newEvents {
+		if ev.Trade() != id {
+			return 0, fmt.Errorf("%w: event trade %v, stream trade %v", domain.ErrWrongTradeID, ev.Trade(), id)
+		}
+
+		eventType, payload, err := encodeTradeEvent(ev)
+		if err != nil {
+			return 0, err
+		}
+
+		nextSeq++
+		err = q.InsertTradeEvent(ctx, database.InsertTradeEventParams{
+			TradeID:    tradeID,
+			Sequence:   nextSeq,
+			EventType:  eventType,
+			Payload:    payload,
+			OccurredAt: pgtype.Timestamptz{Time: ev.OccurredAt(), Valid: true},
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		err = q.InsertTradeOutbox(ctx, database.InsertTradeOutboxParams{
+			TradeID:  tradeID,
+			Sequence: nextSeq,
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = q.SetTradeStreamVersion(ctx, database.SetTradeStreamVersionParams{
+		Version: nextSeq,
+		TradeID: tradeID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return ports.Version(nextSeq), nil
+}
+
+func (s *TradeStore) ReadAfter(ctx context.Context, after eventlog.Position, limit int) ([]eventlog.Entry[domain.TradeEvent], error) {
+	rows, err := database.New(s.db).ListTradeEventsAfter(ctx, database.ListTradeEventsAfterParams{
+		After:     int64(after),
+		MaxEvents: int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]eventlog.Entry[domain.TradeEvent], len(rows))
+	for i, row := range rows {
+		event, err := decodeTradeEvent(row.EventType, row.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("trade %v, sequence %v: %w", row.TradeID, row.Sequence, err)
+		}
+
+		entries[i] = eventlog.Entry[domain.TradeEvent]{
+			Position: eventlog.Position(row.Position),
+			Recorded: eventlog.Recorded[domain.TradeEvent]{
+				Sequence: eventlog.Sequence(row.Sequence),
+				Event:    event,
+			},
+		}
+	}
+
+	return entries, nil
+}
+
+func (s *TradeStore) LastPosition(ctx context.Context) (eventlog.Position, error) {
+	pos, err := database.New(s.db).GetLastTradeEventPosition(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return eventlog.Position(pos), nil
+}
+
+// ListUnderReview returns the trades that have been accepted and not yet
+// vetoed, executed or failed, in ascending order.
+func (s *TradeStore) ListUnderReview(ctx context.Context) ([]domain.TradeID, error) {
+	rows, err := database.New(s.db).ListTradesUnderReview(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]domain.TradeID, len(rows))
+	for i, id := range rows {
+		ids[i] = domain.TradeID(id)
+	}
+
+	return ids, nil
+}
+
+func NewTradeStore(db DB) *TradeStore {
+	return &TradeStore{
+		db: db,
+	}
+}
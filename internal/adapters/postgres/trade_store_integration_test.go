@@ -0,0 +1,154 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/adapters/postgres"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+func uniqueTradeID() domain.TradeID {
+	return domain.TradeID(uniqueTeamID())
+}
+
+func acceptedTrade(id domain.TradeID) domain.AcceptedTrade {
+	return domain.AcceptedTrade{
+		TradeID: id,
+		Terms: domain.TradeTerms{
+			Proposer:      testkit.TeamA(),
+			Receiver:      testkit.TeamB(),
+			ProposerSends: []domain.PlayerID{1},
+			ReceiverSends: []domain.PlayerID{2},
+		},
+		ReviewEndsAt:      testkit.TodayLock().Add(48 * time.Hour),
+		VetoVotesRequired: 2,
+		AcceptedAt:        testkit.TodayLock(),
+	}
+}
+
+func TestTradeStore_Append(t *testing.T) {
+	store := postgres.NewTradeStore(newTestPool(t))
+
+	t.Run("append then load round trips events in sequence order", func(t *testing.T) {
+		id := uniqueTradeID()
+		events := []domain.TradeEvent{
+			acceptedTrade(id),
+			domain.CastTradeVetoVote{TradeID: id, Voter: testkit.TeamC(), CastAt: testkit.TodayLock()},
+		}
+
+		version, err := store.Append(t.Context(), id, events, 0)
+		require.NoError(t, err)
+		assert.Equal(t, version, ports.Version(2))
+
+		history, loaded, err := store.Load(t.Context(), id)
+		require.NoError(t, err)
+		assert.Equal(t, loaded, version)
+		require.Equal(t, len(history), 2)
+
+		accepted, ok := history[0].Event.(domain.AcceptedTrade)
+		require.True(t, ok)
+		assert.Equal(t, accepted.Terms.ReceiverSends, []domain.PlayerID{2})
+	})
+
+	t.Run("stale expected version is a conflict", func(t *testing.T) {
+		id := uniqueTradeID()
+
+		_, err := store.Append(t.Context(), id, []domain.TradeEvent{acceptedTrade(id)}, 0)
+		require.NoError(t, err)
+
+		_, err = store.Append(t.Context(), id, []domain.TradeEvent{acceptedTrade(id)}, 0)
+		assert.ErrorIs(t, err, ports.ErrVersionConflict)
+	})
+
+	t.Run("events for another trade are rejected", func(t *testing.T) {
+		id := uniqueTradeID()
+
+		_, err := store.Append(t.Context(), id, []domain.TradeEvent{acceptedTrade(id + 1)}, 0)
+		assert.ErrorIs(t, err, domain.ErrWrongTradeID)
+
+		history, version, err := store.Load(t.Context(), id)
+		require.NoError(t, err)
+		assert.Equal(t, len(history), 0)
+		assert.Equal(t, version, ports.Version(0))
+	})
+}
+
+func TestTradeStore_ListUnderReview(t *testing.T) {
+	store := postgres.NewTradeStore(newTestPool(t))
+
+	reviewing, executed := uniqueTradeID(), uniqueTradeID()
+
+	_, err := store.Append(t.Context(), reviewing, []domain.TradeEvent{acceptedTrade(reviewing)}, 0)
+	require.NoError(t, err)
+	_, err = store.Append(t.Context(), executed, []domain.TradeEvent{
+		acceptedTrade(executed),
+		domain.ExecutedTrade{TradeID: executed, EffectiveAt: testkit.TomorrowLock(), ExecutedAt: testkit.TodayLock()},
+	}, 0)
+	require.NoError(t, err)
+
+	ids, err := store.ListUnderReview(t.Context())
+	require.NoError(t, err)
+
+	var sawReviewing, sawExecuted bool
+	for _, id := range ids {
+		sawReviewing = sawReviewing || id == reviewing
+		sawExecuted = sawExecuted || id == executed
+	}
+	assert.True(t, sawReviewing)
+	assert.False(t, sawExecuted)
+}
+
+func TestTradeStore_ReadAfter(t *testing.T) {
+	pool := newTestPool(t)
+	rosters := postgres.NewRosterStore(pool)
+	trades := postgres.NewTradeStore(pool)
+
+	t.Run("positions trade events in the roster log's order", func(t *testing.T) {
+		team, id := uniqueTeamID(), uniqueTradeID()
+
+		start, err := rosters.LastPosition(t.Context())
+		require.NoError(t, err)
+		tradeStart, err := trades.LastPosition(t.Context())
+		require.NoError(t, err)
+		start = max(start, tradeStart)
+
+		_, err = rosters.Append(t.Context(), team, []domain.RosterEvent{
+			domain.AddedPlayerToRoster{TeamID: team, PlayerID: 1, EffectiveAt: testkit.TodayLock()},
+		}, 0)
+		require.NoError(t, err)
+		_, err = trades.Append(t.Context(), id, []domain.TradeEvent{acceptedTrade(id)}, 0)
+		require.NoError(t, err)
+
+		rosterEntries, err := rosters.ReadAfter(t.Context(), start, 100000)
+		require.NoError(t, err)
+		tradeEntries, err := trades.ReadAfter(t.Context(), start, 100000)
+		require.NoError(t, err)
+
+		var added, accepted eventlog.Position
+		for _, entry := range rosterEntries {
+			if entry.Event.Team() == team {
+				added = entry.Position
+			}
+		}
+		for _, entry := range tradeEntries {
+			if entry.Event.Trade() == id {
+				accepted = entry.Position
+			}
+		}
+
+		assert.True(t, added > start)
+		assert.True(t, accepted > added)
+
+		last, err := trades.LastPosition(t.Context())
+		require.NoError(t, err)
+		assert.True(t, last >= accepted)
+	})
+}
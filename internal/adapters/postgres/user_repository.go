@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/spcameron/dugout/internal/database"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// uniqueViolation is the Postgres SQLSTATE for a duplicate key.
+const uniqueViolation = "23505"
+
+// UserRepository stores accounts in Postgres. Emails are expected to arrive
+// normalized; the users table rejects any that are not lowercase.
+type UserRepository struct {
+	db DB
+}
+
+func (r *UserRepository) Create(ctx context.Context, user domain.User, passwordHash []byte) (domain.User, error) {
+	id, err := database.New(r.db).CreateUser(ctx, database.CreateUserParams{
+		Email:        user.Email,
+		DisplayName:  user.DisplayName,
+		PasswordHash: passwordHash,
+	})
+	if isUniqueViolation(err) {
+		return domain.User{}, fmt.Errorf("%w: %s", ports.ErrEmailTaken, user.Email)
+	}
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	user.ID = domain.UserID(id)
+	return user, nil
+}
+
+func (r *UserRepository) Get(ctx context.Context, id domain.UserID) (domain.User, error) {
+	row, err := database.New(r.db).GetUser(ctx, int64(id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.User{}, fmt.Errorf("%w: %v", ports.ErrUserNotFound, id)
+	}
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	return domain.User{
+		ID:          domain.UserID(row.ID),
+		Email:       row.Email,
+		DisplayName: row.DisplayName,
+	}, nil
+}
+
+func (r *UserRepository) GetCredentials(ctx context.Context, email string) (ports.Credentials, error) {
+	row, err := database.New(r.db).GetUserCredentials(ctx, email)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ports.Credentials{}, fmt.Errorf("%w: %s", ports.ErrUserNotFound, email)
+	}
+	if err != nil {
+		return ports.Credentials{}, err
+	}
+
+	return ports.Credentials{
+		User: domain.User{
+			ID:          domain.UserID(row.ID),
+			Email:       row.Email,
+			DisplayName: row.DisplayName,
+		},
+		PasswordHash: row.PasswordHash,
+	}, nil
+}
+
+func NewUserRepository(db DB) *UserRepository {
+	return &UserRepository{
+		db: db,
+	}
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
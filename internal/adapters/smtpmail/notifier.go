@@ -0,0 +1,180 @@
+// Package smtpmail delivers notifications as email through an SMTP relay.
+package smtpmail
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+var ErrAuthUnsupported = errors.New("SMTP server does not support authentication")
+
+// Notifier mails each notification to the user's account address. It upgrades
+// to TLS whenever the relay offers STARTTLS, and authenticates with Auth when it
+// is set.
+//
+// Email cannot take back a message, so a notification sent again is mailed
+// again. Its Message-ID is derived from the notification key, which lets mail
+// clients recognize the repeat.
+type Notifier struct {
+	Addr  string
+	From  mail.Address
+	Auth  smtp.Auth
+	Users ports.UserRepository
+}
+
+func (n Notifier) Notify(ctx context.Context, notification ports.Notification) error {
+	user, err := n.Users.Get(ctx, notification.UserID)
+	if err != nil {
+		return err
+	}
+
+	to := mail.Address{Name: user.DisplayName, Address: user.Email}
+
+	msg, err := n.message(to, notification)
+	if err != nil {
+		return err
+	}
+
+	return n.send(ctx, to.Address, msg)
+}
+
+// send is smtp.SendMail with the dial and every exchange bounded by ctx.
+func (n Notifier) send(ctx context.Context, to string, msg []byte) error {
+	host, _, err := net.SplitHostPort(n.Addr)
+	if err != nil {
+		return err
+	}
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", n.Addr)
+	if err != nil {
+		return err
+	}
+
+	stop := context.AfterFunc(ctx, func() {
+		conn.SetDeadline(time.Now())
+	})
+	defer stop()
+
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	err = c.Hello("localhost")
+	if err != nil {
+		return err
+	}
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		err = c.StartTLS(&tls.Config{ServerName: host})
+		if err != nil {
+			return err
+		}
+	}
+
+	if n.Auth != nil {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return ErrAuthUnsupported
+		}
+
+		err = c.Auth(n.Auth)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = c.Mail(n.From.Address)
+	if err != nil {
+		return err
+	}
+
+	err = c.Rcpt(to)
+	if err != nil {
+		return err
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(msg)
+	if err != nil {
+		return err
+	}
+
+	err = w.Close()
+	if err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
+
+// message builds a plain-text email. Headers are encoded so that nothing in a
+// subject or name can start a new header, and the body is quoted-printable so
+// that names outside ASCII survive relays without 8BITMIME.
+func (n Notifier) message(to mail.Address, notification ports.Notification) ([]byte, error) {
+	var buf bytes.Buffer
+
+	header := func(name, value string) {
+		fmt.Fprintf(&buf, "%s: %s\r\n", name, value)
+	}
+
+	header("From", n.From.String())
+	header("To", to.String())
+	header("Subject", mime.QEncoding.Encode("utf-8", notification.Subject))
+	header("Date", notification.CreatedAt.Format(time.RFC1123Z))
+	header("Message-ID", n.messageID(notification))
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=utf-8")
+	header("Content-Transfer-Encoding", "quoted-printable")
+	buf.WriteString("\r\n")
+
+	w := quotedprintable.NewWriter(&buf)
+	_, err := w.Write([]byte(notification.Body))
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (n Notifier) messageID(notification ports.Notification) string {
+	_, domain, ok := strings.Cut(n.From.Address, "@")
+	if !ok {
+		domain = "localhost"
+	}
+
+	local := strings.ReplaceAll(notification.Key, "/", ".")
+
+	return fmt.Sprintf("<%s.%d@%s>", local, notification.UserID, domain)
+}
+
+func NewNotifier(addr string, from mail.Address, auth smtp.Auth, users ports.UserRepository) Notifier {
+	return Notifier{
+		Addr:  addr,
+		From:  from,
+		Auth:  auth,
+		Users: users,
+	}
+}
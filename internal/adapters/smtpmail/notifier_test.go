@@ -0,0 +1,128 @@
+package smtpmail_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/adapters/smtpmail"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+var from = mail.Address{Name: "Dugout", Address: "noreply@dugout.test"}
+
+func newUser(t *testing.T, users *testkit.FakeUserRepository, email string) domain.User {
+	t.Helper()
+
+	user, err := users.Create(t.Context(), domain.User{Email: email, DisplayName: "José Ramírez"}, []byte("hash"))
+	require.NoError(t, err)
+
+	return user
+}
+
+func notification(user domain.UserID) ports.Notification {
+	return ports.Notification{
+		Key:       "roster/111/4/drop/7",
+		UserID:    user,
+		Kind:      domain.NotifyCommissionerDrop,
+		Subject:   "Ronald Acuña Jr. was dropped from your roster",
+		Body:      "A commissioner dropped Ronald Acuña Jr.\n\n.Reason: added in error\n",
+		CreatedAt: time.Date(2026, 4, 2, 4, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestNotifier(t *testing.T) {
+	t.Run("mails the notification to the user's address", func(t *testing.T) {
+		server := testkit.StartFakeSMTPServer(t)
+		users := testkit.NewFakeUserRepository()
+		user := newUser(t, users, "jose@example.test")
+		notifier := smtpmail.NewNotifier(server.Addr, from, nil, users)
+
+		require.NoError(t, notifier.Notify(t.Context(), notification(user.ID)))
+
+		messages := server.Messages()
+		require.Equal(t, len(messages), 1)
+		assert.Equal(t, messages[0].From, "noreply@dugout.test")
+		require.Equal(t, len(messages[0].To), 1)
+		assert.Equal(t, messages[0].To[0], "jose@example.test")
+
+		msg, err := mail.ReadMessage(strings.NewReader(messages[0].Data))
+		require.NoError(t, err)
+
+		subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+		require.NoError(t, err)
+		assert.Equal(t, subject, "Ronald Acuña Jr. was dropped from your roster")
+
+		to, err := msg.Header.AddressList("To")
+		require.NoError(t, err)
+		assert.Equal(t, to[0].Name, "José Ramírez")
+
+		assert.Equal(t, msg.Header.Get("Message-ID"), fmt.Sprintf("<roster.111.4.drop.7.%d@dugout.test>", user.ID))
+		assert.Equal(t, msg.Header.Get("Content-Transfer-Encoding"), "quoted-printable")
+
+		body, err := io.ReadAll(msg.Body)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "Acu=C3=B1a")
+		assert.Contains(t, string(body), "\n.Reason")
+	})
+
+	t.Run("authenticates when given credentials", func(t *testing.T) {
+		server := testkit.StartFakeSMTPServer(t)
+		users := testkit.NewFakeUserRepository()
+		user := newUser(t, users, "jose@example.test")
+		auth := smtp.PlainAuth("", "relay-user", "secret", "127.0.0.1")
+		notifier := smtpmail.NewNotifier(server.Addr, from, auth, users)
+
+		require.NoError(t, notifier.Notify(t.Context(), notification(user.ID)))
+
+		messages := server.Messages()
+		require.Equal(t, len(messages), 1)
+		assert.Equal(t, messages[0].AuthUser, "relay-user")
+	})
+
+	t.Run("fails when the relay refuses the recipient", func(t *testing.T) {
+		server := testkit.StartFakeSMTPServer(t)
+		server.Reject = []string{"jose@example.test"}
+		users := testkit.NewFakeUserRepository()
+		user := newUser(t, users, "jose@example.test")
+		notifier := smtpmail.NewNotifier(server.Addr, from, nil, users)
+
+		err := notifier.Notify(t.Context(), notification(user.ID))
+
+		assert.NotNil(t, err)
+		assert.Equal(t, len(server.Messages()), 0)
+	})
+
+	t.Run("fails for an unknown user without mailing", func(t *testing.T) {
+		server := testkit.StartFakeSMTPServer(t)
+		notifier := smtpmail.NewNotifier(server.Addr, from, nil, testkit.NewFakeUserRepository())
+
+		err := notifier.Notify(t.Context(), notification(99))
+
+		assert.ErrorIs(t, err, ports.ErrUserNotFound)
+		assert.Equal(t, len(server.Messages()), 0)
+	})
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		server := testkit.StartFakeSMTPServer(t)
+		users := testkit.NewFakeUserRepository()
+		user := newUser(t, users, "jose@example.test")
+		notifier := smtpmail.NewNotifier(server.Addr, from, nil, users)
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+
+		err := notifier.Notify(ctx, notification(user.ID))
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -0,0 +1,97 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/a-h/templ"
+
+	"github.com/spcameron/dugout/internal/adapters/web/views"
+	"github.com/spcameron/dugout/internal/usecase/account"
+)
+
+func (s *Server) handleHome(w http.ResponseWriter, r *http.Request) {
+	s.render(w, r, http.StatusOK, views.HomePage())
+}
+
+func (s *Server) handleLoginPage(w http.ResponseWriter, r *http.Request) {
+	s.render(w, r, http.StatusOK, views.LoginPage(views.AccountForm{
+		Next: localPath(r.URL.Query().Get("next")),
+	}))
+}
+
+func (s *Server) handleLogin(w http.ResponseWriter, r *http.Request) {
+	form := views.AccountForm{
+		Email: r.FormValue("email"),
+		Next:  localPath(r.FormValue("next")),
+	}
+
+	signedIn, err := s.Accounts.SignIn.Handle(r.Context(), account.NewSignInCommand(form.Email, r.FormValue("password")))
+	if err != nil {
+		s.rejectAccountForm(w, r, err, form, views.LoginPage)
+		return
+	}
+
+	s.setSessionCookie(w, signedIn.Token, signedIn.ExpiresAt)
+	http.Redirect(w, r, form.Next, http.StatusSeeOther)
+}
+
+func (s *Server) handleSignupPage(w http.ResponseWriter, r *http.Request) {
+	s.render(w, r, http.StatusOK, views.SignupPage(views.AccountForm{}))
+}
+
+// handleSignup registers the user and signs them straight in.
+func (s *Server) handleSignup(w http.ResponseWriter, r *http.Request) {
+	form := views.AccountForm{
+		Email:       r.FormValue("email"),
+		DisplayName: r.FormValue("display_name"),
+	}
+	password := r.FormValue("password")
+
+	_, err := s.Accounts.Register.Handle(r.Context(), account.NewRegisterUserCommand(form.Email, form.DisplayName, password))
+	if err != nil {
+		s.rejectAccountForm(w, r, err, form, views.SignupPage)
+		return
+	}
+
+	signedIn, err := s.Accounts.SignIn.Handle(r.Context(), account.NewSignInCommand(form.Email, password))
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	s.setSessionCookie(w, signedIn.Token, signedIn.ExpiresAt)
+	http.Redirect(w, r, "/", http.StatusSeeOther)
+}
+
+func (s *Server) handleLogout(w http.ResponseWriter, r *http.Request) {
+	cookie, err := r.Cookie(sessionCookie)
+	if err == nil {
+		err = s.Accounts.SignOut.Handle(r.Context(), cookie.Value)
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+	}
+
+	s.clearSessionCookie(w)
+	http.Redirect(w, r, "/login", http.StatusSeeOther)
+}
+
+// rejectAccountForm redisplays an account form with the rejection inline, or
+// falls back to writeError when the failure is not the user's to fix.
+func (s *Server) rejectAccountForm(
+	w http.ResponseWriter,
+	r *http.Request,
+	err error,
+	form views.AccountForm,
+	page func(views.AccountForm) templ.Component,
+) {
+	p := ProblemFor(err)
+	if p.Status >= http.StatusInternalServerError {
+		s.writeError(w, r, err)
+		return
+	}
+
+	form.Error = p.Title
+	s.render(w, r, p.Status, page(form))
+}
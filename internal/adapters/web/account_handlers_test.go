@@ -0,0 +1,180 @@
+package web_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/spcameron/dugout/internal/adapters/web"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+func sessionCookie(t *testing.T, resp *http.Response) *http.Cookie {
+	t.Helper()
+
+	for _, c := range resp.Cookies() {
+		if c.Name == "dugout_session" {
+			return c
+		}
+	}
+
+	t.Fatal("response did not set the session cookie")
+	return nil
+}
+
+func TestSignupAndLogin(t *testing.T) {
+	signup := url.Values{
+		"email":        {"Skipper@example.com"},
+		"display_name": {"Skipper"},
+		"password":     {"correct horse battery"},
+	}
+
+	t.Run("signup signs the new user in with a hardened cookie", func(t *testing.T) {
+		f := newRosterFixture(t, nil)
+		f.signOut()
+
+		rec := f.do(http.MethodPost, "/signup", signup)
+		require.Equal(t, rec.Code, http.StatusSeeOther)
+		assert.Equal(t, rec.Header().Get("Location"), "/")
+
+		cookie := sessionCookie(t, rec.Result())
+		assert.True(t, cookie.HttpOnly)
+		assert.True(t, cookie.Secure)
+		assert.Equal(t, cookie.SameSite, http.SameSiteLaxMode)
+
+		f.cookie = cookie
+		assert.Equal(t, f.do(http.MethodGet, "/", nil).Code, http.StatusOK)
+	})
+
+	t.Run("signup with a weak password redisplays the form", func(t *testing.T) {
+		f := newRosterFixture(t, nil)
+		f.signOut()
+
+		weak := url.Values{"email": {"skipper@example.com"}, "display_name": {"Skipper"}, "password": {"short"}}
+		rec := f.do(http.MethodPost, "/signup", weak)
+
+		assert.Equal(t, rec.Code, http.StatusUnprocessableEntity)
+		assert.Contains(t, rec.Body.String(), `role="alert"`)
+		assert.Contains(t, rec.Body.String(), `value="skipper@example.com"`)
+		assert.Contains(t, rec.Body.String(), web.ProblemFor(domain.ErrWeakPassword).Title)
+	})
+
+	t.Run("login returns to a local next page", func(t *testing.T) {
+		f := newRosterFixture(t, nil)
+		f.signOut()
+		require.Equal(t, f.do(http.MethodPost, "/signup", signup).Code, http.StatusSeeOther)
+
+		login := url.Values{"email": {"skipper@example.com"}, "password": {"correct horse battery"}, "next": {"/teams/111/roster"}}
+		rec := f.do(http.MethodPost, "/login", login)
+
+		require.Equal(t, rec.Code, http.StatusSeeOther)
+		assert.Equal(t, rec.Header().Get("Location"), "/teams/111/roster")
+		assert.True(t, sessionCookie(t, rec.Result()).Value != "")
+	})
+
+	t.Run("login keeps a next page on this site", func(t *testing.T) {
+		f := newRosterFixture(t, nil)
+		f.signOut()
+		require.Equal(t, f.do(http.MethodPost, "/signup", signup).Code, http.StatusSeeOther)
+
+		login := url.Values{"email": {"skipper@example.com"}, "password": {"correct horse battery"}, "next": {"//evil.example/phish"}}
+		rec := f.do(http.MethodPost, "/login", login)
+
+		require.Equal(t, rec.Code, http.StatusSeeOther)
+		assert.Equal(t, rec.Header().Get("Location"), "/phish")
+	})
+
+	t.Run("login with the wrong password is unauthorized", func(t *testing.T) {
+		f := newRosterFixture(t, nil)
+		f.signOut()
+		require.Equal(t, f.do(http.MethodPost, "/signup", signup).Code, http.StatusSeeOther)
+
+		rec := f.do(http.MethodPost, "/login", url.Values{"email": {"skipper@example.com"}, "password": {"wrong horse battery"}})
+
+		assert.Equal(t, rec.Code, http.StatusUnauthorized)
+		assert.Contains(t, rec.Body.String(), `role="alert"`)
+		assert.Equal(t, len(rec.Result().Cookies()), 0)
+	})
+
+	t.Run("logout ends the session and clears the cookie", func(t *testing.T) {
+		f := newRosterFixture(t, nil)
+		require.Equal(t, f.sessions.Len(), 1)
+
+		rec := f.do(http.MethodPost, "/logout", nil)
+
+		require.Equal(t, rec.Code, http.StatusSeeOther)
+		assert.True(t, sessionCookie(t, rec.Result()).MaxAge < 0)
+		assert.Equal(t, f.sessions.Len(), 0)
+	})
+}
+
+func TestRosterRoutesRequireAManager(t *testing.T) {
+	t.Run("anonymous page load is sent to sign in", func(t *testing.T) {
+		f := newRosterFixture(t, nil)
+		f.signOut()
+
+		rec := f.do(http.MethodGet, "/teams/111/roster", nil)
+
+		assert.Equal(t, rec.Code, http.StatusSeeOther)
+		assert.Equal(t, rec.Header().Get("Location"), "/login?next=%2Fteams%2F111%2Froster")
+	})
+
+	t.Run("anonymous API call is unauthorized", func(t *testing.T) {
+		f := newRosterFixture(t, nil)
+		f.signOut()
+
+		rec := f.do(http.MethodPost, "/teams/111/roster/players", url.Values{"player_id": {"1"}})
+
+		require.Equal(t, rec.Code, http.StatusUnauthorized)
+		var p web.Problem
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+		assert.Equal(t, p.Code, "unauthenticated")
+	})
+
+	t.Run("anonymous htmx call redirects the page", func(t *testing.T) {
+		f := newRosterFixture(t, nil)
+		f.signOut()
+
+		req := f.request(http.MethodPost, "/teams/111/roster/players", url.Values{"player_id": {"1"}})
+		req.Header.Set("HX-Request", "true")
+		req.Header.Set("HX-Current-URL", "http://localhost:4000/teams/111/roster")
+		rec := f.serve(req)
+
+		assert.Equal(t, rec.Code, http.StatusUnauthorized)
+		assert.Equal(t, rec.Header().Get("HX-Redirect"), "/login?next=%2Fteams%2F111%2Froster")
+	})
+
+	t.Run("expired session is cleared and treated as anonymous", func(t *testing.T) {
+		f := newRosterFixture(t, nil)
+		f.cookie.Value = "no-such-session"
+
+		rec := f.do(http.MethodPost, "/teams/111/roster/players", url.Values{"player_id": {"1"}})
+
+		assert.Equal(t, rec.Code, http.StatusUnauthorized)
+		assert.True(t, sessionCookie(t, rec.Result()).MaxAge < 0)
+	})
+
+	t.Run("another team's manager is forbidden", func(t *testing.T) {
+		f := newRosterFixture(t, nil)
+		f.signInAs(t, testkit.ManagerB())
+
+		rec := f.do(http.MethodPost, "/teams/111/roster/players", url.Values{"player_id": {"1"}})
+
+		require.Equal(t, rec.Code, http.StatusForbidden)
+		assert.False(t, f.view(t).PlayerOnRoster(1))
+	})
+
+	t.Run("the commissioner may change any team's roster", func(t *testing.T) {
+		f := newRosterFixture(t, nil)
+		f.signInAs(t, testkit.Commissioner())
+
+		rec := f.do(http.MethodPost, "/teams/111/roster/players", url.Values{"player_id": {"1"}})
+
+		require.Equal(t, rec.Code, http.StatusNoContent)
+		assert.True(t, f.view(t).PlayerOnRoster(1))
+	})
+}
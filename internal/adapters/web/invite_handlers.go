@@ -0,0 +1,151 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/spcameron/dugout/internal/adapters/web/views"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/usecase/league"
+)
+
+// defaultInviteTTL is how long an invite lasts when the commissioner does not
+// say.
+const defaultInviteTTL = 7 * 24 * time.Hour
+
+func (s *Server) handleJoinPage(w http.ResponseWriter, r *http.Request) {
+	code := chi.URLParam(r, "code")
+
+	_, _, err := parseInviteCode(code)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	s.render(w, r, http.StatusOK, views.JoinPage(views.JoinForm{Code: code}))
+}
+
+// handleJoin redeems the invite for the signed-in user and takes them to their
+// new team's roster.
+func (s *Server) handleJoin(w http.ResponseWriter, r *http.Request) {
+	form := views.JoinForm{
+		Code:     chi.URLParam(r, "code"),
+		TeamName: r.FormValue("team_name"),
+	}
+
+	leagueID, tok, err := parseInviteCode(form.Code)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	teamID, err := s.League.Join.Handle(r.Context(), league.NewJoinLeagueCommand(leagueID, tok, form.TeamName, actor(r)))
+	if err != nil {
+		p := ProblemFor(err)
+		if p.Status >= http.StatusInternalServerError {
+			s.writeError(w, r, err)
+			return
+		}
+
+		form.Error = p.Title
+		s.render(w, r, p.Status, views.JoinPage(form))
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/teams/%d/roster", teamID), http.StatusSeeOther)
+}
+
+func (s *Server) handleNewInvitePage(w http.ResponseWriter, r *http.Request) {
+	leagueID, err := leagueIDParam(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	s.render(w, r, http.StatusOK, views.NewInvitePage(leagueID))
+}
+
+// handleIssueInvite issues an invite lasting expires_in, or defaultInviteTTL,
+// that can be redeemed once if single_use is sent at all, as an unchecked
+// checkbox is not.
+func (s *Server) handleIssueInvite(w http.ResponseWriter, r *http.Request) {
+	leagueID, err := leagueIDParam(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	ttl := defaultInviteTTL
+	raw := r.FormValue("expires_in")
+	if raw != "" {
+		ttl, err = time.ParseDuration(raw)
+		if err != nil {
+			s.writeError(w, r, fmt.Errorf("%w: expires_in: %w", errBadRequest, err))
+			return
+		}
+	}
+	singleUse := r.FormValue("single_use") != ""
+
+	issued, err := s.League.IssueInvite.Handle(r.Context(), league.NewIssueInviteCommand(leagueID, ttl, singleUse, actor(r)))
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	s.render(w, r, http.StatusCreated, views.InviteIssuedPage(views.IssuedInvite{
+		LeagueID:  leagueID,
+		InviteID:  issued.InviteID,
+		Code:      inviteCode(leagueID, issued.Token),
+		SingleUse: singleUse,
+		ExpiresAt: issued.ExpiresAt,
+	}))
+}
+
+func (s *Server) handleRevokeInvite(w http.ResponseWriter, r *http.Request) {
+	leagueID, err := leagueIDParam(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	id, err := parseID(chi.URLParam(r, "inviteID"))
+	if err != nil {
+		s.writeError(w, r, fmt.Errorf("%w: invite ID: %w", errBadRequest, err))
+		return
+	}
+
+	err = s.League.RevokeInvite.Handle(r.Context(), league.NewRevokeInviteCommand(leagueID, domain.InviteID(id), actor(r)))
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// inviteCode puts the league in the invite link alongside the token, since the
+// token alone does not say which league's invites to look in.
+func inviteCode(leagueID domain.LeagueID, tok string) string {
+	return fmt.Sprintf("%d-%s", leagueID, tok)
+}
+
+// parseInviteCode reports a code that is not league-token as an unknown
+// invite, the same as a well-formed code whose token matches nothing.
+func parseInviteCode(code string) (domain.LeagueID, string, error) {
+	rawLeague, tok, ok := strings.Cut(code, "-")
+	if !ok || tok == "" {
+		return 0, "", domain.ErrInviteNotFound
+	}
+
+	id, err := strconv.Atoi(rawLeague)
+	if err != nil || id <= 0 {
+		return 0, "", domain.ErrInviteNotFound
+	}
+
+	return domain.LeagueID(id), tok, nil
+}
@@ -0,0 +1,204 @@
+package web_test
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/spcameron/dugout/internal/adapters/web"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/token"
+	"github.com/spcameron/dugout/internal/usecase/account"
+	"github.com/spcameron/dugout/internal/usecase/league"
+)
+
+// newcomer has an account but no team in LeagueA.
+const newcomer = domain.UserID(33)
+
+var invitePath = regexp.MustCompile(`href="(/invites/[^"]+)"`)
+
+type inviteFixture struct {
+	members  *testkit.FakeMembershipRepository
+	sessions *testkit.FakeSessionStore
+	routes   http.Handler
+}
+
+func newInviteFixture(t *testing.T) *inviteFixture {
+	t.Helper()
+
+	leagues := testkit.NewLeagueStoreInPhase(domain.PhasePreDraft)
+	members := testkit.NewLeagueMemberships()
+	sessions := testkit.NewFakeSessionStore()
+	clock := testkit.NewStubClock(testkit.TodayLock())
+
+	srv := web.NewServer(web.RosterCommands{}, web.RosterQueries{}, web.AccountHandlers{
+		Authenticate: account.NewAuthenticateHandler(sessions, clock),
+	}, web.LeagueCommands{
+		Join:         league.NewJoinLeagueHandler(leagues, members, clock),
+		IssueInvite:  league.NewIssueInviteHandler(leagues, members, clock),
+		RevokeInvite: league.NewRevokeInviteHandler(leagues, members, clock),
+	}, stubPinger{}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	return &inviteFixture{
+		members:  members,
+		sessions: sessions,
+		routes:   srv.Routes(),
+	}
+}
+
+// do sends the request as user, who is signed in for it, or anonymously when
+// user is zero.
+func (f *inviteFixture) do(t *testing.T, user domain.UserID, method, target string, form url.Values) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(method, target, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if user != 0 {
+		tok := fmt.Sprintf("token-for-user-%d", user)
+		err := f.sessions.Create(t.Context(), ports.Session{
+			TokenHash: token.Hash(tok),
+			UserID:    user,
+			ExpiresAt: testkit.TomorrowLock(),
+		})
+		require.NoError(t, err)
+		req.AddCookie(&http.Cookie{Name: "dugout_session", Value: tok})
+	}
+
+	rec := httptest.NewRecorder()
+	f.routes.ServeHTTP(rec, req)
+
+	return rec
+}
+
+// issue has the commissioner issue an invite and returns its link.
+func (f *inviteFixture) issue(t *testing.T, form url.Values) string {
+	t.Helper()
+
+	rec := f.do(t, testkit.Commissioner(), http.MethodPost, "/leagues/1/invites", form)
+	require.Equal(t, rec.Code, http.StatusCreated)
+
+	match := invitePath.FindStringSubmatch(rec.Body.String())
+	require.Equal(t, len(match), 2)
+
+	return match[1]
+}
+
+func TestInviteRoutes(t *testing.T) {
+	t.Run("commissioner issues an invite that a signed-in user redeems", func(t *testing.T) {
+		f := newInviteFixture(t)
+		link := f.issue(t, url.Values{"expires_in": {"24h"}, "single_use": {"on"}})
+		assert.True(t, strings.HasPrefix(link, "/invites/1-"))
+
+		rec := f.do(t, newcomer, http.MethodGet, link, nil)
+		require.Equal(t, rec.Code, http.StatusOK)
+		assert.Contains(t, rec.Body.String(), `name="team_name"`)
+
+		rec = f.do(t, newcomer, http.MethodPost, link, url.Values{"team_name": {"Team C"}})
+		require.Equal(t, rec.Code, http.StatusSeeOther)
+		assert.True(t, strings.HasPrefix(rec.Header().Get("Location"), "/teams/"))
+
+		memberships, err := f.members.ListForUser(t.Context(), newcomer)
+		require.NoError(t, err)
+		assert.True(t, memberships.InLeague(testkit.LeagueA()))
+
+		rec = f.do(t, domain.UserID(44), http.MethodPost, link, url.Values{"team_name": {"Team D"}})
+		assert.Equal(t, rec.Code, http.StatusUnprocessableEntity)
+		assert.Contains(t, rec.Body.String(), "That invite has already been used")
+	})
+
+	t.Run("revoked invite cannot be redeemed", func(t *testing.T) {
+		f := newInviteFixture(t)
+		link := f.issue(t, nil)
+
+		rec := f.do(t, testkit.Commissioner(), http.MethodDelete, "/leagues/1/invites/1", nil)
+		require.Equal(t, rec.Code, http.StatusNoContent)
+
+		rec = f.do(t, newcomer, http.MethodPost, link, url.Values{"team_name": {"Team C"}})
+		assert.Equal(t, rec.Code, http.StatusUnprocessableEntity)
+		assert.Contains(t, rec.Body.String(), "That invite has been revoked")
+	})
+
+	t.Run("anonymous visitor is sent to sign in first", func(t *testing.T) {
+		f := newInviteFixture(t)
+		link := f.issue(t, nil)
+
+		rec := f.do(t, 0, http.MethodGet, link, nil)
+
+		assert.Equal(t, rec.Code, http.StatusSeeOther)
+		assert.Equal(t, rec.Header().Get("Location"), "/login?next="+url.QueryEscape(link))
+	})
+
+	testCases := []struct {
+		name       string
+		user       domain.UserID
+		method     string
+		target     string
+		form       url.Values
+		wantStatus int
+	}{
+		{
+			name:       "manager cannot issue invites",
+			user:       testkit.ManagerA(),
+			method:     http.MethodPost,
+			target:     "/leagues/1/invites",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "manager cannot revoke invites",
+			user:       testkit.ManagerA(),
+			method:     http.MethodDelete,
+			target:     "/leagues/1/invites/1",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "malformed expiry is a bad request",
+			user:       testkit.Commissioner(),
+			method:     http.MethodPost,
+			target:     "/leagues/1/invites",
+			form:       url.Values{"expires_in": {"a week"}},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "revoking an unknown invite is not found",
+			user:       testkit.Commissioner(),
+			method:     http.MethodDelete,
+			target:     "/leagues/1/invites/9",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "malformed invite link is not found",
+			user:       newcomer,
+			method:     http.MethodGet,
+			target:     "/invites/not-a-league",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "unknown invite token is not found",
+			user:       newcomer,
+			method:     http.MethodPost,
+			target:     "/invites/1-UNKNOWN",
+			form:       url.Values{"team_name": {"Team C"}},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newInviteFixture(t)
+
+			rec := f.do(t, tc.user, tc.method, tc.target, tc.form)
+
+			assert.Equal(t, rec.Code, tc.wantStatus)
+		})
+	}
+}
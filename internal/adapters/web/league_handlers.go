@@ -0,0 +1,203 @@
+package web
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/go-chi/chi/v5"
+
+	"github.com/spcameron/dugout/internal/adapters/web/views"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+)
+
+const (
+	// streamHeartbeat keeps idle streams from being closed by proxies.
+	streamHeartbeat = 15 * time.Second
+	// streamWriteTimeout drops a client that stops reading. It reconnects with
+	// its last event ID and picks up where it left off.
+	streamWriteTimeout = 10 * time.Second
+	// streamRetry is how long the browser waits before reconnecting.
+	streamRetry = 3 * time.Second
+)
+
+func (s *Server) handleLeaguePage(w http.ResponseWriter, r *http.Request) {
+	leagueID, err := leagueIDParam(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	err = s.Live.Authorize(r.Context(), actor(r), leagueID)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	s.render(w, r, http.StatusOK, views.LeaguePage(leagueID))
+}
+
+// handleLeagueEvents streams the league's roster changes and trade status
+// changes as server-sent events, named roster and trade, whose IDs are log
+// positions. A reconnecting client sends the last one back as
+// Last-Event-ID and resumes after it; a new client starts from the feed's head.
+//
+// Each stream reads the feed at its own pace, so a slow client only delays
+// itself, and one that stalls past streamWriteTimeout is disconnected.
+func (s *Server) handleLeagueEvents(w http.ResponseWriter, r *http.Request) {
+	leagueID, err := leagueIDParam(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	err = s.Live.Authorize(r.Context(), actor(r), leagueID)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	after := s.Live.Head()
+	lastID := r.Header.Get("Last-Event-ID")
+	if lastID != "" {
+		after, err = parsePosition(lastID)
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+	}
+
+	rc := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	err = writeStream(rc, w, fmt.Sprintf("retry: %d\n\n", streamRetry.Milliseconds()))
+	if err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	ctx := r.Context()
+	for {
+		updates, next, wait, err := s.Live.Since(ctx, leagueID, after)
+		if err != nil {
+			if ctx.Err() == nil {
+				s.Logger.ErrorContext(ctx, "read league events", "league_id", leagueID, "err", err)
+			}
+			return
+		}
+
+		var buf bytes.Buffer
+		for _, u := range updates {
+			name := "roster"
+			if u.Trade != nil {
+				name = "trade"
+			}
+
+			err := writeEvent(&buf, r, name, u.Position, views.ActivityItem(views.Activity{
+				TeamID:     u.TeamID,
+				PlayerName: u.PlayerName,
+				Event:      u.Event,
+				Trade:      u.Trade,
+			}))
+			if err != nil {
+				s.Logger.ErrorContext(ctx, "render league event", "league_id", leagueID, "err", err)
+				return
+			}
+		}
+
+		if buf.Len() > 0 {
+			err := writeStream(rc, w, buf.String())
+			if err != nil {
+				return
+			}
+		}
+
+		after = next
+		if wait == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.streamsClosed:
+			return
+		case <-wait:
+		case <-heartbeat.C:
+			err := writeStream(rc, w, ": ping\n\n")
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+// CloseStreams ends every open event stream. http.Server.Shutdown waits for
+// handlers to return but does not cancel their requests, so register it with
+// RegisterOnShutdown.
+func (s *Server) CloseStreams() {
+	s.closeStreams.Do(func() { close(s.streamsClosed) })
+}
+
+// writeStream writes and flushes chunk within streamWriteTimeout.
+func writeStream(rc *http.ResponseController, w io.Writer, chunk string) error {
+	err := rc.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+
+	_, err = io.WriteString(w, chunk)
+	if err != nil {
+		return err
+	}
+
+	return rc.Flush()
+}
+
+// writeEvent renders c as the data of one event, a line per data field.
+func writeEvent(buf *bytes.Buffer, r *http.Request, name string, id eventlog.Position, c templ.Component) error {
+	var html bytes.Buffer
+	err := c.Render(r.Context(), &html)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(buf, "event: %s\nid: %d\n", name, id)
+	for _, line := range bytes.Split(bytes.TrimRight(html.Bytes(), "\n"), []byte("\n")) {
+		buf.WriteString("data: ")
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	buf.WriteByte('\n')
+
+	return nil
+}
+
+func leagueIDParam(r *http.Request) (domain.LeagueID, error) {
+	id, err := parseID(chi.URLParam(r, "leagueID"))
+	if err != nil {
+		return 0, fmt.Errorf("%w: league ID: %w", errBadRequest, err)
+	}
+
+	return domain.LeagueID(id), nil
+}
+
+func parsePosition(raw string) (eventlog.Position, error) {
+	pos, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || pos < 0 {
+		return 0, fmt.Errorf("%w: Last-Event-ID %q is not a log position", errBadRequest, raw)
+	}
+
+	return eventlog.Position(pos), nil
+}
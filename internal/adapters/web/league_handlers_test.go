@@ -0,0 +1,282 @@
+package web_test
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/adapters/web"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/token"
+	"github.com/spcameron/dugout/internal/usecase/account"
+	"github.com/spcameron/dugout/internal/usecase/live"
+)
+
+type leagueFixture struct {
+	store    *testkit.FakeRosterStore
+	trades   *testkit.FakeTradeStore
+	feed     *live.Feed
+	srv      *web.Server
+	sessions *testkit.FakeSessionStore
+	http     *httptest.Server
+}
+
+func newLeagueFixture(t *testing.T) *leagueFixture {
+	t.Helper()
+
+	store := testkit.NewFakeRosterStore()
+	trades := testkit.NewFakeTradeStore()
+	trades.Positions = store.Positions
+	players := testkit.NewFakePlayerRepository()
+	players.SeedPlayers(domain.Player{ID: 7, Name: "Ronald Acuña Jr.", Roles: domain.MustRoleSet(domain.RoleHitter)})
+	feed := live.NewFeed(store, trades, trades, testkit.NewLeagueMemberships(), players)
+	require.NoError(t, feed.Start(t.Context()))
+
+	sessions := testkit.NewFakeSessionStore()
+	srv := web.NewServer(web.RosterCommands{}, web.RosterQueries{}, web.AccountHandlers{
+		Authenticate: account.NewAuthenticateHandler(sessions, testkit.NewStubClock(testkit.TodayLock())),
+	}, web.LeagueCommands{}, stubPinger{}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	srv.Live = feed
+
+	f := &leagueFixture{
+		store:    store,
+		trades:   trades,
+		feed:     feed,
+		srv:      srv,
+		sessions: sessions,
+		http:     httptest.NewServer(srv.Routes()),
+	}
+	t.Cleanup(f.http.Close)
+	t.Cleanup(srv.CloseStreams)
+
+	return f
+}
+
+// get requests path as user, who is signed in for the request.
+func (f *leagueFixture) get(t *testing.T, user domain.UserID, path string, header http.Header) *http.Response {
+	t.Helper()
+
+	tok := fmt.Sprintf("token-for-user-%d", user)
+	err := f.sessions.Create(t.Context(), ports.Session{
+		TokenHash: token.Hash(tok),
+		UserID:    user,
+		ExpiresAt: testkit.TomorrowLock(),
+	})
+	require.NoError(t, err)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, f.http.URL+path, nil)
+	require.NoError(t, err)
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	req.AddCookie(&http.Cookie{Name: "dugout_session", Value: tok})
+
+	resp, err := f.http.Client().Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+
+	return resp
+}
+
+func (f *leagueFixture) record(t *testing.T, events ...domain.RosterEvent) {
+	t.Helper()
+
+	for _, e := range events {
+		f.store.SeedEvents(e.Team(), []domain.RosterEvent{e})
+	}
+	require.NoError(t, f.feed.Poll(t.Context()))
+}
+
+func (f *leagueFixture) recordTrade(t *testing.T, id domain.TradeID, events ...domain.TradeEvent) {
+	t.Helper()
+
+	f.trades.SeedEvents(id, events)
+	require.NoError(t, f.feed.Poll(t.Context()))
+}
+
+type sseEvent struct {
+	name string
+	id   string
+	data string
+}
+
+// readEvent returns the next event on the stream, skipping comments and the
+// retry field.
+func readEvent(t *testing.T, r *bufio.Reader) sseEvent {
+	t.Helper()
+
+	lines := make(chan sseEvent, 1)
+	go func() {
+		var e sseEvent
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				close(lines)
+				return
+			}
+
+			line = strings.TrimSuffix(line, "\n")
+			switch {
+			case line == "" && e.name != "":
+				lines <- e
+				return
+			case strings.HasPrefix(line, "event: "):
+				e.name = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "id: "):
+				e.id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "data: "):
+				e.data += strings.TrimPrefix(line, "data: ")
+			}
+		}
+	}()
+
+	select {
+	case e, ok := <-lines:
+		require.True(t, ok)
+		return e
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for an event")
+		return sseEvent{}
+	}
+}
+
+func added(team domain.TeamID, player domain.PlayerID) domain.RosterEvent {
+	return domain.AddedPlayerToRoster{TeamID: team, PlayerID: player, EffectiveAt: testkit.TodayLock()}
+}
+
+func TestLeaguePage(t *testing.T) {
+	testCases := []struct {
+		name string
+		user domain.UserID
+		want int
+	}{
+		{name: "manager in the league", user: testkit.ManagerA(), want: http.StatusOK},
+		{name: "commissioner of the league", user: testkit.Commissioner(), want: http.StatusOK},
+		{name: "user outside the league", user: 33, want: http.StatusForbidden},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newLeagueFixture(t)
+
+			resp := f.get(t, tc.user, "/leagues/1", nil)
+
+			assert.Equal(t, resp.StatusCode, tc.want)
+		})
+	}
+
+	t.Run("connects to the league's event stream", func(t *testing.T) {
+		f := newLeagueFixture(t)
+
+		resp := f.get(t, testkit.ManagerA(), "/leagues/1", nil)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+
+		assert.Contains(t, string(body), `hx-ext="sse"`)
+		assert.Contains(t, string(body), `sse-connect="/leagues/1/events"`)
+		assert.Contains(t, string(body), `sse-swap="roster,trade"`)
+	})
+}
+
+func TestLeagueEvents(t *testing.T) {
+	t.Run("streams the league's roster changes as they are recorded", func(t *testing.T) {
+		f := newLeagueFixture(t)
+
+		resp := f.get(t, testkit.ManagerA(), "/leagues/1/events", nil)
+		require.Equal(t, resp.StatusCode, http.StatusOK)
+		assert.Contains(t, resp.Header.Get("Content-Type"), "text/event-stream")
+		stream := bufio.NewReader(resp.Body)
+
+		f.record(t, added(testkit.TeamC(), 8), added(testkit.TeamB(), 7))
+
+		e := readEvent(t, stream)
+		assert.Equal(t, e.name, "roster")
+		assert.Equal(t, e.id, "2")
+		assert.Contains(t, e.data, "<li")
+		assert.Contains(t, e.data, "Team 222 added Ronald Acuña Jr.")
+	})
+
+	t.Run("streams trade status changes between roster changes", func(t *testing.T) {
+		f := newLeagueFixture(t)
+
+		resp := f.get(t, testkit.ManagerA(), "/leagues/1/events", nil)
+		require.Equal(t, resp.StatusCode, http.StatusOK)
+		stream := bufio.NewReader(resp.Body)
+
+		terms := domain.TradeTerms{
+			Proposer:      testkit.TeamA(),
+			Receiver:      testkit.TeamB(),
+			ProposerSends: []domain.PlayerID{1},
+			ReceiverSends: []domain.PlayerID{2},
+		}
+		f.record(t, added(testkit.TeamA(), 7))
+		f.recordTrade(t, 5, domain.ProposedTrade{TradeID: 5, Terms: terms, ProposedBy: testkit.ManagerA(), ProposedAt: testkit.TodayLock()})
+
+		assert.Equal(t, readEvent(t, stream).name, "roster")
+
+		e := readEvent(t, stream)
+		assert.Equal(t, e.name, "trade")
+		assert.Equal(t, e.id, "2")
+		assert.Contains(t, e.data, "Team 111 offered team 222 a trade")
+	})
+
+	t.Run("resumes after Last-Event-ID", func(t *testing.T) {
+		f := newLeagueFixture(t)
+		f.record(t, added(testkit.TeamA(), 1), added(testkit.TeamA(), 2), added(testkit.TeamB(), 3))
+
+		resp := f.get(t, testkit.ManagerB(), "/leagues/1/events", http.Header{"Last-Event-Id": {"1"}})
+		require.Equal(t, resp.StatusCode, http.StatusOK)
+		stream := bufio.NewReader(resp.Body)
+
+		assert.Equal(t, readEvent(t, stream).id, "2")
+		assert.Equal(t, readEvent(t, stream).id, "3")
+	})
+
+	t.Run("rejects a Last-Event-ID that is not a position", func(t *testing.T) {
+		f := newLeagueFixture(t)
+
+		resp := f.get(t, testkit.ManagerA(), "/leagues/1/events", http.Header{"Last-Event-Id": {"abc"}})
+
+		assert.Equal(t, resp.StatusCode, http.StatusBadRequest)
+	})
+
+	t.Run("rejects users outside the league", func(t *testing.T) {
+		f := newLeagueFixture(t)
+
+		resp := f.get(t, 33, "/leagues/1/events", nil)
+
+		assert.Equal(t, resp.StatusCode, http.StatusForbidden)
+	})
+
+	t.Run("ends open streams on shutdown", func(t *testing.T) {
+		f := newLeagueFixture(t)
+
+		resp := f.get(t, testkit.ManagerA(), "/leagues/1/events", nil)
+		require.Equal(t, resp.StatusCode, http.StatusOK)
+
+		f.srv.CloseStreams()
+
+		done := make(chan error, 1)
+		go func() {
+			_, err := io.ReadAll(resp.Body)
+			done <- err
+		}()
+
+		select {
+		case err := <-done:
+			require.NoError(t, err)
+		case <-time.After(2 * time.Second):
+			t.Fatal("stream stayed open after CloseStreams")
+		}
+	})
+}
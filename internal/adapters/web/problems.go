@@ -0,0 +1,212 @@
+package web
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/spcameron/dugout/internal/adapters/web/views"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/telemetry"
+)
+
+// problemTypePrefix namespaces problem type URIs. Clients should branch on Code,
+// which is the same string without the prefix.
+const problemTypePrefix = "urn:dugout:problem:"
+
+// alertsTarget is the element in the page layout that receives error fragments
+// which have no panel of their own to land in.
+const alertsTarget = "#alerts"
+
+var errBadRequest = errors.New("bad request")
+
+// Problem is an RFC 7807 problem details body. Code is stable across releases;
+// Title is for people and may be reworded.
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	Code     string `json:"code"`
+}
+
+type problemKind struct {
+	target error
+	status int
+	code   string
+	title  string
+}
+
+// problemKinds is checked in order with errors.Is, so an error that wraps more
+// than one sentinel is reported as the first match.
+var problemKinds = []problemKind{
+	// Replay errors wrap the domain error the event broke, which must not be
+	// reported as if the request had broken it.
+	{eventlog.ErrUnreplayableEvent, http.StatusInternalServerError, "roster_unreadable", "That roster's history could not be read"},
+
+	{errBadRequest, http.StatusBadRequest, "bad_request", "The request is malformed"},
+
+	{errUnauthenticated, http.StatusUnauthorized, "unauthenticated", "Please sign in"},
+	{domain.ErrInvalidCredentials, http.StatusUnauthorized, "invalid_credentials", "That email and password do not match"},
+
+	{domain.ErrNotAuthorized, http.StatusForbidden, "not_authorized", "You cannot make changes to that team"},
+
+	{domain.ErrInviteNotFound, http.StatusNotFound, "invite_not_found", "That invite link is not valid"},
+	{ports.ErrLeagueNotFound, http.StatusNotFound, "league_not_found", "No such league"},
+	{domain.ErrMatchupNotFound, http.StatusNotFound, "matchup_not_found", "No such playoff matchup"},
+	{ports.ErrPlayerNotFound, http.StatusNotFound, "player_not_found", "No such player"},
+	{domain.ErrPlayerNotOnRoster, http.StatusNotFound, "player_not_on_roster", "That player is not on the roster"},
+	{eventlog.ErrRecordedEventNotFound, http.StatusNotFound, "roster_event_not_found", "No such roster event"},
+	{ports.ErrTeamNotFound, http.StatusNotFound, "team_not_found", "No such team"},
+	{domain.ErrTeamNotInLeague, http.StatusNotFound, "team_not_in_league", "That team is not in the league"},
+
+	{ports.ErrVersionConflict, http.StatusConflict, "version_conflict", "The roster changed while you were editing it; please try again"},
+	{ports.ErrEmailTaken, http.StatusConflict, "email_taken", "That email is already registered"},
+	{domain.ErrAlreadyVotedOnTrade, http.StatusConflict, "already_voted_on_trade", "Your team has already voted on this trade"},
+	{domain.ErrDuplicateTeamName, http.StatusConflict, "duplicate_team_name", "Another team in the league already has that name"},
+	{domain.ErrLeagueAlreadyCreated, http.StatusConflict, "league_already_created", "That league already exists"},
+	{domain.ErrManagerAlreadyInLeague, http.StatusConflict, "manager_already_in_league", "You already manage a team in that league"},
+	{domain.ErrMatchupAlreadyDecided, http.StatusConflict, "matchup_already_decided", "That matchup has already been decided"},
+	{domain.ErrMatchupResultRecorded, http.StatusConflict, "matchup_result_recorded", "That team already has a result for the period"},
+	{domain.ErrPlayerAlreadyActive, http.StatusConflict, "player_already_active", "That player is already active"},
+	{domain.ErrPlayerAlreadyInactive, http.StatusConflict, "player_already_inactive", "That player is already benched"},
+	{domain.ErrPlayerAlreadyOnRoster, http.StatusConflict, "player_already_on_roster", "That player is already on the roster"},
+	{domain.ErrRosterEventAlreadyReversed, http.StatusConflict, "roster_event_already_reversed", "That move has already been reversed"},
+	{domain.ErrScheduledMoveConflict, http.StatusConflict, "scheduled_move_conflict", "That move conflicts with a move already scheduled"},
+	{domain.ErrTradeAlreadyAccepted, http.StatusConflict, "trade_already_accepted", "That trade has already been accepted"},
+
+	{domain.ErrActiveHittersFull, http.StatusUnprocessableEntity, "active_hitters_full", "The active hitter slots are full"},
+	{domain.ErrActivePitchersFull, http.StatusUnprocessableEntity, "active_pitchers_full", "The active pitcher slots are full"},
+	{domain.ErrInvalidEmail, http.StatusUnprocessableEntity, "invalid_email", "That is not a valid email address"},
+	{domain.ErrInvalidInvite, http.StatusUnprocessableEntity, "invalid_invite", "Invites need an expiry in the future"},
+	{domain.ErrInvalidLeagueSettings, http.StatusUnprocessableEntity, "invalid_league_settings", "Those league settings are not valid"},
+	{domain.ErrInvalidMatchupResult, http.StatusUnprocessableEntity, "invalid_matchup_result", "That is not a valid matchup result"},
+	{domain.ErrInvalidName, http.StatusUnprocessableEntity, "invalid_name", fmt.Sprintf("Names must be 1 to %d characters", domain.MaxNameLength)},
+	{domain.ErrInvalidPhaseTransition, http.StatusUnprocessableEntity, "invalid_phase_transition", "The league cannot move to that phase"},
+	{domain.ErrInvalidPlayoffSettings, http.StatusUnprocessableEntity, "invalid_playoff_settings", "Those teams and byes do not make a bracket"},
+	{domain.ErrInvalidStandings, http.StatusUnprocessableEntity, "invalid_standings", "The standings do not match the league's teams"},
+	{domain.ErrInvalidTradeTerms, http.StatusUnprocessableEntity, "invalid_trade_terms", "Those trade terms are not valid"},
+	{domain.ErrInvalidUser, http.StatusUnprocessableEntity, "invalid_user", "A display name is required"},
+	{domain.ErrInviteAlreadyUsed, http.StatusUnprocessableEntity, "invite_already_used", "That invite has already been used"},
+	{domain.ErrInviteExpired, http.StatusUnprocessableEntity, "invite_expired", "That invite has expired"},
+	{domain.ErrInviteRevoked, http.StatusUnprocessableEntity, "invite_revoked", "That invite has been revoked"},
+	{domain.ErrLeagueClosedToTeams, http.StatusUnprocessableEntity, "league_closed_to_teams", "The league is no longer accepting teams"},
+	{domain.ErrLeagueFull, http.StatusUnprocessableEntity, "league_full", "The league is full"},
+	{domain.ErrLeagueSettingsLocked, http.StatusUnprocessableEntity, "league_settings_locked", "League settings are locked once the draft starts"},
+	{domain.ErrLineupMoveLimitReached, http.StatusUnprocessableEntity, "lineup_move_limit_reached", "That player has been moved as often as the league allows this period"},
+	{domain.ErrLockPassed, http.StatusUnprocessableEntity, "lock_passed", "That lock has already passed"},
+	{domain.ErrMatchupNotReady, http.StatusUnprocessableEntity, "matchup_not_ready", "That matchup is waiting on earlier results"},
+	{domain.ErrNotEnoughTeams, http.StatusUnprocessableEntity, "not_enough_teams", "The league needs more teams first"},
+	{domain.ErrOverrideReasonRequired, http.StatusUnprocessableEntity, "override_reason_required", "An override needs a reason"},
+	{domain.ErrPeriodAddLimitReached, http.StatusUnprocessableEntity, "period_add_limit_reached", "Your team has used its adds for this period"},
+	{domain.ErrPlayerNotEligibleForRole, http.StatusUnprocessableEntity, "player_not_eligible_for_role", "That player is not eligible for that role"},
+	{domain.ErrPlayoffsNotStarted, http.StatusUnprocessableEntity, "playoffs_not_started", "The playoffs have not started"},
+	{domain.ErrPlayoffsUndecided, http.StatusUnprocessableEntity, "playoffs_undecided", "Every playoff matchup must be decided first"},
+	{domain.ErrRegularSeasonNotInProgress, http.StatusUnprocessableEntity, "regular_season_not_in_progress", "The regular season is not in progress"},
+	{domain.ErrRosterEventNotReversible, http.StatusUnprocessableEntity, "roster_event_not_reversible", "That move cannot be reversed"},
+	{domain.ErrRosterFull, http.StatusUnprocessableEntity, "roster_full", "The roster is full"},
+	{domain.ErrRosterMovesClosed, http.StatusUnprocessableEntity, "roster_moves_closed", "Roster moves are closed in this part of the season"},
+	{domain.ErrSeasonAddLimitReached, http.StatusUnprocessableEntity, "season_add_limit_reached", "Your team has used its adds for the season"},
+	{domain.ErrTradeNotUnderReview, http.StatusUnprocessableEntity, "trade_not_under_review", "That trade is not under review"},
+	{domain.ErrTradeParticipantCannotVote, http.StatusUnprocessableEntity, "trade_participant_cannot_vote", "Teams in a trade cannot vote on it"},
+	{domain.ErrTradeReviewClosed, http.StatusUnprocessableEntity, "trade_review_closed", "The trade review window has closed"},
+	{domain.ErrTradeReviewOpen, http.StatusUnprocessableEntity, "trade_review_open", "The trade review window is still open"},
+	{domain.ErrUnrecognizedPlayerRole, http.StatusUnprocessableEntity, "unrecognized_player_role", "That is not a player role"},
+	{domain.ErrWeakPassword, http.StatusUnprocessableEntity, "weak_password", fmt.Sprintf("Passwords must be %d to %d characters", domain.MinPasswordLength, domain.MaxPasswordLength)},
+}
+
+// rejectedProblem reports a domain rejection that has no kind of its own. It is
+// still the caller's to fix, so it is never an internal error.
+var rejectedProblem = problemKind{
+	status: http.StatusUnprocessableEntity,
+	code:   "rejected",
+	title:  "That request was turned down",
+}
+
+var internalProblem = problemKind{
+	status: http.StatusInternalServerError,
+	code:   "internal_error",
+	title:  "Something went wrong on our end",
+}
+
+// ProblemFor translates err into the problem reported to clients. Rejections
+// not in problemKinds become rejected, and other errors it does not recognize
+// become an internal_error; server errors never carry a detail, so the cause
+// stays in the logs.
+func ProblemFor(err error) Problem {
+	kind := kindOf(err)
+	detail := ""
+	if kind.status < http.StatusInternalServerError {
+		detail = err.Error()
+	}
+
+	return Problem{
+		Type:   problemTypePrefix + kind.code,
+		Title:  kind.title,
+		Status: kind.status,
+		Detail: detail,
+		Code:   kind.code,
+	}
+}
+
+func kindOf(err error) problemKind {
+	for _, k := range problemKinds {
+		if errors.Is(err, k.target) {
+			return k
+		}
+	}
+
+	if errors.As(err, new(*domain.Rejection)) {
+		return rejectedProblem
+	}
+
+	return internalProblem
+}
+
+// writeError reports a failed request. htmx requests get an alert fragment aimed
+// at the layout's alert region; everyone else gets problem+json. Unrecognized
+// errors are logged, since the client is never told what went wrong.
+func (s *Server) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	p := s.problem(r, err)
+
+	if isHTMX(r) {
+		w.Header().Set("HX-Retarget", alertsTarget)
+		w.Header().Set("HX-Reswap", "innerHTML")
+		s.render(w, r, p.Status, views.Alert(p.Title))
+		return
+	}
+
+	writeProblem(w, p)
+}
+
+// problem translates err for this request, logging it if it is unrecognized. The
+// request's span is tagged with the problem code.
+func (s *Server) problem(r *http.Request, err error) Problem {
+	p := ProblemFor(err)
+	p.Instance = r.URL.Path
+	trace.SpanFromContext(r.Context()).SetAttributes(telemetry.ErrorClass.String(p.Code))
+	if p.Status >= http.StatusInternalServerError {
+		s.Logger.ErrorContext(r.Context(), "request failed",
+			"err", err,
+			"method", r.Method,
+			"path", r.URL.Path,
+			"request_id", middleware.GetReqID(r.Context()),
+		)
+	}
+
+	return p
+}
+
+func writeProblem(w http.ResponseWriter, p Problem) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p)
+}
@@ -0,0 +1,124 @@
+package web_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/spcameron/dugout/internal/adapters/web"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/roster"
+)
+
+func TestProblemFor(t *testing.T) {
+	testCases := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name:       "roster full is unprocessable",
+			err:        domain.ErrRosterFull,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantCode:   "roster_full",
+		},
+		{
+			name:       "active hitters full is unprocessable",
+			err:        domain.ErrActiveHittersFull,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantCode:   "active_hitters_full",
+		},
+		{
+			name:       "player already on roster is a conflict",
+			err:        domain.ErrPlayerAlreadyOnRoster,
+			wantStatus: http.StatusConflict,
+			wantCode:   "player_already_on_roster",
+		},
+		{
+			name:       "unknown player is not found",
+			err:        ports.ErrPlayerNotFound,
+			wantStatus: http.StatusNotFound,
+			wantCode:   "player_not_found",
+		},
+		{
+			name:       "roster moves outside the season are unprocessable",
+			err:        domain.ErrRosterMovesClosed,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantCode:   "roster_moves_closed",
+		},
+		{
+			name:       "unknown league is not found",
+			err:        ports.ErrLeagueNotFound,
+			wantStatus: http.StatusNotFound,
+			wantCode:   "league_not_found",
+		},
+		{
+			name:       "wrapped sentinel is still recognized",
+			err:        fmt.Errorf("add player 7: %w", domain.ErrActivePitchersFull),
+			wantStatus: http.StatusUnprocessableEntity,
+			wantCode:   "active_pitchers_full",
+		},
+		{
+			name:       "version conflict error is a conflict",
+			err:        &ports.VersionConflictError{TeamID: testkit.TeamA(), Current: 3, Expected: 2},
+			wantStatus: http.StatusConflict,
+			wantCode:   "version_conflict",
+		},
+		{
+			name: "replay error is internal even when it wraps a domain error",
+			err: &roster.ReplayError{
+				TeamID:   testkit.TeamA(),
+				Sequence: 4,
+				Event:    domain.RemovedPlayerFromRoster{TeamID: testkit.TeamA(), PlayerID: 7},
+				Err:      domain.ErrPlayerNotOnRoster,
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantCode:   "roster_unreadable",
+		},
+		{
+			name:       "rejection without a kind of its own is unprocessable",
+			err:        fmt.Errorf("adding player 7: %w", domain.ErrInvalidPlayer),
+			wantStatus: http.StatusUnprocessableEntity,
+			wantCode:   "rejected",
+		},
+		{
+			name:       "unknown error is internal",
+			err:        errors.New("disk on fire"),
+			wantStatus: http.StatusInternalServerError,
+			wantCode:   "internal_error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := web.ProblemFor(tc.err)
+
+			assert.Equal(t, p.Status, tc.wantStatus)
+			assert.Equal(t, p.Code, tc.wantCode)
+			assert.Equal(t, p.Type, "urn:dugout:problem:"+tc.wantCode)
+			assert.True(t, p.Title != "")
+			if tc.wantStatus == http.StatusInternalServerError {
+				assert.Equal(t, p.Detail, "")
+			} else {
+				assert.Equal(t, p.Detail, tc.err.Error())
+			}
+		})
+	}
+}
+
+func TestUnknownErrorsAreLogged(t *testing.T) {
+	f := newRosterFixture(t, &testkit.FailingAppendRosterStore{})
+
+	rec := f.do(http.MethodPost, "/teams/111/roster/players", url.Values{"player_id": {"1"}})
+
+	require.Equal(t, rec.Code, http.StatusInternalServerError)
+	assert.Contains(t, f.logs.String(), testkit.ErrFailingAppend.Error())
+	assert.Contains(t, f.logs.String(), "path=/teams/111/roster/players")
+}
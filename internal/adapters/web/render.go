@@ -0,0 +1,24 @@
+package web
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/a-h/templ"
+)
+
+// render buffers the component so a failed render can still become a 500 rather
+// than a truncated page. The failure is reported as problem+json, since the
+// fallback must not depend on rendering.
+func (s *Server) render(w http.ResponseWriter, r *http.Request, status int, c templ.Component) {
+	var buf bytes.Buffer
+	err := c.Render(r.Context(), &buf)
+	if err != nil {
+		writeProblem(w, s.problem(r, err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = buf.WriteTo(w)
+}
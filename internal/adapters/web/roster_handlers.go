@@ -0,0 +1,190 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/spcameron/dugout/internal/adapters/web/views"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/usecase/roster"
+)
+
+func (s *Server) handleRosterPage(w http.ResponseWriter, r *http.Request) {
+	teamID, err := teamIDParam(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	data, err := s.rosterData(r.Context(), teamID)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	s.render(w, r, http.StatusOK, views.RosterPage(data))
+}
+
+func (s *Server) handleAddPlayer(w http.ResponseWriter, r *http.Request) {
+	teamID, err := teamIDParam(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	playerID, err := parsePlayerID(r.FormValue("player_id"))
+	if err == nil {
+		err = s.Roster.Add.Handle(r.Context(), roster.NewAddPlayerCommand(teamID, playerID, actor(r)))
+	}
+
+	s.respondToRosterCommand(w, r, teamID, err)
+}
+
+func (s *Server) handleRemovePlayer(w http.ResponseWriter, r *http.Request) {
+	teamID, err := teamIDParam(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	playerID, err := playerIDParam(r)
+	if err == nil {
+		err = s.Roster.Remove.Handle(r.Context(), roster.NewRemovePlayerCommand(teamID, playerID, actor(r)))
+	}
+
+	s.respondToRosterCommand(w, r, teamID, err)
+}
+
+func (s *Server) handleActivatePlayer(w http.ResponseWriter, r *http.Request) {
+	teamID, err := teamIDParam(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	playerID, err := playerIDParam(r)
+	if err == nil {
+		var role domain.PlayerRole
+		role, err = domain.ParsePlayerRole(r.FormValue("role"))
+		if err != nil {
+			err = fmt.Errorf("%w: %w", errBadRequest, err)
+		} else {
+			err = s.Roster.Activate.Handle(r.Context(), roster.NewActivatePlayerCommand(teamID, playerID, role, actor(r)))
+		}
+	}
+
+	s.respondToRosterCommand(w, r, teamID, err)
+}
+
+func (s *Server) handleInactivatePlayer(w http.ResponseWriter, r *http.Request) {
+	teamID, err := teamIDParam(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	playerID, err := playerIDParam(r)
+	if err == nil {
+		err = s.Roster.Inactivate.Handle(r.Context(), roster.NewInactivatePlayerCommand(teamID, playerID, actor(r)))
+	}
+
+	s.respondToRosterCommand(w, r, teamID, err)
+}
+
+// respondToRosterCommand answers htmx requests with a fresh roster panel, carrying
+// any rejection as an inline message. Other clients get a bare status or a
+// problem.
+func (s *Server) respondToRosterCommand(w http.ResponseWriter, r *http.Request, teamID domain.TeamID, err error) {
+	if !isHTMX(r) {
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	status := http.StatusOK
+	var message string
+	if err != nil {
+		p := ProblemFor(err)
+		if p.Status >= http.StatusInternalServerError {
+			s.writeError(w, r, err)
+			return
+		}
+		status, message = p.Status, p.Title
+	}
+
+	data, loadErr := s.rosterData(r.Context(), teamID)
+	if loadErr != nil {
+		s.writeError(w, r, loadErr)
+		return
+	}
+	data.Error = message
+
+	s.render(w, r, status, views.RosterPanel(data))
+}
+
+func (s *Server) rosterData(ctx context.Context, teamID domain.TeamID) (views.RosterData, error) {
+	details, err := s.Rosters.View.Handle(ctx, roster.NewViewRosterQuery(teamID))
+	if err != nil {
+		return views.RosterData{}, err
+	}
+
+	return views.RosterData{
+		TeamID:  teamID,
+		View:    details.View,
+		Players: details.Players,
+	}, nil
+}
+
+// actor is the signed-in user making a roster change. Roster routes sit behind
+// requireUser, so there always is one.
+func actor(r *http.Request) domain.UserID {
+	id, _ := currentUser(r)
+	return id
+}
+
+func isHTMX(r *http.Request) bool {
+	return r.Header.Get("HX-Request") == "true"
+}
+
+func teamIDParam(r *http.Request) (domain.TeamID, error) {
+	id, err := parseID(chi.URLParam(r, "teamID"))
+	if err != nil {
+		return 0, fmt.Errorf("%w: team ID: %w", errBadRequest, err)
+	}
+
+	return domain.TeamID(id), nil
+}
+
+func playerIDParam(r *http.Request) (domain.PlayerID, error) {
+	return parsePlayerID(chi.URLParam(r, "playerID"))
+}
+
+func parsePlayerID(raw string) (domain.PlayerID, error) {
+	id, err := parseID(raw)
+	if err != nil {
+		return 0, fmt.Errorf("%w: player ID: %w", errBadRequest, err)
+	}
+
+	return domain.PlayerID(id), nil
+}
+
+func parseID(raw string) (int, error) {
+	id, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, err
+	}
+
+	if id <= 0 {
+		return 0, fmt.Errorf("%d is not positive", id)
+	}
+
+	return id, nil
+}
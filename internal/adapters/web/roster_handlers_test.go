@@ -0,0 +1,250 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/adapters/web"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/token"
+	"github.com/spcameron/dugout/internal/usecase/account"
+	"github.com/spcameron/dugout/internal/usecase/roster"
+)
+
+type rosterFixture struct {
+	store    *testkit.FakeRosterStore
+	lock     testkit.StubLeagueLock
+	users    *testkit.FakeUserRepository
+	sessions *testkit.FakeSessionStore
+	logs     *bytes.Buffer
+	routes   http.Handler
+
+	// cookie signs requests in. It starts as ManagerA's, who manages TeamA.
+	cookie *http.Cookie
+}
+
+func newRosterFixture(t *testing.T, rosterStore ports.RosterStore) *rosterFixture {
+	t.Helper()
+
+	store := testkit.NewFakeRosterStore()
+	if rosterStore == nil {
+		rosterStore = store
+	}
+
+	lock := testkit.NewStubLeagueLock()
+	players := testkit.NewFakePlayerRepository()
+	players.SeedPlayerIDs(1, 2)
+	access := roster.NewAccess(testkit.NewLeagueMemberships(), testkit.NewLeagueStoreInPhase(domain.PhaseInSeason))
+
+	users := testkit.NewFakeUserRepository()
+	sessions := testkit.NewFakeSessionStore()
+	clock := testkit.NewStubClock(testkit.TodayLock())
+	hasher := testkit.FakePasswordHasher{}
+
+	var logs bytes.Buffer
+	srv := web.NewServer(web.RosterCommands{
+		Add:        roster.NewAddPlayerHandler(rosterStore, lock, players, access),
+		Remove:     roster.NewRemovePlayerHandler(rosterStore, lock, access),
+		Activate:   roster.NewActivatePlayerHandler(rosterStore, lock, access),
+		Inactivate: roster.NewInactivatePlayerHandler(rosterStore, lock, access),
+	}, web.RosterQueries{
+		View: roster.NewViewRosterHandler(rosterStore, lock, players),
+	}, web.AccountHandlers{
+		Register:     account.NewRegisterUserHandler(users, hasher),
+		SignIn:       account.NewSignInHandler(users, hasher, sessions, clock, time.Hour),
+		SignOut:      account.NewSignOutHandler(sessions),
+		Authenticate: account.NewAuthenticateHandler(sessions, clock),
+	}, web.LeagueCommands{}, stubPinger{}, slog.New(slog.NewTextHandler(&logs, nil)))
+
+	f := &rosterFixture{
+		store:    store,
+		lock:     lock,
+		users:    users,
+		sessions: sessions,
+		logs:     &logs,
+		routes:   srv.Routes(),
+	}
+	f.signInAs(t, testkit.ManagerA())
+
+	return f
+}
+
+// signInAs opens a session for user directly in the session store and uses it
+// for later requests.
+func (f *rosterFixture) signInAs(t *testing.T, user domain.UserID) {
+	t.Helper()
+
+	tok := fmt.Sprintf("token-for-user-%d", user)
+	err := f.sessions.Create(t.Context(), ports.Session{
+		TokenHash: token.Hash(tok),
+		UserID:    user,
+		ExpiresAt: testkit.TomorrowLock(),
+	})
+	require.NoError(t, err)
+
+	f.cookie = &http.Cookie{Name: "dugout_session", Value: tok}
+}
+
+func (f *rosterFixture) signOut() {
+	f.cookie = nil
+}
+
+func (f *rosterFixture) do(method, target string, form url.Values) *httptest.ResponseRecorder {
+	return f.serve(f.request(method, target, form))
+}
+
+// doHTMX sends the request the way htmx does from the roster page.
+func (f *rosterFixture) doHTMX(method, target string, form url.Values) *httptest.ResponseRecorder {
+	req := f.request(method, target, form)
+	req.Header.Set("HX-Request", "true")
+
+	return f.serve(req)
+}
+
+func (f *rosterFixture) request(method, target string, form url.Values) *http.Request {
+	req := httptest.NewRequest(method, target, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if f.cookie != nil {
+		req.AddCookie(f.cookie)
+	}
+
+	return req
+}
+
+func (f *rosterFixture) serve(req *http.Request) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	f.routes.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func (f *rosterFixture) view(t *testing.T) domain.RosterView {
+	t.Helper()
+
+	committed, _, err := f.store.Load(t.Context(), testkit.TeamA())
+	require.NoError(t, err)
+
+	return roster.NewRosterStream(testkit.TeamA(), committed).ProjectThrough(f.lock.NextLock(t.Context()))
+}
+
+func TestRosterRoutes(t *testing.T) {
+	t.Run("add, activate, inactivate, and remove a player", func(t *testing.T) {
+		f := newRosterFixture(t, nil)
+
+		rec := f.do(http.MethodPost, "/teams/111/roster/players", url.Values{"player_id": {"1"}})
+		require.Equal(t, rec.Code, http.StatusNoContent)
+		require.True(t, f.view(t).PlayerOnRoster(1))
+
+		rec = f.do(http.MethodPost, "/teams/111/roster/players/1/activate", url.Values{"role": {"hitter"}})
+		require.Equal(t, rec.Code, http.StatusNoContent)
+		assert.Equal(t, f.view(t).Counts().ActiveHitters, 1)
+
+		rec = f.do(http.MethodPost, "/teams/111/roster/players/1/inactivate", nil)
+		require.Equal(t, rec.Code, http.StatusNoContent)
+		assert.Equal(t, f.view(t).Counts().Inactive, 1)
+
+		rec = f.do(http.MethodDelete, "/teams/111/roster/players/1", nil)
+		require.Equal(t, rec.Code, http.StatusNoContent)
+		assert.False(t, f.view(t).PlayerOnRoster(1))
+	})
+
+	testCases := []struct {
+		name       string
+		store      ports.RosterStore
+		method     string
+		target     string
+		form       url.Values
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name:       "malformed team ID is a bad request",
+			method:     http.MethodPost,
+			target:     "/teams/abc/roster/players",
+			form:       url.Values{"player_id": {"1"}},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "bad_request",
+		},
+		{
+			name:       "missing player ID is a bad request",
+			method:     http.MethodPost,
+			target:     "/teams/111/roster/players",
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "bad_request",
+		},
+		{
+			name:       "unknown role is a bad request",
+			method:     http.MethodPost,
+			target:     "/teams/111/roster/players/1/activate",
+			form:       url.Values{"role": {"closer"}},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "bad_request",
+		},
+		{
+			name:       "unknown player is not found",
+			method:     http.MethodPost,
+			target:     "/teams/111/roster/players",
+			form:       url.Values{"player_id": {"99"}},
+			wantStatus: http.StatusNotFound,
+			wantCode:   "player_not_found",
+		},
+		{
+			name:       "player missing from the roster is not found",
+			method:     http.MethodDelete,
+			target:     "/teams/111/roster/players/2",
+			wantStatus: http.StatusNotFound,
+			wantCode:   "player_not_on_roster",
+		},
+		{
+			name:       "version conflict is a conflict",
+			store:      &testkit.VersionConflictRosterStore{},
+			method:     http.MethodPost,
+			target:     "/teams/111/roster/players",
+			form:       url.Values{"player_id": {"1"}},
+			wantStatus: http.StatusConflict,
+			wantCode:   "version_conflict",
+		},
+		{
+			name:       "store failure is an internal error that hides the cause",
+			store:      &testkit.FailingLoadRosterStore{},
+			method:     http.MethodPost,
+			target:     "/teams/111/roster/players",
+			form:       url.Values{"player_id": {"1"}},
+			wantStatus: http.StatusInternalServerError,
+			wantCode:   "internal_error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newRosterFixture(t, tc.store)
+
+			rec := f.do(tc.method, tc.target, tc.form)
+
+			assert.Equal(t, rec.Code, tc.wantStatus)
+			assert.Equal(t, rec.Header().Get("Content-Type"), "application/problem+json")
+
+			body := rec.Body.String()
+			var p web.Problem
+			require.NoError(t, json.Unmarshal([]byte(body), &p))
+			assert.Equal(t, p.Status, tc.wantStatus)
+			assert.Equal(t, p.Code, tc.wantCode)
+			assert.Equal(t, p.Instance, tc.target)
+			if tc.wantStatus == http.StatusInternalServerError {
+				assert.False(t, strings.Contains(body, testkit.ErrFailingLoad.Error()))
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package web_test
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/spcameron/dugout/internal/adapters/web"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+func TestRosterPage(t *testing.T) {
+	t.Run("renders the roster grouped by status with counts against the limits", func(t *testing.T) {
+		f := newRosterFixture(t, nil)
+		f.store.SeedEvents(testkit.TeamA(), []domain.RosterEvent{
+			domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 1, Eligibility: domain.MustRoleSet(domain.RoleHitter), EffectiveAt: testkit.TodayLock()},
+			domain.ActivatedPlayerOnRoster{TeamID: testkit.TeamA(), PlayerID: 1, PlayerRole: domain.RoleHitter, EffectiveAt: testkit.TodayLock()},
+			domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 2, Eligibility: domain.MustRoleSet(domain.RoleHitter), EffectiveAt: testkit.TodayLock()},
+		})
+
+		rec := f.do(http.MethodGet, "/teams/111/roster", nil)
+		require.Equal(t, rec.Code, http.StatusOK)
+
+		body := rec.Body.String()
+		assert.Contains(t, rec.Header().Get("Content-Type"), "text/html")
+		assert.Contains(t, body, "<!doctype html>")
+		assert.Contains(t, body, "htmx.org")
+		assert.Contains(t, body, "2 / 26")
+		assert.Contains(t, body, "1 / 12")
+		assert.Contains(t, body, "0 / 6")
+
+		hitters := strings.Index(body, "Active hitters</h2>")
+		inactive := strings.Index(body, "Inactive</h2>")
+		require.True(t, hitters >= 0 && inactive > hitters)
+		assert.Contains(t, body[hitters:inactive], "Player 1")
+		assert.Contains(t, body[inactive:], "Player 2")
+	})
+
+	t.Run("htmx add swaps in the updated roster panel", func(t *testing.T) {
+		f := newRosterFixture(t, nil)
+
+		rec := f.doHTMX(http.MethodPost, "/teams/111/roster/players", url.Values{"player_id": {"1"}})
+		require.Equal(t, rec.Code, http.StatusOK)
+
+		body := rec.Body.String()
+		assert.Contains(t, body, `id="roster"`)
+		assert.Contains(t, body, "Player 1")
+		assert.False(t, strings.Contains(body, "<html"))
+	})
+
+	t.Run("htmx rejection renders the panel with an inline message", func(t *testing.T) {
+		f := newRosterFixture(t, nil)
+		history := make([]domain.RosterEvent, domain.MaxRosterSize)
+		for i := range history {
+			history[i] = domain.AddedPlayerToRoster{
+				TeamID:      testkit.TeamA(),
+				PlayerID:    domain.PlayerID(i + 10),
+				Eligibility: domain.MustRoleSet(domain.RoleHitter),
+				EffectiveAt: testkit.TodayLock(),
+			}
+		}
+		f.store.SeedEvents(testkit.TeamA(), history)
+
+		rec := f.doHTMX(http.MethodPost, "/teams/111/roster/players", url.Values{"player_id": {"1"}})
+		require.Equal(t, rec.Code, http.StatusUnprocessableEntity)
+
+		body := rec.Body.String()
+		assert.Contains(t, body, `role="alert"`)
+		assert.Contains(t, body, web.ProblemFor(domain.ErrRosterFull).Title)
+		assert.Contains(t, body, "26 / 26")
+	})
+
+	t.Run("htmx infrastructure failure is not rendered as a panel", func(t *testing.T) {
+		f := newRosterFixture(t, &testkit.FailingAppendRosterStore{})
+
+		rec := f.doHTMX(http.MethodPost, "/teams/111/roster/players", url.Values{"player_id": {"1"}})
+
+		assert.Equal(t, rec.Code, http.StatusInternalServerError)
+		assert.Equal(t, rec.Header().Get("HX-Retarget"), "#alerts")
+		assert.Contains(t, rec.Body.String(), `role="alert"`)
+		assert.False(t, strings.Contains(rec.Body.String(), `id="roster"`))
+		assert.False(t, strings.Contains(rec.Body.String(), testkit.ErrFailingAppend.Error()))
+	})
+}
@@ -0,0 +1,167 @@
+package web
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/usecase/league"
+)
+
+// phaseCodes are the phases a commissioner can move a league to, by the name
+// the form sends.
+var phaseCodes = map[string]domain.LeaguePhase{
+	"drafting":  domain.PhaseDrafting,
+	"in_season": domain.PhaseInSeason,
+	"playoffs":  domain.PhasePlayoffs,
+	"complete":  domain.PhaseComplete,
+}
+
+var bracketCodes = map[string]domain.BracketKind{
+	"championship": domain.BracketChampionship,
+	"third_place":  domain.BracketThirdPlace,
+	"consolation":  domain.BracketConsolation,
+}
+
+// handleAdvancePhase moves the league to the phase named in the form. Moving to
+// the playoffs seeds the bracket from the standings.
+func (s *Server) handleAdvancePhase(w http.ResponseWriter, r *http.Request) {
+	leagueID, err := leagueIDParam(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	phase, ok := phaseCodes[r.FormValue("phase")]
+	if !ok {
+		s.writeError(w, r, fmt.Errorf("%w: phase %q", errBadRequest, r.FormValue("phase")))
+		return
+	}
+
+	err = s.League.AdvancePhase.Handle(r.Context(), league.NewAdvancePhaseCommand(leagueID, phase, actor(r)))
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleRecordResult records a regular-season matchup result, which the
+// standings are ranked from.
+func (s *Server) handleRecordResult(w http.ResponseWriter, r *http.Request) {
+	leagueID, err := leagueIDParam(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var result domain.MatchupResult
+	var home, away int
+	err = parseForm(r,
+		formInt("period", &result.Period),
+		formInt("home", &home),
+		formInt("away", &away),
+		formScore("home_score", &result.HomeScore),
+		formScore("away_score", &result.AwayScore),
+	)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	result.Home, result.Away = domain.TeamID(home), domain.TeamID(away)
+
+	err = s.League.RecordResult.Handle(r.Context(), league.NewRecordMatchupResultCommand(leagueID, result, actor(r)))
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleRecordPlayoffResult records a playoff matchup's result, advancing its
+// winner. The better seed's score is high_score.
+func (s *Server) handleRecordPlayoffResult(w http.ResponseWriter, r *http.Request) {
+	leagueID, err := leagueIDParam(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	bracket, ok := bracketCodes[r.FormValue("bracket")]
+	if !ok {
+		s.writeError(w, r, fmt.Errorf("%w: bracket %q", errBadRequest, r.FormValue("bracket")))
+		return
+	}
+
+	matchup := domain.MatchupID{Bracket: bracket}
+	var high, low float64
+	err = parseForm(r,
+		formInt("round", &matchup.Round),
+		formInt("slot", &matchup.Slot),
+		formScore("high_score", &high),
+		formScore("low_score", &low),
+	)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	err = s.League.RecordPlayoffResult.Handle(r.Context(), league.NewRecordPlayoffResultCommand(leagueID, matchup, high, low, actor(r)))
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// formField parses one form value into its destination.
+type formField func(r *http.Request) error
+
+// parseForm parses each field in turn, stopping at the first that is malformed.
+func parseForm(r *http.Request, fields ...formField) error {
+	for _, parse := range fields {
+		err := parse(r)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// formInt parses a whole number. Range checks are left to the domain.
+func formInt(name string, dst *int) formField {
+	return func(r *http.Request) error {
+		n, err := strconv.Atoi(r.FormValue(name))
+		if err != nil {
+			return fmt.Errorf("%w: %s: %w", errBadRequest, name, err)
+		}
+
+		*dst = n
+
+		return nil
+	}
+}
+
+// formScore parses a finite number of fantasy points.
+func formScore(name string, dst *float64) formField {
+	return func(r *http.Request) error {
+		score, err := strconv.ParseFloat(r.FormValue(name), 64)
+		if err != nil {
+			return fmt.Errorf("%w: %s: %w", errBadRequest, name, err)
+		}
+
+		if math.IsNaN(score) || math.IsInf(score, 0) {
+			return fmt.Errorf("%w: %s: %v is not a score", errBadRequest, name, score)
+		}
+
+		*dst = score
+
+		return nil
+	}
+}
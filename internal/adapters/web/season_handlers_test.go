@@ -0,0 +1,161 @@
+package web_test
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/spcameron/dugout/internal/adapters/web"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/projection"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/token"
+	"github.com/spcameron/dugout/internal/usecase/account"
+	"github.com/spcameron/dugout/internal/usecase/league"
+	"github.com/spcameron/dugout/internal/usecase/readmodel"
+)
+
+type seasonFixture struct {
+	leagues   *testkit.FakeLeagueStore
+	sessions  *testkit.FakeSessionStore
+	standings projection.Runner[domain.LeagueEvent]
+	routes    http.Handler
+}
+
+func newSeasonFixture(t *testing.T) *seasonFixture {
+	t.Helper()
+
+	leagues := testkit.NewLeagueStoreInPhase(domain.PhaseInSeason)
+	members := testkit.NewLeagueMemberships()
+	sessions := testkit.NewFakeSessionStore()
+	clock := testkit.NewStubClock(testkit.TodayLock())
+	standings := readmodel.NewStandings()
+
+	srv := web.NewServer(web.RosterCommands{}, web.RosterQueries{}, web.AccountHandlers{
+		Authenticate: account.NewAuthenticateHandler(sessions, clock),
+	}, web.LeagueCommands{
+		AdvancePhase:        league.NewAdvancePhaseHandler(leagues, members, standings, clock),
+		RecordResult:        league.NewRecordMatchupResultHandler(leagues, members, clock),
+		RecordPlayoffResult: league.NewRecordPlayoffResultHandler(leagues, members, clock),
+	}, stubPinger{}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	return &seasonFixture{
+		leagues:   leagues,
+		sessions:  sessions,
+		standings: testkit.NewLeagueRunner(leagues, standings.Projection()),
+		routes:    srv.Routes(),
+	}
+}
+
+// post sends the form as user, who is signed in for it.
+func (f *seasonFixture) post(t *testing.T, user domain.UserID, target string, form url.Values) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	tok := fmt.Sprintf("token-for-user-%d", user)
+	err := f.sessions.Create(t.Context(), ports.Session{
+		TokenHash: token.Hash(tok),
+		UserID:    user,
+		ExpiresAt: testkit.TomorrowLock(),
+	})
+	require.NoError(t, err)
+	req.AddCookie(&http.Cookie{Name: "dugout_session", Value: tok})
+
+	rec := httptest.NewRecorder()
+	f.routes.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestSeasonRoutes(t *testing.T) {
+	t.Run("results seed the bracket and the playoff result crowns a champion", func(t *testing.T) {
+		f := newSeasonFixture(t)
+
+		rec := f.post(t, testkit.Commissioner(), "/leagues/1/season/results", url.Values{
+			"period":     {"1"},
+			"home":       {fmt.Sprint(testkit.TeamA())},
+			"away":       {fmt.Sprint(testkit.TeamB())},
+			"home_score": {"70.5"},
+			"away_score": {"88"},
+		})
+		require.Equal(t, rec.Code, http.StatusNoContent)
+		require.NoError(t, f.standings.CatchUp(t.Context()))
+
+		rec = f.post(t, testkit.Commissioner(), "/leagues/1/season/phase", url.Values{"phase": {"playoffs"}})
+		require.Equal(t, rec.Code, http.StatusNoContent)
+
+		view, err := league.LoadLeague(t.Context(), f.leagues, testkit.LeagueA())
+		require.NoError(t, err)
+		require.Equal(t, len(view.Bracket.Seeds), 2)
+		assert.Equal(t, view.Bracket.Seeds[0], testkit.TeamB())
+
+		rec = f.post(t, testkit.Commissioner(), "/leagues/1/season/playoffs/results", url.Values{
+			"bracket":    {"championship"},
+			"round":      {"1"},
+			"slot":       {"0"},
+			"high_score": {"80"},
+			"low_score":  {"95"},
+		})
+		require.Equal(t, rec.Code, http.StatusNoContent)
+
+		view, err = league.LoadLeague(t.Context(), f.leagues, testkit.LeagueA())
+		require.NoError(t, err)
+		assert.Equal(t, view.Bracket.Champion(), testkit.TeamA())
+	})
+
+	testCases := []struct {
+		name     string
+		user     domain.UserID
+		target   string
+		form     url.Values
+		wantCode int
+	}{
+		{
+			name:     "managers cannot advance the league",
+			user:     testkit.ManagerA(),
+			target:   "/leagues/1/season/phase",
+			form:     url.Values{"phase": {"playoffs"}},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:     "unknown phase is a bad request",
+			user:     testkit.Commissioner(),
+			target:   "/leagues/1/season/phase",
+			form:     url.Values{"phase": {"spring_training"}},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "score that is not a number is a bad request",
+			user:     testkit.Commissioner(),
+			target:   "/leagues/1/season/results",
+			form:     url.Values{"period": {"1"}, "home": {"111"}, "away": {"222"}, "home_score": {"NaN"}, "away_score": {"3"}},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "playoff results wait for the playoffs",
+			user:     testkit.Commissioner(),
+			target:   "/leagues/1/season/playoffs/results",
+			form:     url.Values{"bracket": {"championship"}, "round": {"1"}, "slot": {"0"}, "high_score": {"1"}, "low_score": {"2"}},
+			wantCode: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newSeasonFixture(t)
+
+			rec := f.post(t, tc.user, tc.target, tc.form)
+
+			assert.Equal(t, rec.Code, tc.wantCode)
+		})
+	}
+}
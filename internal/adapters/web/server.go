@@ -0,0 +1,190 @@
+// Package web is the HTTP delivery adapter. It decodes requests into use case
+// commands and reports their outcome; it holds no domain rules of its own.
+package web
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+
+	"github.com/spcameron/dugout/internal/usecase/account"
+	"github.com/spcameron/dugout/internal/usecase/league"
+	"github.com/spcameron/dugout/internal/usecase/live"
+	"github.com/spcameron/dugout/internal/usecase/roster"
+)
+
+// pingTimeout bounds the database check behind the health and readiness endpoints.
+const pingTimeout = 2 * time.Second
+
+// Pinger is satisfied by *database.Queries.
+type Pinger interface {
+	Ping(ctx context.Context) (int32, error)
+}
+
+// CommandHandler is a use case handler that reports only whether the command
+// succeeded. Roster commands are taken as this interface so that the caller can
+// wrap them, as cmd/dugout does to count them.
+type CommandHandler[C any] interface {
+	Handle(ctx context.Context, cmd C) error
+}
+
+type RosterQueries struct {
+	View roster.ViewRosterHandler
+}
+
+type RosterCommands struct {
+	Add        CommandHandler[roster.AddPlayerCommand]
+	Remove     CommandHandler[roster.RemovePlayerCommand]
+	Activate   CommandHandler[roster.ActivatePlayerCommand]
+	Inactivate CommandHandler[roster.InactivatePlayerCommand]
+}
+
+type AccountHandlers struct {
+	Register     account.RegisterUserHandler
+	SignIn       account.SignInHandler
+	SignOut      account.SignOutHandler
+	Authenticate account.AuthenticateHandler
+}
+
+type LeagueCommands struct {
+	Join                league.JoinLeagueHandler
+	IssueInvite         league.IssueInviteHandler
+	RevokeInvite        league.RevokeInviteHandler
+	AdvancePhase        league.AdvancePhaseHandler
+	RecordResult        league.RecordMatchupResultHandler
+	RecordPlayoffResult league.RecordPlayoffResultHandler
+}
+
+// Server must be constructed with NewServer. It reports not ready until SetReady
+// is called, so load balancers hold traffic until startup completes and can drain
+// it again before shutdown.
+//
+// SecureCookies marks the session cookie Secure; leave it off only for local
+// development over plain HTTP. Metrics, when set, is served at /metrics. Live,
+// when set, enables the league activity pages and their event streams.
+type Server struct {
+	Roster        RosterCommands
+	Rosters       RosterQueries
+	Accounts      AccountHandlers
+	League        LeagueCommands
+	DB            Pinger
+	Logger        *slog.Logger
+	SecureCookies bool
+	Metrics       http.Handler
+	Live          *live.Feed
+
+	ready         atomic.Bool
+	streamsClosed chan struct{}
+	closeStreams  sync.Once
+}
+
+func (s *Server) SetReady(ready bool) {
+	s.ready.Store(ready)
+}
+
+func (s *Server) Routes() http.Handler {
+	r := chi.NewRouter()
+	r.Use(middleware.RequestID)
+	r.Use(s.traceRequests)
+	r.Use(middleware.Recoverer)
+
+	r.Get("/healthz", s.handleHealth)
+	r.Get("/readyz", s.handleReady)
+	if s.Metrics != nil {
+		r.Method(http.MethodGet, "/metrics", s.Metrics)
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Use(s.loadUser)
+
+		r.Get("/login", s.handleLoginPage)
+		r.Post("/login", s.handleLogin)
+		r.Get("/signup", s.handleSignupPage)
+		r.Post("/signup", s.handleSignup)
+		r.Post("/logout", s.handleLogout)
+
+		r.Group(func(r chi.Router) {
+			r.Use(s.requireUser)
+
+			r.Get("/", s.handleHome)
+			r.Get("/invites/{code}", s.handleJoinPage)
+			r.Post("/invites/{code}", s.handleJoin)
+			r.Get("/teams/{teamID}/roster", s.handleRosterPage)
+			if s.Live != nil {
+				r.Get("/leagues/{leagueID}", s.handleLeaguePage)
+				r.Get("/leagues/{leagueID}/events", s.handleLeagueEvents)
+			}
+
+			r.Route("/leagues/{leagueID}/invites", func(r chi.Router) {
+				r.Get("/new", s.handleNewInvitePage)
+				r.Post("/", s.handleIssueInvite)
+				r.Delete("/{inviteID}", s.handleRevokeInvite)
+			})
+
+			r.Route("/leagues/{leagueID}/season", func(r chi.Router) {
+				r.Post("/phase", s.handleAdvancePhase)
+				r.Post("/results", s.handleRecordResult)
+				r.Post("/playoffs/results", s.handleRecordPlayoffResult)
+			})
+
+			r.Route("/teams/{teamID}/roster/players", func(r chi.Router) {
+				r.Post("/", s.handleAddPlayer)
+				r.Delete("/{playerID}", s.handleRemovePlayer)
+				r.Post("/{playerID}/activate", s.handleActivatePlayer)
+				r.Post("/{playerID}/inactivate", s.handleInactivatePlayer)
+			})
+		})
+	})
+
+	return r
+}
+
+// handleHealth reports whether the process can reach the database.
+func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	err := s.ping(r.Context())
+	if err != nil {
+		s.Logger.Error("health check failed", "err", err)
+		http.Error(w, "database unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("ok\n"))
+}
+
+// handleReady reports whether the server should receive traffic.
+func (s *Server) handleReady(w http.ResponseWriter, r *http.Request) {
+	if !s.ready.Load() {
+		http.Error(w, "not ready", http.StatusServiceUnavailable)
+		return
+	}
+
+	s.handleHealth(w, r)
+}
+
+func (s *Server) ping(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, pingTimeout)
+	defer cancel()
+
+	_, err := s.DB.Ping(ctx)
+	return err
+}
+
+func NewServer(commands RosterCommands, queries RosterQueries, accounts AccountHandlers, leagues LeagueCommands, db Pinger, logger *slog.Logger) *Server {
+	return &Server{
+		Roster:        commands,
+		Rosters:       queries,
+		Accounts:      accounts,
+		League:        leagues,
+		DB:            db,
+		Logger:        logger,
+		SecureCookies: true,
+		streamsClosed: make(chan struct{}),
+	}
+}
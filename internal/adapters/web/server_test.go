@@ -0,0 +1,102 @@
+package web_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/spcameron/dugout/internal/adapters/web"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+)
+
+type stubPinger struct {
+	err error
+}
+
+func (p stubPinger) Ping(ctx context.Context) (int32, error) {
+	if p.err != nil {
+		return 0, p.err
+	}
+
+	return 1, nil
+}
+
+func TestHealthAndReadiness(t *testing.T) {
+	testCases := []struct {
+		name       string
+		path       string
+		ready      bool
+		pingErr    error
+		wantStatus int
+	}{
+		{
+			name:       "health is ok when the database answers",
+			path:       "/healthz",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "health is unavailable when the database does not answer",
+			path:       "/healthz",
+			pingErr:    errors.New("connection refused"),
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:       "readiness is unavailable until the server is marked ready",
+			path:       "/readyz",
+			ready:      false,
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:       "readiness is ok once ready with a reachable database",
+			path:       "/readyz",
+			ready:      true,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "readiness is unavailable when ready but the database does not answer",
+			path:       "/readyz",
+			ready:      true,
+			pingErr:    errors.New("connection refused"),
+			wantStatus: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := web.NewServer(web.RosterCommands{}, web.RosterQueries{}, web.AccountHandlers{}, web.LeagueCommands{}, stubPinger{err: tc.pingErr}, slog.New(slog.DiscardHandler))
+			srv.SetReady(tc.ready)
+
+			rec := httptest.NewRecorder()
+			srv.Routes().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.path, nil))
+
+			assert.Equal(t, rec.Code, tc.wantStatus)
+		})
+	}
+}
+
+func TestMetricsEndpoint(t *testing.T) {
+	t.Run("serves the metrics handler when one is set", func(t *testing.T) {
+		srv := web.NewServer(web.RosterCommands{}, web.RosterQueries{}, web.AccountHandlers{}, web.LeagueCommands{}, stubPinger{}, slog.New(slog.DiscardHandler))
+		srv.Metrics = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("dugout_roster_commands_total 1\n"))
+		})
+
+		rec := httptest.NewRecorder()
+		srv.Routes().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+		assert.Equal(t, rec.Code, http.StatusOK)
+		assert.Contains(t, rec.Body.String(), "dugout_roster_commands_total")
+	})
+
+	t.Run("is not routed without one", func(t *testing.T) {
+		srv := web.NewServer(web.RosterCommands{}, web.RosterQueries{}, web.AccountHandlers{}, web.LeagueCommands{}, stubPinger{}, slog.New(slog.DiscardHandler))
+
+		rec := httptest.NewRecorder()
+		srv.Routes().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+		assert.Equal(t, rec.Code, http.StatusNotFound)
+	})
+}
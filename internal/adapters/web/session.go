@@ -0,0 +1,125 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+const sessionCookie = "dugout_session"
+
+var errUnauthenticated = errors.New("sign in required")
+
+type userKey struct{}
+
+// currentUser returns the signed-in user that loadUser found for r.
+func currentUser(r *http.Request) (domain.UserID, bool) {
+	id, ok := r.Context().Value(userKey{}).(domain.UserID)
+	return id, ok
+}
+
+// loadUser resolves the session cookie, if any, to a user for the rest of the
+// request. A stale cookie is cleared rather than treated as an error.
+func (s *Server) loadUser(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie(sessionCookie)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		userID, err := s.Accounts.Authenticate.Handle(r.Context(), cookie.Value)
+		if errors.Is(err, ports.ErrSessionNotFound) {
+			s.clearSessionCookie(w)
+			next.ServeHTTP(w, r)
+			return
+		}
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), userKey{}, userID)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// requireUser sends anonymous page loads to the sign-in page and rejects
+// anything else with a 401. htmx is told to redirect the whole page.
+func (s *Server) requireUser(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := currentUser(r); ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		switch {
+		case isHTMX(r):
+			w.Header().Set("HX-Redirect", loginPath(r.Header.Get("HX-Current-URL")))
+			w.WriteHeader(http.StatusUnauthorized)
+		case r.Method == http.MethodGet:
+			http.Redirect(w, r, loginPath(r.URL.RequestURI()), http.StatusSeeOther)
+		default:
+			s.writeError(w, r, errUnauthenticated)
+		}
+	})
+}
+
+func (s *Server) setSessionCookie(w http.ResponseWriter, token string, expires time.Time) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Value:    token,
+		Path:     "/",
+		Expires:  expires,
+		HttpOnly: true,
+		Secure:   s.SecureCookies,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func (s *Server) clearSessionCookie(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   s.SecureCookies,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func loginPath(next string) string {
+	next = localPath(next)
+	if next == "/" {
+		return "/login"
+	}
+
+	return "/login?" + url.Values{"next": {next}}.Encode()
+}
+
+// localPath keeps redirects on this site. It accepts a path or a full URL, as
+// htmx reports the current page, and falls back to "/".
+func localPath(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "/"
+	}
+
+	path := u.EscapedPath()
+	if !strings.HasPrefix(path, "/") || strings.HasPrefix(path, "//") || strings.Contains(path, `\`) {
+		return "/"
+	}
+
+	if u.RawQuery != "" {
+		path += "?" + u.RawQuery
+	}
+
+	return path
+}
@@ -0,0 +1,79 @@
+package web_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/telemetry"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+func TestRequestSpans(t *testing.T) {
+	t.Run("span is named for the route and tagged with the problem reported", func(t *testing.T) {
+		spans := testkit.RecordSpans(t)
+		f := newRosterFixture(t, nil)
+		f.store.SeedEvents(testkit.TeamA(), []domain.RosterEvent{
+			domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 1, EffectiveAt: testkit.TodayLock()},
+		})
+
+		rec := f.do(http.MethodPost, "/teams/111/roster/players", url.Values{"player_id": {"1"}})
+		require.Equal(t, rec.Code, http.StatusConflict)
+
+		span := testkit.SpanNamed(t, spans, "POST /teams/{teamID}/roster/players")
+		for _, kv := range []attribute.KeyValue{
+			attribute.String("http.request.method", http.MethodPost),
+			attribute.String("http.route", "/teams/{teamID}/roster/players"),
+			attribute.Int("http.response.status_code", http.StatusConflict),
+			telemetry.ErrorClass.String("player_already_on_roster"),
+		} {
+			got, ok := testkit.SpanAttr(span, kv.Key)
+			require.True(t, ok)
+			assert.Equal(t, got, kv.Value)
+		}
+		assert.Equal(t, span.Status.Code, codes.Unset)
+
+		command := testkit.SpanNamed(t, spans, "roster.AddPlayer")
+		assert.Equal(t, command.Parent.SpanID(), span.SpanContext.SpanID())
+
+		assert.Contains(t, f.logs.String(), "msg=request")
+		assert.Contains(t, f.logs.String(), "status=409")
+	})
+
+	t.Run("server errors mark the span failed", func(t *testing.T) {
+		spans := testkit.RecordSpans(t)
+		f := newRosterFixture(t, &testkit.FailingAppendRosterStore{})
+
+		rec := f.do(http.MethodPost, "/teams/111/roster/players", url.Values{"player_id": {"1"}})
+		require.Equal(t, rec.Code, http.StatusInternalServerError)
+
+		span := testkit.SpanNamed(t, spans, "POST /teams/{teamID}/roster/players")
+		assert.Equal(t, span.Status.Code, codes.Error)
+		class, _ := testkit.SpanAttr(span, telemetry.ErrorClass)
+		assert.Equal(t, class.AsString(), "internal_error")
+	})
+
+	t.Run("continues a trace the caller propagated", func(t *testing.T) {
+		spans := testkit.RecordSpans(t)
+		previous := otel.GetTextMapPropagator()
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+		t.Cleanup(func() { otel.SetTextMapPropagator(previous) })
+
+		f := newRosterFixture(t, nil)
+		req := f.request(http.MethodGet, "/teams/111/roster", nil)
+		req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+		f.serve(req)
+
+		span := testkit.SpanNamed(t, spans, "GET /teams/{teamID}/roster")
+		assert.Equal(t, span.SpanContext.TraceID().String(), "4bf92f3577b34da6a3ce929d0e0e4736")
+		assert.Equal(t, span.Parent.SpanID().String(), "00f067aa0ba902b7")
+	})
+}
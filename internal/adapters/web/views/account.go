@@ -0,0 +1,10 @@
+package views
+
+// AccountForm is what the sign-in and sign-up pages redisplay after a rejected
+// submission. Passwords are never echoed back.
+type AccountForm struct {
+	Email       string
+	DisplayName string
+	Next        string
+	Error       string
+}
@@ -0,0 +1,35 @@
+package views
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+)
+
+// JoinForm is what the join page redisplays after a rejected submission. Code
+// is the invite code from the link, which the form posts back to.
+type JoinForm struct {
+	Code     string
+	TeamName string
+	Error    string
+}
+
+// IssuedInvite is shown to the commissioner once, since the link cannot be
+// recovered after the page is closed.
+type IssuedInvite struct {
+	LeagueID  domain.LeagueID
+	InviteID  domain.InviteID
+	Code      string
+	SingleUse bool
+	ExpiresAt time.Time
+}
+
+// InvitePath is the link an invitee follows to join a league.
+func InvitePath(code string) string {
+	return "/invites/" + code
+}
+
+func leagueInvitesPath(leagueID domain.LeagueID) string {
+	return fmt.Sprintf("/leagues/%d/invites", leagueID)
+}
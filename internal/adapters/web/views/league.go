@@ -0,0 +1,70 @@
+package views
+
+import (
+	"fmt"
+
+	"github.com/spcameron/dugout/internal/domain"
+)
+
+// Activity is one roster change or trade status change in a league's live
+// activity list. Trade is set instead of Event for a trade. PlayerName is empty
+// when the player is missing from the catalog.
+type Activity struct {
+	TeamID     domain.TeamID
+	PlayerName string
+	Event      domain.RosterEvent
+	Trade      *domain.TradeView
+}
+
+// Summary describes the change in a sentence.
+func (a Activity) Summary() string {
+	if a.Trade != nil {
+		return tradeSummary(*a.Trade)
+	}
+
+	switch e := a.Event.(type) {
+	case domain.AddedPlayerToRoster:
+		return fmt.Sprintf("Team %d added %s", a.TeamID, a.player(e.PlayerID))
+	case domain.RemovedPlayerFromRoster:
+		return fmt.Sprintf("Team %d dropped %s", a.TeamID, a.player(e.PlayerID))
+	case domain.ActivatedPlayerOnRoster:
+		return fmt.Sprintf("Team %d activated %s as a %s", a.TeamID, a.player(e.PlayerID), e.PlayerRole.Code())
+	case domain.InactivatedPlayerOnRoster:
+		return fmt.Sprintf("Team %d moved %s to inactive", a.TeamID, a.player(e.PlayerID))
+	case domain.RecordedRosterOverride:
+		return fmt.Sprintf("Commissioner changed team %d's roster: %s", a.TeamID, e.Reason)
+	default:
+		return fmt.Sprintf("Team %d's roster changed", a.TeamID)
+	}
+}
+
+func tradeSummary(t domain.TradeView) string {
+	proposer, receiver := t.Terms.Proposer, t.Terms.Receiver
+
+	switch t.Status {
+	case domain.TradeOffered:
+		return fmt.Sprintf("Team %d offered team %d a trade", proposer, receiver)
+	case domain.TradeUnderReview:
+		return fmt.Sprintf("Team %d and team %d agreed to a trade, now under review", proposer, receiver)
+	case domain.TradeVetoed:
+		return fmt.Sprintf("The trade between team %d and team %d was vetoed", proposer, receiver)
+	case domain.TradeExecuted:
+		return fmt.Sprintf("Team %d and team %d completed a trade", proposer, receiver)
+	case domain.TradeFailed:
+		return fmt.Sprintf("The trade between team %d and team %d fell through", proposer, receiver)
+	default:
+		return fmt.Sprintf("The trade between team %d and team %d changed", proposer, receiver)
+	}
+}
+
+func (a Activity) player(id domain.PlayerID) string {
+	if a.PlayerName == "" {
+		return fmt.Sprintf("Player #%d", id)
+	}
+
+	return a.PlayerName
+}
+
+func leagueEventsPath(leagueID domain.LeagueID) string {
+	return fmt.Sprintf("/leagues/%d/events", leagueID)
+}
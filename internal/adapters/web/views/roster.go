@@ -0,0 +1,76 @@
+// Package views holds the templ components for server-rendered pages.
+package views
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spcameron/dugout/internal/domain"
+)
+
+type RosterData struct {
+	TeamID  domain.TeamID
+	View    domain.RosterView
+	Players map[domain.PlayerID]domain.Player
+	Error   string
+}
+
+// RosterGroup is the entries on a roster with one RosterStatus.
+type RosterGroup struct {
+	Title   string
+	Entries []domain.RosterEntry
+}
+
+// Groups splits the roster into active hitters, active pitchers, and inactive
+// players, in that order, keeping roster order within each group.
+func (d RosterData) Groups() []RosterGroup {
+	groups := []RosterGroup{
+		{Title: "Active hitters"},
+		{Title: "Active pitchers"},
+		{Title: "Inactive"},
+	}
+
+	for _, e := range d.View.Entries {
+		switch e.RosterStatus {
+		case domain.StatusActiveHitter:
+			groups[0].Entries = append(groups[0].Entries, e)
+		case domain.StatusActivePitcher:
+			groups[1].Entries = append(groups[1].Entries, e)
+		default:
+			groups[2].Entries = append(groups[2].Entries, e)
+		}
+	}
+
+	return groups
+}
+
+// PlayerName falls back to the player ID for players missing from the catalog.
+func (d RosterData) PlayerName(id domain.PlayerID) string {
+	p, ok := d.Players[id]
+	if !ok {
+		return fmt.Sprintf("Player #%d", id)
+	}
+
+	return p.Name
+}
+
+func playersPath(teamID domain.TeamID) string {
+	return fmt.Sprintf("/teams/%d/roster/players", teamID)
+}
+
+func playerPath(teamID domain.TeamID, playerID domain.PlayerID) string {
+	return fmt.Sprintf("%s/%d", playersPath(teamID), playerID)
+}
+
+func activatePath(teamID domain.TeamID, playerID domain.PlayerID) string {
+	return playerPath(teamID, playerID) + "/activate"
+}
+
+func inactivatePath(teamID domain.TeamID, playerID domain.PlayerID) string {
+	return playerPath(teamID, playerID) + "/inactivate"
+}
+
+func roleVals(role domain.PlayerRole) string {
+	vals, _ := json.Marshal(map[string]string{"role": role.Code()})
+	return string(vals)
+}
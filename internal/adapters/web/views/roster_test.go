@@ -0,0 +1,74 @@
+package views_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/spcameron/dugout/internal/adapters/web/views"
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+func renderPanel(t *testing.T, data views.RosterData) string {
+	t.Helper()
+
+	var sb strings.Builder
+	require.NoError(t, views.RosterPanel(data).Render(context.Background(), &sb))
+
+	return sb.String()
+}
+
+func TestRosterPanel(t *testing.T) {
+	entry := func(id domain.PlayerID, status domain.RosterStatus, roles ...domain.PlayerRole) domain.RosterEntry {
+		return domain.RosterEntry{
+			TeamID:       testkit.TeamA(),
+			PlayerID:     id,
+			RosterStatus: status,
+			Eligibility:  domain.MustRoleSet(roles...),
+		}
+	}
+
+	t.Run("offers an activate button for each eligible role", func(t *testing.T) {
+		html := renderPanel(t, views.RosterData{
+			TeamID: testkit.TeamA(),
+			View: domain.RosterView{
+				TeamID:  testkit.TeamA(),
+				Entries: []domain.RosterEntry{entry(1, domain.StatusInactive, domain.RoleHitter, domain.RolePitcher)},
+			},
+			Players: map[domain.PlayerID]domain.Player{1: {ID: 1, Name: "Shohei Ohtani"}},
+		})
+
+		assert.Contains(t, html, "Activate as hitter")
+		assert.Contains(t, html, "Activate as pitcher")
+		assert.Contains(t, html, `hx-post="/teams/111/roster/players/1/activate"`)
+		assert.Contains(t, html, `hx-delete="/teams/111/roster/players/1"`)
+		assert.False(t, strings.Contains(html, "Bench"))
+	})
+
+	t.Run("offers bench but not activate for an active player", func(t *testing.T) {
+		html := renderPanel(t, views.RosterData{
+			TeamID: testkit.TeamA(),
+			View: domain.RosterView{
+				TeamID:  testkit.TeamA(),
+				Entries: []domain.RosterEntry{entry(1, domain.StatusActivePitcher, domain.RolePitcher)},
+			},
+		})
+
+		assert.Contains(t, html, "Bench")
+		assert.Contains(t, html, "Player #1")
+		assert.False(t, strings.Contains(html, "Activate as"))
+	})
+
+	t.Run("escapes the inline error message", func(t *testing.T) {
+		html := renderPanel(t, views.RosterData{
+			TeamID: testkit.TeamA(),
+			Error:  "<script>alert(1)</script>",
+		})
+
+		assert.Contains(t, html, "&lt;script&gt;")
+		assert.False(t, strings.Contains(html, "<script>alert(1)</script>"))
+	})
+}
@@ -0,0 +1,300 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: current_rosters.sql
+
+package database
+
+import (
+	"context"
+)
+
+const countLeagueRosters = `-- name: CountLeagueRosters :many
+SELECT
+    team_id,
+    count(*)::int AS total,
+    (count(*) FILTER (WHERE roster_status = 'active_hitter'))::int AS active_hitters,
+    (count(*) FILTER (WHERE roster_status = 'active_pitcher'))::int AS active_pitchers,
+    (count(*) FILTER (WHERE roster_status = 'inactive'))::int AS inactive
+FROM
+    current_roster_entries
+WHERE
+    team_id IN (
+        SELECT
+            m.team_id
+        FROM
+            memberships m
+        WHERE
+            m.league_id = $1)
+GROUP BY
+    team_id
+ORDER BY
+    team_id
+`
+
+type CountLeagueRostersRow struct {
+	TeamID         int64 `json:"team_id"`
+	Total          int32 `json:"total"`
+	ActiveHitters  int32 `json:"active_hitters"`
+	ActivePitchers int32 `json:"active_pitchers"`
+	Inactive       int32 `json:"inactive"`
+}
+
+func (q *Queries) CountLeagueRosters(ctx context.Context, leagueID int64) ([]CountLeagueRostersRow, error) {
+	rows, err := q.db.Query(ctx, countLeagueRosters, leagueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountLeagueRostersRow
+	for rows.Next() {
+		var i CountLeagueRostersRow
+		if err := rows.Scan(
+			&i.TeamID,
+			&i.Total,
+			&i.ActiveHitters,
+			&i.ActivePitchers,
+			&i.Inactive,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteAllCurrentRosterEntries = `-- name: DeleteAllCurrentRosterEntries :exec
+DELETE FROM current_roster_entries
+`
+
+func (q *Queries) DeleteAllCurrentRosterEntries(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, deleteAllCurrentRosterEntries)
+	return err
+}
+
+const deleteCurrentRosterEntry = `-- name: DeleteCurrentRosterEntry :exec
+DELETE FROM current_roster_entries
+WHERE team_id = $1
+    AND player_id = $2
+`
+
+type DeleteCurrentRosterEntryParams struct {
+	TeamID   int64 `json:"team_id"`
+	PlayerID int64 `json:"player_id"`
+}
+
+func (q *Queries) DeleteCurrentRosterEntry(ctx context.Context, arg DeleteCurrentRosterEntryParams) error {
+	_, err := q.db.Exec(ctx, deleteCurrentRosterEntry, arg.TeamID, arg.PlayerID)
+	return err
+}
+
+const getPlayerOwner = `-- name: GetPlayerOwner :one
+SELECT
+    team_id
+FROM
+    current_roster_entries
+WHERE
+    player_id = $1
+    AND team_id IN (
+        SELECT
+            m.team_id
+        FROM
+            memberships m
+        WHERE
+            m.league_id = $2)
+LIMIT 1
+`
+
+type GetPlayerOwnerParams struct {
+	PlayerID int64 `json:"player_id"`
+	LeagueID int64 `json:"league_id"`
+}
+
+func (q *Queries) GetPlayerOwner(ctx context.Context, arg GetPlayerOwnerParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getPlayerOwner, arg.PlayerID, arg.LeagueID)
+	var team_id int64
+	err := row.Scan(&team_id)
+	return team_id, err
+}
+
+const listCurrentRosterTeams = `-- name: ListCurrentRosterTeams :many
+SELECT DISTINCT
+    team_id
+FROM
+    current_roster_entries
+ORDER BY
+    team_id
+`
+
+func (q *Queries) ListCurrentRosterTeams(ctx context.Context) ([]int64, error) {
+	rows, err := q.db.Query(ctx, listCurrentRosterTeams)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var team_id int64
+		if err := rows.Scan(&team_id); err != nil {
+			return nil, err
+		}
+		items = append(items, team_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLeagueRosters = `-- name: ListLeagueRosters :many
+SELECT
+    team_id,
+    player_id,
+    roster_status,
+    eligibility
+FROM
+    current_roster_entries
+WHERE
+    team_id IN (
+        SELECT
+            m.team_id
+        FROM
+            memberships m
+        WHERE
+            m.league_id = $1)
+ORDER BY
+    team_id,
+    added_position
+`
+
+type ListLeagueRostersRow struct {
+	TeamID       int64    `json:"team_id"`
+	PlayerID     int64    `json:"player_id"`
+	RosterStatus string   `json:"roster_status"`
+	Eligibility  []string `json:"eligibility"`
+}
+
+func (q *Queries) ListLeagueRosters(ctx context.Context, leagueID int64) ([]ListLeagueRostersRow, error) {
+	rows, err := q.db.Query(ctx, listLeagueRosters, leagueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListLeagueRostersRow
+	for rows.Next() {
+		var i ListLeagueRostersRow
+		if err := rows.Scan(
+			&i.TeamID,
+			&i.PlayerID,
+			&i.RosterStatus,
+			&i.Eligibility,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamRoster = `-- name: ListTeamRoster :many
+SELECT
+    team_id,
+    player_id,
+    roster_status,
+    eligibility
+FROM
+    current_roster_entries
+WHERE
+    team_id = $1
+ORDER BY
+    added_position
+`
+
+type ListTeamRosterRow struct {
+	TeamID       int64    `json:"team_id"`
+	PlayerID     int64    `json:"player_id"`
+	RosterStatus string   `json:"roster_status"`
+	Eligibility  []string `json:"eligibility"`
+}
+
+func (q *Queries) ListTeamRoster(ctx context.Context, teamID int64) ([]ListTeamRosterRow, error) {
+	rows, err := q.db.Query(ctx, listTeamRoster, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTeamRosterRow
+	for rows.Next() {
+		var i ListTeamRosterRow
+		if err := rows.Scan(
+			&i.TeamID,
+			&i.PlayerID,
+			&i.RosterStatus,
+			&i.Eligibility,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setCurrentRosterStatus = `-- name: SetCurrentRosterStatus :execrows
+UPDATE
+    current_roster_entries
+SET
+    roster_status = $1
+WHERE
+    team_id = $2
+    AND player_id = $3
+`
+
+type SetCurrentRosterStatusParams struct {
+	RosterStatus string `json:"roster_status"`
+	TeamID       int64  `json:"team_id"`
+	PlayerID     int64  `json:"player_id"`
+}
+
+func (q *Queries) SetCurrentRosterStatus(ctx context.Context, arg SetCurrentRosterStatusParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setCurrentRosterStatus, arg.RosterStatus, arg.TeamID, arg.PlayerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const upsertCurrentRosterEntry = `-- name: UpsertCurrentRosterEntry :exec
+INSERT INTO current_roster_entries (team_id, player_id, roster_status, eligibility, added_position)
+    VALUES ($1, $2, 'inactive', $3, $4)
+ON CONFLICT (team_id, player_id)
+    DO UPDATE SET
+        roster_status = 'inactive',
+        eligibility = excluded.eligibility,
+        added_position = excluded.added_position
+`
+
+type UpsertCurrentRosterEntryParams struct {
+	TeamID        int64    `json:"team_id"`
+	PlayerID      int64    `json:"player_id"`
+	Eligibility   []string `json:"eligibility"`
+	AddedPosition int64    `json:"added_position"`
+}
+
+func (q *Queries) UpsertCurrentRosterEntry(ctx context.Context, arg UpsertCurrentRosterEntryParams) error {
+	_, err := q.db.Exec(ctx, upsertCurrentRosterEntry,
+		arg.TeamID,
+		arg.PlayerID,
+		arg.Eligibility,
+		arg.AddedPosition,
+	)
+	return err
+}
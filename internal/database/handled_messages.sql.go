@@ -0,0 +1,54 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: handled_messages.sql
+
+package database
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const markMessageHandled = `-- name: MarkMessageHandled :exec
+INSERT INTO handled_messages (subscriber, key, handled_at)
+    VALUES ($1, $2, $3)
+ON CONFLICT (subscriber, key)
+    DO NOTHING
+`
+
+type MarkMessageHandledParams struct {
+	Subscriber string             `json:"subscriber"`
+	Key        string             `json:"key"`
+	HandledAt  pgtype.Timestamptz `json:"handled_at"`
+}
+
+func (q *Queries) MarkMessageHandled(ctx context.Context, arg MarkMessageHandledParams) error {
+	_, err := q.db.Exec(ctx, markMessageHandled, arg.Subscriber, arg.Key, arg.HandledAt)
+	return err
+}
+
+const messageHandled = `-- name: MessageHandled :one
+SELECT
+    EXISTS (
+        SELECT
+            1
+        FROM
+            handled_messages
+        WHERE
+            subscriber = $1
+            AND key = $2)
+`
+
+type MessageHandledParams struct {
+	Subscriber string `json:"subscriber"`
+	Key        string `json:"key"`
+}
+
+func (q *Queries) MessageHandled(ctx context.Context, arg MessageHandledParams) (bool, error) {
+	row := q.db.QueryRow(ctx, messageHandled, arg.Subscriber, arg.Key)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
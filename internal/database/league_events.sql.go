@@ -0,0 +1,214 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: league_events.sql
+
+package database
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const ensureLeagueStream = `-- name: EnsureLeagueStream :exec
+INSERT INTO league_streams (league_id)
+    VALUES ($1)
+ON CONFLICT (league_id)
+    DO NOTHING
+`
+
+func (q *Queries) EnsureLeagueStream(ctx context.Context, leagueID int64) error {
+	_, err := q.db.Exec(ctx, ensureLeagueStream, leagueID)
+	return err
+}
+
+const insertLeagueEvent = `-- name: InsertLeagueEvent :exec
+INSERT INTO league_events (league_id, sequence, event_type, payload, occurred_at)
+    VALUES ($1, $2, $3, $4, $5)
+`
+
+type InsertLeagueEventParams struct {
+	LeagueID   int64              `json:"league_id"`
+	Sequence   int64              `json:"sequence"`
+	EventType  string             `json:"event_type"`
+	Payload    []byte             `json:"payload"`
+	OccurredAt pgtype.Timestamptz `json:"occurred_at"`
+}
+
+func (q *Queries) InsertLeagueEvent(ctx context.Context, arg InsertLeagueEventParams) error {
+	_, err := q.db.Exec(ctx, insertLeagueEvent,
+		arg.LeagueID,
+		arg.Sequence,
+		arg.EventType,
+		arg.Payload,
+		arg.OccurredAt,
+	)
+	return err
+}
+
+const listLeagueEvents = `-- name: ListLeagueEvents :many
+SELECT
+    sequence,
+    event_type,
+    payload
+FROM
+    league_events
+WHERE
+    league_id = $1
+ORDER BY
+    sequence
+`
+
+type ListLeagueEventsRow struct {
+	Sequence  int64  `json:"sequence"`
+	EventType string `json:"event_type"`
+	Payload   []byte `json:"payload"`
+}
+
+func (q *Queries) ListLeagueEvents(ctx context.Context, leagueID int64) ([]ListLeagueEventsRow, error) {
+	rows, err := q.db.Query(ctx, listLeagueEvents, leagueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListLeagueEventsRow
+	for rows.Next() {
+		var i ListLeagueEventsRow
+		if err := rows.Scan(&i.Sequence, &i.EventType, &i.Payload); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLeagueEventsAfter = `-- name: ListLeagueEventsAfter :many
+SELECT
+    position,
+    league_id,
+    sequence,
+    event_type,
+    payload
+FROM
+    league_events
+WHERE
+    position > $1
+ORDER BY
+    position
+LIMIT $2
+`
+
+type ListLeagueEventsAfterParams struct {
+	After     int64 `json:"after"`
+	MaxEvents int32 `json:"max_events"`
+}
+
+type ListLeagueEventsAfterRow struct {
+	Position  int64  `json:"position"`
+	LeagueID  int64  `json:"league_id"`
+	Sequence  int64  `json:"sequence"`
+	EventType string `json:"event_type"`
+	Payload   []byte `json:"payload"`
+}
+
+func (q *Queries) ListLeagueEventsAfter(ctx context.Context, arg ListLeagueEventsAfterParams) ([]ListLeagueEventsAfterRow, error) {
+	rows, err := q.db.Query(ctx, listLeagueEventsAfter, arg.After, arg.MaxEvents)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListLeagueEventsAfterRow
+	for rows.Next() {
+		var i ListLeagueEventsAfterRow
+		if err := rows.Scan(
+			&i.Position,
+			&i.LeagueID,
+			&i.Sequence,
+			&i.EventType,
+			&i.Payload,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockLeagueLog = `-- name: LockLeagueLog :exec
+SELECT
+    pg_advisory_xact_lock(hashtext('league_events'))
+`
+
+// Taken before any stream is touched and held until commit, so appends commit
+// one at a time and positions are handed out in commit order.
+func (q *Queries) LockLeagueLog(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, lockLeagueLog)
+	return err
+}
+
+const lockLeagueStream = `-- name: LockLeagueStream :one
+SELECT
+    version
+FROM
+    league_streams
+WHERE
+    league_id = $1
+FOR UPDATE
+`
+
+func (q *Queries) LockLeagueStream(ctx context.Context, leagueID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, lockLeagueStream, leagueID)
+	var version int64
+	err := row.Scan(&version)
+	return version, err
+}
+
+const nextLeagueID = `-- name: NextLeagueID :one
+SELECT
+    nextval('league_id_seq')::bigint
+`
+
+func (q *Queries) NextLeagueID(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, nextLeagueID)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const nextTeamID = `-- name: NextTeamID :one
+SELECT
+    nextval('team_id_seq')::bigint
+`
+
+func (q *Queries) NextTeamID(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, nextTeamID)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const setLeagueStreamVersion = `-- name: SetLeagueStreamVersion :exec
+UPDATE
+    league_streams
+SET
+    version = $1
+WHERE
+    league_id = $2
+`
+
+type SetLeagueStreamVersionParams struct {
+	Version  int64 `json:"version"`
+	LeagueID int64 `json:"league_id"`
+}
+
+func (q *Queries) SetLeagueStreamVersion(ctx context.Context, arg SetLeagueStreamVersionParams) error {
+	_, err := q.db.Exec(ctx, setLeagueStreamVersion, arg.Version, arg.LeagueID)
+	return err
+}
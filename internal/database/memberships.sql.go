@@ -0,0 +1,133 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: memberships.sql
+
+package database
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getTeamLeague = `-- name: GetTeamLeague :one
+SELECT
+    league_id
+FROM
+    memberships
+WHERE
+    team_id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetTeamLeague(ctx context.Context, teamID pgtype.Int8) (int64, error) {
+	row := q.db.QueryRow(ctx, getTeamLeague, teamID)
+	var league_id int64
+	err := row.Scan(&league_id)
+	return league_id, err
+}
+
+const grantMembership = `-- name: GrantMembership :exec
+INSERT INTO memberships (user_id, league_id, team_id, role)
+    VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id, league_id, coalesce(team_id, 0), role)
+    DO NOTHING
+`
+
+type GrantMembershipParams struct {
+	UserID   int64       `json:"user_id"`
+	LeagueID int64       `json:"league_id"`
+	TeamID   pgtype.Int8 `json:"team_id"`
+	Role     string      `json:"role"`
+}
+
+func (q *Queries) GrantMembership(ctx context.Context, arg GrantMembershipParams) error {
+	_, err := q.db.Exec(ctx, grantMembership,
+		arg.UserID,
+		arg.LeagueID,
+		arg.TeamID,
+		arg.Role,
+	)
+	return err
+}
+
+const listMembershipsForUser = `-- name: ListMembershipsForUser :many
+SELECT
+    user_id,
+    league_id,
+    team_id,
+    role
+FROM
+    memberships
+WHERE
+    user_id = $1
+ORDER BY
+    league_id,
+    team_id NULLS FIRST,
+    role
+`
+
+type ListMembershipsForUserRow struct {
+	UserID   int64       `json:"user_id"`
+	LeagueID int64       `json:"league_id"`
+	TeamID   pgtype.Int8 `json:"team_id"`
+	Role     string      `json:"role"`
+}
+
+func (q *Queries) ListMembershipsForUser(ctx context.Context, userID int64) ([]ListMembershipsForUserRow, error) {
+	rows, err := q.db.Query(ctx, listMembershipsForUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMembershipsForUserRow
+	for rows.Next() {
+		var i ListMembershipsForUserRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.LeagueID,
+			&i.TeamID,
+			&i.Role,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamManagers = `-- name: ListTeamManagers :many
+SELECT
+    user_id
+FROM
+    memberships
+WHERE
+    team_id = $1
+    AND role IN ('manager', 'co_manager')
+ORDER BY
+    user_id
+`
+
+func (q *Queries) ListTeamManagers(ctx context.Context, teamID pgtype.Int8) ([]int64, error) {
+	rows, err := q.db.Query(ctx, listTeamManagers, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var user_id int64
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -4,6 +4,159 @@
 
 package database
 
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type CurrentRosterEntry struct {
+	TeamID        int64    `json:"team_id"`
+	PlayerID      int64    `json:"player_id"`
+	RosterStatus  string   `json:"roster_status"`
+	Eligibility   []string `json:"eligibility"`
+	AddedPosition int64    `json:"added_position"`
+}
+
+type HandledMessage struct {
+	Subscriber string             `json:"subscriber"`
+	Key        string             `json:"key"`
+	HandledAt  pgtype.Timestamptz `json:"handled_at"`
+}
+
+type LeagueEvent struct {
+	LeagueID   int64              `json:"league_id"`
+	Sequence   int64              `json:"sequence"`
+	EventType  string             `json:"event_type"`
+	Payload    []byte             `json:"payload"`
+	OccurredAt pgtype.Timestamptz `json:"occurred_at"`
+	RecordedAt pgtype.Timestamptz `json:"recorded_at"`
+	Position   int64              `json:"position"`
+}
+
+type LeagueStream struct {
+	LeagueID int64 `json:"league_id"`
+	Version  int64 `json:"version"`
+}
+
+type Membership struct {
+	UserID    int64              `json:"user_id"`
+	LeagueID  int64              `json:"league_id"`
+	TeamID    pgtype.Int8        `json:"team_id"`
+	Role      string             `json:"role"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type Notification struct {
+	UserID    int64              `json:"user_id"`
+	Key       string             `json:"key"`
+	Kind      string             `json:"kind"`
+	Subject   string             `json:"subject"`
+	Body      string             `json:"body"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	ReadAt    pgtype.Timestamptz `json:"read_at"`
+}
+
+type NotificationPreference struct {
+	UserID    int64              `json:"user_id"`
+	Channel   string             `json:"channel"`
+	Enabled   bool               `json:"enabled"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+type Player struct {
+	ID        int64              `json:"id"`
+	MlbID     int64              `json:"mlb_id"`
+	Name      string             `json:"name"`
+	Position  string             `json:"position"`
+	MlbTeam   string             `json:"mlb_team"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	Roles     []string           `json:"roles"`
+}
+
+type ProjectionCheckpoint struct {
+	Projection string             `json:"projection"`
+	Position   int64              `json:"position"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
+}
+
+type RosterEvent struct {
+	TeamID      int64              `json:"team_id"`
+	Sequence    int64              `json:"sequence"`
+	EventType   string             `json:"event_type"`
+	Payload     []byte             `json:"payload"`
+	EffectiveAt pgtype.Timestamptz `json:"effective_at"`
+	RecordedAt  pgtype.Timestamptz `json:"recorded_at"`
+	Position    int64              `json:"position"`
+}
+
+type RosterOutbox struct {
+	ID        int64              `json:"id"`
+	TeamID    int64              `json:"team_id"`
+	Sequence  int64              `json:"sequence"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type RosterOutboxDelivery struct {
+	OutboxID       int64              `json:"outbox_id"`
+	Subscriber     string             `json:"subscriber"`
+	Attempts       int32              `json:"attempts"`
+	NextAttemptAt  pgtype.Timestamptz `json:"next_attempt_at"`
+	DeliveredAt    pgtype.Timestamptz `json:"delivered_at"`
+	DeadLetteredAt pgtype.Timestamptz `json:"dead_lettered_at"`
+	LastError      pgtype.Text        `json:"last_error"`
+}
+
+type RosterStream struct {
+	TeamID  int64 `json:"team_id"`
+	Version int64 `json:"version"`
+}
+
 type SchemaMigrationsGuard struct {
 	ID int32 `json:"id"`
 }
+
+type Session struct {
+	TokenHash []byte             `json:"token_hash"`
+	UserID    int64              `json:"user_id"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type TradeEvent struct {
+	TradeID    int64              `json:"trade_id"`
+	Sequence   int64              `json:"sequence"`
+	EventType  string             `json:"event_type"`
+	Payload    []byte             `json:"payload"`
+	OccurredAt pgtype.Timestamptz `json:"occurred_at"`
+	RecordedAt pgtype.Timestamptz `json:"recorded_at"`
+	Position   int64              `json:"position"`
+}
+
+type TradeOutbox struct {
+	ID        int64              `json:"id"`
+	TradeID   int64              `json:"trade_id"`
+	Sequence  int64              `json:"sequence"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type TradeOutboxDelivery struct {
+	OutboxID       int64              `json:"outbox_id"`
+	Subscriber     string             `json:"subscriber"`
+	Attempts       int32              `json:"attempts"`
+	NextAttemptAt  pgtype.Timestamptz `json:"next_attempt_at"`
+	DeliveredAt    pgtype.Timestamptz `json:"delivered_at"`
+	DeadLetteredAt pgtype.Timestamptz `json:"dead_lettered_at"`
+	LastError      pgtype.Text        `json:"last_error"`
+}
+
+type TradeStream struct {
+	TradeID int64 `json:"trade_id"`
+	Version int64 `json:"version"`
+}
+
+type User struct {
+	ID           int64              `json:"id"`
+	Email        string             `json:"email"`
+	DisplayName  string             `json:"display_name"`
+	PasswordHash []byte             `json:"password_hash"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+}
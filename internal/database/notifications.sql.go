@@ -0,0 +1,155 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: notifications.sql
+
+package database
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getNotificationPreference = `-- name: GetNotificationPreference :one
+SELECT
+    enabled
+FROM
+    notification_preferences
+WHERE
+    user_id = $1
+    AND channel = $2
+`
+
+type GetNotificationPreferenceParams struct {
+	UserID  int64  `json:"user_id"`
+	Channel string `json:"channel"`
+}
+
+func (q *Queries) GetNotificationPreference(ctx context.Context, arg GetNotificationPreferenceParams) (bool, error) {
+	row := q.db.QueryRow(ctx, getNotificationPreference, arg.UserID, arg.Channel)
+	var enabled bool
+	err := row.Scan(&enabled)
+	return enabled, err
+}
+
+const insertNotification = `-- name: InsertNotification :exec
+INSERT INTO notifications (user_id, key, kind, subject, body, created_at)
+    VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (user_id, key)
+    DO NOTHING
+`
+
+type InsertNotificationParams struct {
+	UserID    int64              `json:"user_id"`
+	Key       string             `json:"key"`
+	Kind      string             `json:"kind"`
+	Subject   string             `json:"subject"`
+	Body      string             `json:"body"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) InsertNotification(ctx context.Context, arg InsertNotificationParams) error {
+	_, err := q.db.Exec(ctx, insertNotification,
+		arg.UserID,
+		arg.Key,
+		arg.Kind,
+		arg.Subject,
+		arg.Body,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const listNotifications = `-- name: ListNotifications :many
+SELECT
+    user_id,
+    key,
+    kind,
+    subject,
+    body,
+    created_at,
+    read_at
+FROM
+    notifications
+WHERE
+    user_id = $1
+ORDER BY
+    created_at DESC,
+    key DESC
+LIMIT $2
+`
+
+type ListNotificationsParams struct {
+	UserID           int64 `json:"user_id"`
+	MaxNotifications int32 `json:"max_notifications"`
+}
+
+func (q *Queries) ListNotifications(ctx context.Context, arg ListNotificationsParams) ([]Notification, error) {
+	rows, err := q.db.Query(ctx, listNotifications, arg.UserID, arg.MaxNotifications)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Notification
+	for rows.Next() {
+		var i Notification
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Key,
+			&i.Kind,
+			&i.Subject,
+			&i.Body,
+			&i.CreatedAt,
+			&i.ReadAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markNotificationRead = `-- name: MarkNotificationRead :exec
+UPDATE
+    notifications
+SET
+    read_at = coalesce(read_at, $1)
+WHERE
+    user_id = $2
+    AND key = $3
+`
+
+type MarkNotificationReadParams struct {
+	ReadAt pgtype.Timestamptz `json:"read_at"`
+	UserID int64              `json:"user_id"`
+	Key    string             `json:"key"`
+}
+
+func (q *Queries) MarkNotificationRead(ctx context.Context, arg MarkNotificationReadParams) error {
+	_, err := q.db.Exec(ctx, markNotificationRead, arg.ReadAt, arg.UserID, arg.Key)
+	return err
+}
+
+const setNotificationPreference = `-- name: SetNotificationPreference :exec
+INSERT INTO notification_preferences (user_id, channel, enabled)
+    VALUES ($1, $2, $3)
+ON CONFLICT (user_id, channel)
+    DO UPDATE SET
+        enabled = excluded.enabled,
+        updated_at = now()
+`
+
+type SetNotificationPreferenceParams struct {
+	UserID  int64  `json:"user_id"`
+	Channel string `json:"channel"`
+	Enabled bool   `json:"enabled"`
+}
+
+func (q *Queries) SetNotificationPreference(ctx context.Context, arg SetNotificationPreferenceParams) error {
+	_, err := q.db.Exec(ctx, setNotificationPreference, arg.UserID, arg.Channel, arg.Enabled)
+	return err
+}
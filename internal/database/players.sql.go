@@ -0,0 +1,169 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: players.sql
+
+package database
+
+import (
+	"context"
+)
+
+const getPlayer = `-- name: GetPlayer :one
+SELECT
+    id,
+    mlb_id,
+    name,
+    roles,
+    position,
+    mlb_team
+FROM
+    players
+WHERE
+    id = $1
+`
+
+type GetPlayerRow struct {
+	ID       int64    `json:"id"`
+	MlbID    int64    `json:"mlb_id"`
+	Name     string   `json:"name"`
+	Roles    []string `json:"roles"`
+	Position string   `json:"position"`
+	MlbTeam  string   `json:"mlb_team"`
+}
+
+func (q *Queries) GetPlayer(ctx context.Context, id int64) (GetPlayerRow, error) {
+	row := q.db.QueryRow(ctx, getPlayer, id)
+	var i GetPlayerRow
+	err := row.Scan(
+		&i.ID,
+		&i.MlbID,
+		&i.Name,
+		&i.Roles,
+		&i.Position,
+		&i.MlbTeam,
+	)
+	return i, err
+}
+
+const getPlayerIDByMLBID = `-- name: GetPlayerIDByMLBID :one
+SELECT
+    id
+FROM
+    players
+WHERE
+    mlb_id = $1
+`
+
+func (q *Queries) GetPlayerIDByMLBID(ctx context.Context, mlbID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, getPlayerIDByMLBID, mlbID)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const searchPlayers = `-- name: SearchPlayers :many
+SELECT
+    id,
+    mlb_id,
+    name,
+    roles,
+    position,
+    mlb_team
+FROM
+    players
+WHERE
+    lower(name) LIKE $1::text || '%'
+    AND ($2::text = ''
+        OR position = $2::text)
+    AND ($3::text = ''
+        OR mlb_team = $3::text)
+ORDER BY
+    name,
+    id
+LIMIT $4
+`
+
+type SearchPlayersParams struct {
+	NamePrefix string `json:"name_prefix"`
+	Position   string `json:"position"`
+	MlbTeam    string `json:"mlb_team"`
+	MaxResults int32  `json:"max_results"`
+}
+
+type SearchPlayersRow struct {
+	ID       int64    `json:"id"`
+	MlbID    int64    `json:"mlb_id"`
+	Name     string   `json:"name"`
+	Roles    []string `json:"roles"`
+	Position string   `json:"position"`
+	MlbTeam  string   `json:"mlb_team"`
+}
+
+func (q *Queries) SearchPlayers(ctx context.Context, arg SearchPlayersParams) ([]SearchPlayersRow, error) {
+	rows, err := q.db.Query(ctx, searchPlayers,
+		arg.NamePrefix,
+		arg.Position,
+		arg.MlbTeam,
+		arg.MaxResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchPlayersRow
+	for rows.Next() {
+		var i SearchPlayersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.MlbID,
+			&i.Name,
+			&i.Roles,
+			&i.Position,
+			&i.MlbTeam,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertPlayer = `-- name: UpsertPlayer :one
+INSERT INTO players (mlb_id, name, roles, position, mlb_team)
+    VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (mlb_id)
+    DO UPDATE SET
+        name = EXCLUDED.name,
+        roles = EXCLUDED.roles,
+        position = EXCLUDED.position,
+        mlb_team = EXCLUDED.mlb_team,
+        updated_at = now()
+    WHERE (players.name, players.roles, players.position, players.mlb_team) IS DISTINCT FROM (EXCLUDED.name, EXCLUDED.roles, EXCLUDED.position, EXCLUDED.mlb_team)
+    RETURNING
+        id
+`
+
+type UpsertPlayerParams struct {
+	MlbID    int64    `json:"mlb_id"`
+	Name     string   `json:"name"`
+	Roles    []string `json:"roles"`
+	Position string   `json:"position"`
+	MlbTeam  string   `json:"mlb_team"`
+}
+
+func (q *Queries) UpsertPlayer(ctx context.Context, arg UpsertPlayerParams) (int64, error) {
+	row := q.db.QueryRow(ctx, upsertPlayer,
+		arg.MlbID,
+		arg.Name,
+		arg.Roles,
+		arg.Position,
+		arg.MlbTeam,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
@@ -0,0 +1,45 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: projection_checkpoints.sql
+
+package database
+
+import (
+	"context"
+)
+
+const getProjectionCheckpoint = `-- name: GetProjectionCheckpoint :one
+SELECT
+    position
+FROM
+    projection_checkpoints
+WHERE
+    projection = $1
+`
+
+func (q *Queries) GetProjectionCheckpoint(ctx context.Context, projection string) (int64, error) {
+	row := q.db.QueryRow(ctx, getProjectionCheckpoint, projection)
+	var position int64
+	err := row.Scan(&position)
+	return position, err
+}
+
+const saveProjectionCheckpoint = `-- name: SaveProjectionCheckpoint :exec
+INSERT INTO projection_checkpoints (projection, position)
+    VALUES ($1, $2)
+ON CONFLICT (projection)
+    DO UPDATE SET
+        position = excluded.position,
+        updated_at = now()
+`
+
+type SaveProjectionCheckpointParams struct {
+	Projection string `json:"projection"`
+	Position   int64  `json:"position"`
+}
+
+func (q *Queries) SaveProjectionCheckpoint(ctx context.Context, arg SaveProjectionCheckpointParams) error {
+	_, err := q.db.Exec(ctx, saveProjectionCheckpoint, arg.Projection, arg.Position)
+	return err
+}
@@ -0,0 +1,235 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: roster_events.sql
+
+package database
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const ensureRosterStream = `-- name: EnsureRosterStream :exec
+INSERT INTO roster_streams (team_id)
+    VALUES ($1)
+ON CONFLICT (team_id)
+    DO NOTHING
+`
+
+func (q *Queries) EnsureRosterStream(ctx context.Context, teamID int64) error {
+	_, err := q.db.Exec(ctx, ensureRosterStream, teamID)
+	return err
+}
+
+const getLastRosterEventPosition = `-- name: GetLastRosterEventPosition :one
+SELECT
+    coalesce(max(position), 0)::bigint
+FROM
+    roster_events
+`
+
+func (q *Queries) GetLastRosterEventPosition(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, getLastRosterEventPosition)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const insertRosterEvent = `-- name: InsertRosterEvent :exec
+INSERT INTO roster_events (team_id, sequence, event_type, payload, effective_at)
+    VALUES ($1, $2, $3, $4, $5)
+`
+
+type InsertRosterEventParams struct {
+	TeamID      int64              `json:"team_id"`
+	Sequence    int64              `json:"sequence"`
+	EventType   string             `json:"event_type"`
+	Payload     []byte             `json:"payload"`
+	EffectiveAt pgtype.Timestamptz `json:"effective_at"`
+}
+
+func (q *Queries) InsertRosterEvent(ctx context.Context, arg InsertRosterEventParams) error {
+	_, err := q.db.Exec(ctx, insertRosterEvent,
+		arg.TeamID,
+		arg.Sequence,
+		arg.EventType,
+		arg.Payload,
+		arg.EffectiveAt,
+	)
+	return err
+}
+
+const listRosterEvents = `-- name: ListRosterEvents :many
+SELECT
+    sequence,
+    event_type,
+    payload
+FROM
+    roster_events
+WHERE
+    team_id = $1
+ORDER BY
+    sequence
+`
+
+type ListRosterEventsRow struct {
+	Sequence  int64  `json:"sequence"`
+	EventType string `json:"event_type"`
+	Payload   []byte `json:"payload"`
+}
+
+func (q *Queries) ListRosterEvents(ctx context.Context, teamID int64) ([]ListRosterEventsRow, error) {
+	rows, err := q.db.Query(ctx, listRosterEvents, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRosterEventsRow
+	for rows.Next() {
+		var i ListRosterEventsRow
+		if err := rows.Scan(&i.Sequence, &i.EventType, &i.Payload); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRosterEventsAfter = `-- name: ListRosterEventsAfter :many
+SELECT
+    position,
+    team_id,
+    sequence,
+    event_type,
+    payload
+FROM
+    roster_events
+WHERE
+    position > $1
+ORDER BY
+    position
+LIMIT $2
+`
+
+type ListRosterEventsAfterParams struct {
+	After     int64 `json:"after"`
+	MaxEvents int32 `json:"max_events"`
+}
+
+type ListRosterEventsAfterRow struct {
+	Position  int64  `json:"position"`
+	TeamID    int64  `json:"team_id"`
+	Sequence  int64  `json:"sequence"`
+	EventType string `json:"event_type"`
+	Payload   []byte `json:"payload"`
+}
+
+func (q *Queries) ListRosterEventsAfter(ctx context.Context, arg ListRosterEventsAfterParams) ([]ListRosterEventsAfterRow, error) {
+	rows, err := q.db.Query(ctx, listRosterEventsAfter, arg.After, arg.MaxEvents)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRosterEventsAfterRow
+	for rows.Next() {
+		var i ListRosterEventsAfterRow
+		if err := rows.Scan(
+			&i.Position,
+			&i.TeamID,
+			&i.Sequence,
+			&i.EventType,
+			&i.Payload,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRosterStreams = `-- name: ListRosterStreams :many
+SELECT
+    team_id,
+    version
+FROM
+    roster_streams
+ORDER BY
+    team_id
+`
+
+func (q *Queries) ListRosterStreams(ctx context.Context) ([]RosterStream, error) {
+	rows, err := q.db.Query(ctx, listRosterStreams)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RosterStream
+	for rows.Next() {
+		var i RosterStream
+		if err := rows.Scan(&i.TeamID, &i.Version); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockRosterLog = `-- name: LockRosterLog :exec
+SELECT
+    pg_advisory_xact_lock(hashtext('roster_events'))
+`
+
+// Taken before any stream is touched and held until commit, so appends commit
+// one at a time and positions are handed out in commit order. Trade appends
+// take it too, since trade events share the roster log's positions.
+func (q *Queries) LockRosterLog(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, lockRosterLog)
+	return err
+}
+
+const lockRosterStream = `-- name: LockRosterStream :one
+SELECT
+    version
+FROM
+    roster_streams
+WHERE
+    team_id = $1
+FOR UPDATE
+`
+
+func (q *Queries) LockRosterStream(ctx context.Context, teamID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, lockRosterStream, teamID)
+	var version int64
+	err := row.Scan(&version)
+	return version, err
+}
+
+const setRosterStreamVersion = `-- name: SetRosterStreamVersion :exec
+UPDATE
+    roster_streams
+SET
+    version = $1
+WHERE
+    team_id = $2
+`
+
+type SetRosterStreamVersionParams struct {
+	Version int64 `json:"version"`
+	TeamID  int64 `json:"team_id"`
+}
+
+func (q *Queries) SetRosterStreamVersion(ctx context.Context, arg SetRosterStreamVersionParams) error {
+	_, err := q.db.Exec(ctx, setRosterStreamVersion, arg.Version, arg.TeamID)
+	return err
+}
@@ -0,0 +1,144 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: roster_outbox.sql
+
+package database
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const insertRosterOutbox = `-- name: InsertRosterOutbox :exec
+INSERT INTO roster_outbox (team_id, sequence)
+    VALUES ($1, $2)
+`
+
+type InsertRosterOutboxParams struct {
+	TeamID   int64 `json:"team_id"`
+	Sequence int64 `json:"sequence"`
+}
+
+func (q *Queries) InsertRosterOutbox(ctx context.Context, arg InsertRosterOutboxParams) error {
+	_, err := q.db.Exec(ctx, insertRosterOutbox, arg.TeamID, arg.Sequence)
+	return err
+}
+
+const listPendingRosterOutbox = `-- name: ListPendingRosterOutbox :many
+SELECT
+    o.id,
+    o.team_id,
+    o.sequence,
+    e.event_type,
+    e.payload,
+    coalesce(d.attempts, 0)::integer AS attempts
+FROM
+    roster_outbox o
+    JOIN roster_events e ON e.team_id = o.team_id
+        AND e.sequence = o.sequence
+    LEFT JOIN roster_outbox_deliveries d ON d.outbox_id = o.id
+        AND d.subscriber = $1
+WHERE
+    d.outbox_id IS NULL
+    OR (d.delivered_at IS NULL
+        AND d.dead_lettered_at IS NULL
+        AND d.next_attempt_at <= $2)
+ORDER BY
+    o.id
+LIMIT $3
+`
+
+type ListPendingRosterOutboxParams struct {
+	Subscriber  string             `json:"subscriber"`
+	Now         pgtype.Timestamptz `json:"now"`
+	MaxMessages int32              `json:"max_messages"`
+}
+
+type ListPendingRosterOutboxRow struct {
+	ID        int64  `json:"id"`
+	TeamID    int64  `json:"team_id"`
+	Sequence  int64  `json:"sequence"`
+	EventType string `json:"event_type"`
+	Payload   []byte `json:"payload"`
+	Attempts  int32  `json:"attempts"`
+}
+
+func (q *Queries) ListPendingRosterOutbox(ctx context.Context, arg ListPendingRosterOutboxParams) ([]ListPendingRosterOutboxRow, error) {
+	rows, err := q.db.Query(ctx, listPendingRosterOutbox, arg.Subscriber, arg.Now, arg.MaxMessages)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPendingRosterOutboxRow
+	for rows.Next() {
+		var i ListPendingRosterOutboxRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TeamID,
+			&i.Sequence,
+			&i.EventType,
+			&i.Payload,
+			&i.Attempts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markRosterOutboxDelivered = `-- name: MarkRosterOutboxDelivered :exec
+INSERT INTO roster_outbox_deliveries (outbox_id, subscriber, attempts, next_attempt_at, delivered_at)
+    VALUES ($1, $2, 1, $3, $3)
+ON CONFLICT (outbox_id, subscriber)
+    DO UPDATE SET
+        attempts = roster_outbox_deliveries.attempts + 1,
+        delivered_at = excluded.delivered_at,
+        last_error = NULL
+`
+
+type MarkRosterOutboxDeliveredParams struct {
+	OutboxID    int64              `json:"outbox_id"`
+	Subscriber  string             `json:"subscriber"`
+	DeliveredAt pgtype.Timestamptz `json:"delivered_at"`
+}
+
+func (q *Queries) MarkRosterOutboxDelivered(ctx context.Context, arg MarkRosterOutboxDeliveredParams) error {
+	_, err := q.db.Exec(ctx, markRosterOutboxDelivered, arg.OutboxID, arg.Subscriber, arg.DeliveredAt)
+	return err
+}
+
+const recordRosterOutboxFailure = `-- name: RecordRosterOutboxFailure :exec
+INSERT INTO roster_outbox_deliveries (outbox_id, subscriber, attempts, next_attempt_at, dead_lettered_at, last_error)
+    VALUES ($1, $2, 1, $3, $4, $5)
+ON CONFLICT (outbox_id, subscriber)
+    DO UPDATE SET
+        attempts = roster_outbox_deliveries.attempts + 1,
+        next_attempt_at = excluded.next_attempt_at,
+        dead_lettered_at = excluded.dead_lettered_at,
+        last_error = excluded.last_error
+`
+
+type RecordRosterOutboxFailureParams struct {
+	OutboxID       int64              `json:"outbox_id"`
+	Subscriber     string             `json:"subscriber"`
+	NextAttemptAt  pgtype.Timestamptz `json:"next_attempt_at"`
+	DeadLetteredAt pgtype.Timestamptz `json:"dead_lettered_at"`
+	LastError      pgtype.Text        `json:"last_error"`
+}
+
+func (q *Queries) RecordRosterOutboxFailure(ctx context.Context, arg RecordRosterOutboxFailureParams) error {
+	_, err := q.db.Exec(ctx, recordRosterOutboxFailure,
+		arg.OutboxID,
+		arg.Subscriber,
+		arg.NextAttemptAt,
+		arg.DeadLetteredAt,
+		arg.LastError,
+	)
+	return err
+}
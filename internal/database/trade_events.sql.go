@@ -0,0 +1,233 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: trade_events.sql
+
+package database
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const ensureTradeStream = `-- name: EnsureTradeStream :exec
+INSERT INTO trade_streams (trade_id)
+    VALUES ($1)
+ON CONFLICT (trade_id)
+    DO NOTHING
+`
+
+func (q *Queries) EnsureTradeStream(ctx context.Context, tradeID int64) error {
+	_, err := q.db.Exec(ctx, ensureTradeStream, tradeID)
+	return err
+}
+
+const getLastTradeEventPosition = `-- name: GetLastTradeEventPosition :one
+SELECT
+    coalesce(max(position), 0)::bigint
+FROM
+    trade_events
+`
+
+func (q *Queries) GetLastTradeEventPosition(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, getLastTradeEventPosition)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const insertTradeEvent = `-- name: InsertTradeEvent :exec
+INSERT INTO trade_events (trade_id, sequence, event_type, payload, occurred_at)
+    VALUES ($1, $2, $3, $4, $5)
+`
+
+type InsertTradeEventParams struct {
+	TradeID    int64              `json:"trade_id"`
+	Sequence   int64              `json:"sequence"`
+	EventType  string             `json:"event_type"`
+	Payload    []byte             `json:"payload"`
+	OccurredAt pgtype.Timestamptz `json:"occurred_at"`
+}
+
+func (q *Queries) InsertTradeEvent(ctx context.Context, arg InsertTradeEventParams) error {
+	_, err := q.db.Exec(ctx, insertTradeEvent,
+		arg.TradeID,
+		arg.Sequence,
+		arg.EventType,
+		arg.Payload,
+		arg.OccurredAt,
+	)
+	return err
+}
+
+const listTradeEvents = `-- name: ListTradeEvents :many
+SELECT
+    sequence,
+    event_type,
+    payload
+FROM
+    trade_events
+WHERE
+    trade_id = $1
+ORDER BY
+    sequence
+`
+
+type ListTradeEventsRow struct {
+	Sequence  int64  `json:"sequence"`
+	EventType string `json:"event_type"`
+	Payload   []byte `json:"payload"`
+}
+
+func (q *Queries) ListTradeEvents(ctx context.Context, tradeID int64) ([]ListTradeEventsRow, error) {
+	rows, err := q.db.Query(ctx, listTradeEvents, tradeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTradeEventsRow
+	for rows.Next() {
+		var i ListTradeEventsRow
+		if err := rows.Scan(&i.Sequence, &i.EventType, &i.Payload); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTradeEventsAfter = `-- name: ListTradeEventsAfter :many
+SELECT
+    position,
+    trade_id,
+    sequence,
+    event_type,
+    payload
+FROM
+    trade_events
+WHERE
+    position > $1
+ORDER BY
+    position
+LIMIT $2
+`
+
+type ListTradeEventsAfterParams struct {
+	After     int64 `json:"after"`
+	MaxEvents int32 `json:"max_events"`
+}
+
+type ListTradeEventsAfterRow struct {
+	Position  int64  `json:"position"`
+	TradeID   int64  `json:"trade_id"`
+	Sequence  int64  `json:"sequence"`
+	EventType string `json:"event_type"`
+	Payload   []byte `json:"payload"`
+}
+
+func (q *Queries) ListTradeEventsAfter(ctx context.Context, arg ListTradeEventsAfterParams) ([]ListTradeEventsAfterRow, error) {
+	rows, err := q.db.Query(ctx, listTradeEventsAfter, arg.After, arg.MaxEvents)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTradeEventsAfterRow
+	for rows.Next() {
+		var i ListTradeEventsAfterRow
+		if err := rows.Scan(
+			&i.Position,
+			&i.TradeID,
+			&i.Sequence,
+			&i.EventType,
+			&i.Payload,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTradesUnderReview = `-- name: ListTradesUnderReview :many
+SELECT
+    accepted.trade_id
+FROM
+    trade_events accepted
+WHERE
+    accepted.event_type = 'AcceptedTrade'
+    AND NOT EXISTS (
+        SELECT
+            1
+        FROM
+            trade_events settled
+        WHERE
+            settled.trade_id = accepted.trade_id
+            AND settled.event_type IN ('VetoedTrade', 'ExecutedTrade', 'FailedTrade'))
+ORDER BY
+    accepted.trade_id
+`
+
+// A trade is under review from its AcceptedTrade event until a veto, execution
+// or failure settles it, as TradeView.Apply has it.
+func (q *Queries) ListTradesUnderReview(ctx context.Context) ([]int64, error) {
+	rows, err := q.db.Query(ctx, listTradesUnderReview)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var trade_id int64
+		if err := rows.Scan(&trade_id); err != nil {
+			return nil, err
+		}
+		items = append(items, trade_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockTradeStream = `-- name: LockTradeStream :one
+SELECT
+    version
+FROM
+    trade_streams
+WHERE
+    trade_id = $1
+FOR UPDATE
+`
+
+func (q *Queries) LockTradeStream(ctx context.Context, tradeID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, lockTradeStream, tradeID)
+	var version int64
+	err := row.Scan(&version)
+	return version, err
+}
+
+const setTradeStreamVersion = `-- name: SetTradeStreamVersion :exec
+UPDATE
+    trade_streams
+SET
+    version = $1
+WHERE
+    trade_id = $2
+`
+
+type SetTradeStreamVersionParams struct {
+	Version int64 `json:"version"`
+	TradeID int64 `json:"trade_id"`
+}
+
+func (q *Queries) SetTradeStreamVersion(ctx context.Context, arg SetTradeStreamVersionParams) error {
+	_, err := q.db.Exec(ctx, setTradeStreamVersion, arg.Version, arg.TradeID)
+	return err
+}
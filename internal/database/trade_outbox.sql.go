@@ -0,0 +1,144 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: trade_outbox.sql
+
+package database
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const insertTradeOutbox = `-- name: InsertTradeOutbox :exec
+INSERT INTO trade_outbox (trade_id, sequence)
+    VALUES ($1, $2)
+`
+
+type InsertTradeOutboxParams struct {
+	TradeID  int64 `json:"trade_id"`
+	Sequence int64 `json:"sequence"`
+}
+
+func (q *Queries) InsertTradeOutbox(ctx context.Context, arg InsertTradeOutboxParams) error {
+	_, err := q.db.Exec(ctx, insertTradeOutbox, arg.TradeID, arg.Sequence)
+	return err
+}
+
+const listPendingTradeOutbox = `-- name: ListPendingTradeOutbox :many
+SELECT
+    o.id,
+    o.trade_id,
+    o.sequence,
+    e.event_type,
+    e.payload,
+    coalesce(d.attempts, 0)::integer AS attempts
+FROM
+    trade_outbox o
+    JOIN trade_events e ON e.trade_id = o.trade_id
+        AND e.sequence = o.sequence
+    LEFT JOIN trade_outbox_deliveries d ON d.outbox_id = o.id
+        AND d.subscriber = $1
+WHERE
+    d.outbox_id IS NULL
+    OR (d.delivered_at IS NULL
+        AND d.dead_lettered_at IS NULL
+        AND d.next_attempt_at <= $2)
+ORDER BY
+    o.id
+LIMIT $3
+`
+
+type ListPendingTradeOutboxParams struct {
+	Subscriber  string             `json:"subscriber"`
+	Now         pgtype.Timestamptz `json:"now"`
+	MaxMessages int32              `json:"max_messages"`
+}
+
+type ListPendingTradeOutboxRow struct {
+	ID        int64  `json:"id"`
+	TradeID   int64  `json:"trade_id"`
+	Sequence  int64  `json:"sequence"`
+	EventType string `json:"event_type"`
+	Payload   []byte `json:"payload"`
+	Attempts  int32  `json:"attempts"`
+}
+
+func (q *Queries) ListPendingTradeOutbox(ctx context.Context, arg ListPendingTradeOutboxParams) ([]ListPendingTradeOutboxRow, error) {
+	rows, err := q.db.Query(ctx, listPendingTradeOutbox, arg.Subscriber, arg.Now, arg.MaxMessages)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPendingTradeOutboxRow
+	for rows.Next() {
+		var i ListPendingTradeOutboxRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TradeID,
+			&i.Sequence,
+			&i.EventType,
+			&i.Payload,
+			&i.Attempts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markTradeOutboxDelivered = `-- name: MarkTradeOutboxDelivered :exec
+INSERT INTO trade_outbox_deliveries (outbox_id, subscriber, attempts, next_attempt_at, delivered_at)
+    VALUES ($1, $2, 1, $3, $3)
+ON CONFLICT (outbox_id, subscriber)
+    DO UPDATE SET
+        attempts = trade_outbox_deliveries.attempts + 1,
+        delivered_at = excluded.delivered_at,
+        last_error = NULL
+`
+
+type MarkTradeOutboxDeliveredParams struct {
+	OutboxID    int64              `json:"outbox_id"`
+	Subscriber  string             `json:"subscriber"`
+	DeliveredAt pgtype.Timestamptz `json:"delivered_at"`
+}
+
+func (q *Queries) MarkTradeOutboxDelivered(ctx context.Context, arg MarkTradeOutboxDeliveredParams) error {
+	_, err := q.db.Exec(ctx, markTradeOutboxDelivered, arg.OutboxID, arg.Subscriber, arg.DeliveredAt)
+	return err
+}
+
+const recordTradeOutboxFailure = `-- name: RecordTradeOutboxFailure :exec
+INSERT INTO trade_outbox_deliveries (outbox_id, subscriber, attempts, next_attempt_at, dead_lettered_at, last_error)
+    VALUES ($1, $2, 1, $3, $4, $5)
+ON CONFLICT (outbox_id, subscriber)
+    DO UPDATE SET
+        attempts = trade_outbox_deliveries.attempts + 1,
+        next_attempt_at = excluded.next_attempt_at,
+        dead_lettered_at = excluded.dead_lettered_at,
+        last_error = excluded.last_error
+`
+
+type RecordTradeOutboxFailureParams struct {
+	OutboxID       int64              `json:"outbox_id"`
+	Subscriber     string             `json:"subscriber"`
+	NextAttemptAt  pgtype.Timestamptz `json:"next_attempt_at"`
+	DeadLetteredAt pgtype.Timestamptz `json:"dead_lettered_at"`
+	LastError      pgtype.Text        `json:"last_error"`
+}
+
+func (q *Queries) RecordTradeOutboxFailure(ctx context.Context, arg RecordTradeOutboxFailureParams) error {
+	_, err := q.db.Exec(ctx, recordTradeOutboxFailure,
+		arg.OutboxID,
+		arg.Subscriber,
+		arg.NextAttemptAt,
+		arg.DeadLetteredAt,
+		arg.LastError,
+	)
+	return err
+}
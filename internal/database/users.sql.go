@@ -0,0 +1,85 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: users.sql
+
+package database
+
+import (
+	"context"
+)
+
+const createUser = `-- name: CreateUser :one
+INSERT INTO users (email, display_name, password_hash)
+    VALUES ($1, $2, $3)
+RETURNING
+    id
+`
+
+type CreateUserParams struct {
+	Email        string `json:"email"`
+	DisplayName  string `json:"display_name"`
+	PasswordHash []byte `json:"password_hash"`
+}
+
+func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (int64, error) {
+	row := q.db.QueryRow(ctx, createUser, arg.Email, arg.DisplayName, arg.PasswordHash)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const getUser = `-- name: GetUser :one
+SELECT
+    id,
+    email,
+    display_name
+FROM
+    users
+WHERE
+    id = $1
+`
+
+type GetUserRow struct {
+	ID          int64  `json:"id"`
+	Email       string `json:"email"`
+	DisplayName string `json:"display_name"`
+}
+
+func (q *Queries) GetUser(ctx context.Context, id int64) (GetUserRow, error) {
+	row := q.db.QueryRow(ctx, getUser, id)
+	var i GetUserRow
+	err := row.Scan(&i.ID, &i.Email, &i.DisplayName)
+	return i, err
+}
+
+const getUserCredentials = `-- name: GetUserCredentials :one
+SELECT
+    id,
+    email,
+    display_name,
+    password_hash
+FROM
+    users
+WHERE
+    email = $1
+`
+
+type GetUserCredentialsRow struct {
+	ID           int64  `json:"id"`
+	Email        string `json:"email"`
+	DisplayName  string `json:"display_name"`
+	PasswordHash []byte `json:"password_hash"`
+}
+
+func (q *Queries) GetUserCredentials(ctx context.Context, email string) (GetUserCredentialsRow, error) {
+	row := q.db.QueryRow(ctx, getUserCredentials, email)
+	var i GetUserCredentialsRow
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.DisplayName,
+		&i.PasswordHash,
+	)
+	return i, err
+}
@@ -0,0 +1,181 @@
+package domain
+
+import "fmt"
+
+// OverflowRule decides what happens to the pitching line that carries a team past
+// its innings cap.
+type OverflowRule int
+
+const (
+	// OverflowCountCrossingGame counts the crossing game in full, then stops.
+	OverflowCountCrossingGame OverflowRule = iota + 1
+	// OverflowDropCrossingGame discards any game that would cross the cap, so
+	// later games that still fit under it continue to count.
+	OverflowDropCrossingGame
+)
+
+func (r OverflowRule) String() string {
+	switch r {
+	case OverflowCountCrossingGame:
+		return "OverflowCountCrossingGame"
+	case OverflowDropCrossingGame:
+		return "OverflowDropCrossingGame"
+	default:
+		return fmt.Sprintf("OverflowRule(%d)", int(r))
+	}
+}
+
+// UsageCaps limit how much of a season each lineup slot may be credited with.
+// Zero means no cap. Games count once per player per game credited to the slot.
+type UsageCaps struct {
+	HitterGames    int
+	PitcherGames   int
+	InningsPitched int
+	Overflow       OverflowRule
+}
+
+func (c UsageCaps) Validate() error {
+	if c.HitterGames < 0 || c.PitcherGames < 0 || c.InningsPitched < 0 {
+		return fmt.Errorf("%w: usage caps cannot be negative", ErrInvalidLeagueSettings)
+	}
+
+	if c.InningsPitched > 0 && c.Overflow != OverflowCountCrossingGame && c.Overflow != OverflowDropCrossingGame {
+		return fmt.Errorf("%w: innings cap needs an overflow rule, got %v", ErrInvalidLeagueSettings, c.Overflow)
+	}
+
+	return nil
+}
+
+func (c UsageCaps) outs() int {
+	return c.InningsPitched * 3
+}
+
+// SlotUsage is how much of each cap a team has used. Innings are kept as outs so
+// partial innings add up exactly.
+type SlotUsage struct {
+	HitterGames  int
+	PitcherGames int
+	OutsPitched  int
+}
+
+// Tally accumulates the stats credited to a team, counting each slot only until
+// its cap is reached. Games must be counted in the order they were played.
+type Tally struct {
+	Caps   UsageCaps
+	Totals StatTotals
+	Usage  SlotUsage
+}
+
+func NewTally(caps UsageCaps) Tally {
+	return Tally{Caps: caps}
+}
+
+// Count credits a game against the lineup in rv, as Credit does, unless the
+// player's slot has reached its cap.
+//
+// Panics if an unrecognized RosterStatus is encountered.
+func (t *Tally) Count(rv RosterView, game GameStats) {
+	e, ok := rv.Entry(game.PlayerID)
+	if !ok {
+		return
+	}
+
+	switch e.RosterStatus {
+	case StatusActiveHitter:
+		if reached(t.Caps.HitterGames, t.Usage.HitterGames) {
+			return
+		}
+
+		t.Usage.HitterGames++
+		t.Totals.Hitting = t.Totals.Hitting.Add(game.Hitting)
+	case StatusActivePitcher:
+		if !t.admitsPitching(game.Pitching.OutsRecorded) {
+			return
+		}
+
+		t.Usage.PitcherGames++
+		t.Usage.OutsPitched += game.Pitching.OutsRecorded
+		t.Totals.Pitching = t.Totals.Pitching.Add(game.Pitching)
+	case StatusInactive:
+	default:
+		panic(fmt.Errorf("%w: %v", ErrUnrecognizedRosterStatus, e.RosterStatus))
+	}
+}
+
+func (t Tally) admitsPitching(outs int) bool {
+	if reached(t.Caps.PitcherGames, t.Usage.PitcherGames) {
+		return false
+	}
+
+	limit := t.Caps.outs()
+	switch {
+	case limit == 0:
+		return true
+	case t.Usage.OutsPitched >= limit:
+		return false
+	case t.Usage.OutsPitched+outs <= limit:
+		return true
+	default:
+		return t.Caps.Overflow == OverflowCountCrossingGame
+	}
+}
+
+func reached(limit, used int) bool {
+	return limit > 0 && used >= limit
+}
+
+// CapProjection compares a cap with what has been used and what the team is on
+// pace to use by the end of the season. Cap is zero when the slot is uncapped.
+type CapProjection struct {
+	Cap       int
+	Used      int
+	Projected int
+}
+
+// Remaining is how much of the cap is left, or zero once it is reached.
+func (p CapProjection) Remaining() int {
+	return max(p.Cap-p.Used, 0)
+}
+
+// CapReport projects each cap. Innings are reported in outs.
+type CapReport struct {
+	HitterGames  CapProjection
+	PitcherGames CapProjection
+	OutsPitched  CapProjection
+}
+
+// ProjectCaps projects usage to the end of the season from lineup, the roster as
+// of at. Every active hitter is assumed to play each remaining day. Pitchers are
+// projected at the per-day pace of the stats counted so far, since their usage
+// depends on rotations rather than lineup slots.
+func ProjectCaps(caps UsageCaps, usage SlotUsage, lineup RosterView, calendar MatchupCalendar) CapReport {
+	at := lineup.EffectiveThrough
+	left := calendar.DaysLeft(at)
+	elapsed := calendar.DaysElapsed(at)
+
+	pace := func(used int) int {
+		if elapsed == 0 {
+			return used
+		}
+
+		return used + used*left/elapsed
+	}
+
+	return CapReport{
+		HitterGames: CapProjection{
+			Cap:       caps.HitterGames,
+			Used:      usage.HitterGames,
+			Projected: usage.HitterGames + lineup.Counts().ActiveHitters*left,
+		},
+		PitcherGames: CapProjection{
+			Cap:       caps.PitcherGames,
+			Used:      usage.PitcherGames,
+			Projected: pace(usage.PitcherGames),
+		},
+		OutsPitched: CapProjection{
+			Cap:       caps.outs(),
+			Used:      usage.OutsPitched,
+			Projected: pace(usage.OutsPitched),
+		},
+	}
+}
@@ -0,0 +1,189 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+func TestTallyCount(t *testing.T) {
+	hitting := domain.HittingLine{AtBats: 4, Hits: 2}
+	start := func(outs int) domain.GameStats {
+		return domain.GameStats{PlayerID: 1, Pitching: domain.PitchingLine{OutsRecorded: outs, Strikeouts: 5}}
+	}
+
+	testCases := []struct {
+		name      string
+		hitters   int
+		pitchers  int
+		caps      domain.UsageCaps
+		games     []domain.GameStats
+		wantUsage domain.SlotUsage
+		wantOuts  int
+	}{
+		{
+			name:      "uncapped slots count every game",
+			pitchers:  1,
+			games:     []domain.GameStats{start(18), start(21)},
+			wantUsage: domain.SlotUsage{PitcherGames: 2, OutsPitched: 39},
+			wantOuts:  39,
+		},
+		{
+			name:      "hitter games stop at the cap",
+			hitters:   1,
+			caps:      domain.UsageCaps{HitterGames: 2},
+			games:     []domain.GameStats{{PlayerID: 1, Hitting: hitting}, {PlayerID: 1, Hitting: hitting}, {PlayerID: 1, Hitting: hitting}},
+			wantUsage: domain.SlotUsage{HitterGames: 2},
+		},
+		{
+			name:      "pitcher games stop at the cap",
+			pitchers:  1,
+			caps:      domain.UsageCaps{PitcherGames: 1},
+			games:     []domain.GameStats{start(18), start(21)},
+			wantUsage: domain.SlotUsage{PitcherGames: 1, OutsPitched: 18},
+			wantOuts:  18,
+		},
+		{
+			name:      "crossing game counts in full",
+			pitchers:  1,
+			caps:      domain.UsageCaps{InningsPitched: 10, Overflow: domain.OverflowCountCrossingGame},
+			games:     []domain.GameStats{start(18), start(18), start(3)},
+			wantUsage: domain.SlotUsage{PitcherGames: 2, OutsPitched: 36},
+			wantOuts:  36,
+		},
+		{
+			name:      "crossing game is dropped while later games that fit still count",
+			pitchers:  1,
+			caps:      domain.UsageCaps{InningsPitched: 10, Overflow: domain.OverflowDropCrossingGame},
+			games:     []domain.GameStats{start(18), start(18), start(3)},
+			wantUsage: domain.SlotUsage{PitcherGames: 2, OutsPitched: 21},
+			wantOuts:  21,
+		},
+		{
+			name:      "game reaching the cap exactly counts under either rule",
+			pitchers:  1,
+			caps:      domain.UsageCaps{InningsPitched: 12, Overflow: domain.OverflowDropCrossingGame},
+			games:     []domain.GameStats{start(18), start(18), start(3)},
+			wantUsage: domain.SlotUsage{PitcherGames: 2, OutsPitched: 36},
+			wantOuts:  36,
+		},
+		{
+			name:     "inactive player uses no cap",
+			caps:     domain.UsageCaps{PitcherGames: 1},
+			games:    []domain.GameStats{start(18)},
+			wantOuts: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rv := testkit.ActivatedRosterView(
+				testkit.NewRosterView(testkit.TeamA(), 1, testkit.TodayLock()),
+				tc.hitters,
+				tc.pitchers,
+			)
+
+			tally := domain.NewTally(tc.caps)
+			for _, g := range tc.games {
+				tally.Count(rv, g)
+			}
+
+			assert.Equal(t, tally.Usage, tc.wantUsage)
+			assert.Equal(t, tally.Totals.Pitching.OutsRecorded, tc.wantOuts)
+			assert.Equal(t, tally.Totals.Hitting.AtBats, tc.wantUsage.HitterGames*hitting.AtBats)
+		})
+	}
+
+	t.Run("panics on an unrecognized roster status", func(t *testing.T) {
+		rv := domain.RosterView{
+			TeamID: testkit.TeamA(),
+			Entries: []domain.RosterEntry{
+				{TeamID: testkit.TeamA(), PlayerID: 1, RosterStatus: domain.RosterStatus(999)},
+			},
+			EffectiveThrough: testkit.TodayLock(),
+		}
+		tally := domain.NewTally(domain.UsageCaps{})
+
+		err := require.PanicsError(t, func() { tally.Count(rv, start(3)) })
+		assert.ErrorIs(t, err, domain.ErrUnrecognizedRosterStatus)
+	})
+}
+
+func TestUsageCapsValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		caps    domain.UsageCaps
+		wantErr error
+	}{
+		{
+			name: "no caps",
+			caps: domain.UsageCaps{},
+		},
+		{
+			name: "games caps need no overflow rule",
+			caps: domain.UsageCaps{HitterGames: 1458, PitcherGames: 200},
+		},
+		{
+			name: "innings cap with an overflow rule",
+			caps: domain.UsageCaps{InningsPitched: 1400, Overflow: domain.OverflowDropCrossingGame},
+		},
+		{
+			name:    "reject innings cap without an overflow rule",
+			caps:    domain.UsageCaps{InningsPitched: 1400},
+			wantErr: domain.ErrInvalidLeagueSettings,
+		},
+		{
+			name:    "reject a negative cap",
+			caps:    domain.UsageCaps{HitterGames: -1},
+			wantErr: domain.ErrInvalidLeagueSettings,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.caps.Validate()
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestProjectCaps(t *testing.T) {
+	// Ten days into a twenty-eight day season, with eighteen left.
+	calendar := domain.MatchupCalendar{
+		SeasonStart: testkit.TodayLock().AddDate(0, 0, -10),
+		PeriodDays:  7,
+		Periods:     4,
+	}
+	caps := domain.UsageCaps{HitterGames: 100, PitcherGames: 20, InningsPitched: 50, Overflow: domain.OverflowCountCrossingGame}
+	usage := domain.SlotUsage{HitterGames: 40, PitcherGames: 10, OutsPitched: 60}
+	lineup := testkit.ActivatedRosterView(testkit.NewRosterView(testkit.TeamA(), 5, testkit.TodayLock()), 3, 2)
+
+	report := domain.ProjectCaps(caps, usage, lineup, calendar)
+
+	assert.Equal(t, report, domain.CapReport{
+		HitterGames:  domain.CapProjection{Cap: 100, Used: 40, Projected: 40 + 3*18},
+		PitcherGames: domain.CapProjection{Cap: 20, Used: 10, Projected: 10 + 10*18/10},
+		OutsPitched:  domain.CapProjection{Cap: 150, Used: 60, Projected: 60 + 60*18/10},
+	})
+	assert.Equal(t, report.HitterGames.Remaining(), 60)
+	assert.Equal(t, domain.CapProjection{Cap: 20, Used: 25}.Remaining(), 0)
+
+	t.Run("open-ended season projects only what is used", func(t *testing.T) {
+		calendar := calendar
+		calendar.Periods = 0
+
+		report := domain.ProjectCaps(caps, usage, lineup, calendar)
+
+		assert.Equal(t, report.HitterGames.Projected, 40)
+		assert.Equal(t, report.OutsPitched.Projected, 60)
+	})
+}
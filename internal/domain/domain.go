@@ -1,3 +1,5 @@
 package domain
 
+type LeagueID int
 type TeamID int
+type UserID int
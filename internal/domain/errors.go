@@ -2,17 +2,94 @@ package domain
 
 import "errors"
 
+// Rejection is a domain rule turning a request down. The caller can act on it
+// by changing the request, unlike the plain errors further down, which mean
+// stored or decoded data does not fit the model.
+type Rejection struct {
+	msg string
+}
+
+func (r *Rejection) Error() string {
+	return r.msg
+}
+
+func reject(msg string) error {
+	return &Rejection{msg: msg}
+}
+
+var (
+	ErrActiveHittersFull          = reject("roster already has the maximum active hitters")
+	ErrActivePitchersFull         = reject("roster already has the maximum active pitchers")
+	ErrAlreadyVotedOnTrade        = reject("team has already voted on this trade")
+	ErrDuplicateMLBPlayerID       = reject("MLB player ID appears more than once")
+	ErrDuplicateTeamName          = reject("team name is already taken in the league")
+	ErrInvalidCredentials         = reject("email or password is incorrect")
+	ErrInvalidEmail               = reject("invalid email address")
+	ErrInvalidInvite              = reject("invalid league invite")
+	ErrInvalidLeagueSettings      = reject("invalid league settings")
+	ErrInvalidMatchupResult       = reject("invalid matchup result")
+	ErrInvalidMembership          = reject("invalid membership")
+	ErrInvalidName                = reject("invalid name")
+	ErrInvalidPhaseTransition     = reject("league cannot move to that phase")
+	ErrInvalidPlayer              = reject("invalid player")
+	ErrInvalidPlayoffSettings     = reject("invalid playoff settings")
+	ErrInvalidStandings           = reject("invalid standings")
+	ErrInvalidTradeTerms          = reject("invalid trade terms")
+	ErrInvalidUser                = reject("invalid user")
+	ErrInviteAlreadyUsed          = reject("league invite has already been used")
+	ErrInviteExpired              = reject("league invite has expired")
+	ErrInviteNotFound             = reject("league invite not found")
+	ErrInviteRevoked              = reject("league invite has been revoked")
+	ErrLeagueAlreadyCreated       = reject("league has already been created")
+	ErrLeagueClosedToTeams        = reject("league is not accepting new teams")
+	ErrLeagueFull                 = reject("league already has the maximum number of teams")
+	ErrLeagueSettingsLocked       = reject("league settings are locked once the draft starts")
+	ErrLineupMoveLimitReached     = reject("player has reached the lineup move limit for the period")
+	ErrLockPassed                 = reject("target lock has already passed")
+	ErrManagerAlreadyInLeague     = reject("user already manages a team in the league")
+	ErrMatchupAlreadyDecided      = reject("playoff matchup has already been decided")
+	ErrMatchupNotFound            = reject("playoff matchup not found")
+	ErrMatchupNotReady            = reject("playoff matchup is waiting on earlier results")
+	ErrMatchupResultRecorded      = reject("team already has a result for the matchup period")
+	ErrNotAuthorized              = reject("not authorized")
+	ErrNotEnoughTeams             = reject("league does not have enough teams")
+	ErrOverrideReasonRequired     = reject("override requires a reason")
+	ErrPeriodAddLimitReached      = reject("team has reached the add limit for the period")
+	ErrPlayerAlreadyActive        = reject("player already activated")
+	ErrPlayerAlreadyInactive      = reject("player already inactivated")
+	ErrPlayerAlreadyOnRoster      = reject("player already on roster")
+	ErrPlayerNotEligibleForRole   = reject("player is not eligible for that role")
+	ErrPlayerNotOnRoster          = reject("player is not on the roster")
+	ErrPlayoffsNotStarted         = reject("playoffs have not started")
+	ErrPlayoffsUndecided          = reject("playoff bracket has undecided matchups")
+	ErrRegularSeasonNotInProgress = reject("regular season is not in progress")
+	ErrRosterEventAlreadyReversed = reject("roster event has already been reversed")
+	ErrRosterEventNotReversible   = reject("roster event cannot be reversed")
+	ErrRosterFull                 = reject("roster is already full")
+	ErrRosterMovesClosed          = reject("roster moves are not allowed in the current league phase")
+	ErrScheduledMoveConflict      = reject("move conflicts with a move scheduled for a later lock")
+	ErrSeasonAddLimitReached      = reject("team has reached the add limit for the season")
+	ErrTeamNotInLeague            = reject("team is not in the league")
+	ErrTradeAlreadyAccepted       = reject("trade has already been accepted")
+	ErrTradeAlreadyOffered        = reject("trade has already been offered")
+	ErrTradeNotUnderReview        = reject("trade is not under review")
+	ErrTradeParticipantCannotVote = reject("teams in a trade cannot vote on it")
+	ErrTradeReviewClosed          = reject("trade review window has closed")
+	ErrTradeReviewOpen            = reject("trade review window is still open")
+	ErrUnrecognizedPlayerRole     = reject("unrecognized player role")
+	ErrWeakPassword               = reject("password does not meet the policy")
+)
+
 var (
-	ErrActiveHittersFull        = errors.New("roster already has the maximum active hitters")
-	ErrActivePitchersFull       = errors.New("roster already has the maximum active pitchers")
-	ErrEventOutsideViewWindow   = errors.New("event is outside view effective window")
-	ErrPlayerAlreadyActive      = errors.New("player already activated")
-	ErrPlayerAlreadyInactive    = errors.New("player already inactivated")
-	ErrPlayerAlreadyOnRoster    = errors.New("player already on roster")
-	ErrRosterFull               = errors.New("roster is already full")
-	ErrPlayerNotOnRoster        = errors.New("player is not on the roster")
-	ErrUnrecognizedPlayerRole   = errors.New("unrecognized player role")
-	ErrUnrecognizedRosterEvent  = errors.New("unrecognized roster event")
-	ErrUnrecognizedRosterStatus = errors.New("unrecognized roster status")
-	ErrWrongTeamID              = errors.New("team IDs do not match")
+	ErrEventOutsideViewWindow          = errors.New("event is outside view effective window")
+	ErrUnrecognizedLeagueEvent         = errors.New("unrecognized league event")
+	ErrUnrecognizedMembershipRole      = errors.New("unrecognized membership role")
+	ErrUnrecognizedNotificationChannel = errors.New("unrecognized notification channel")
+	ErrUnrecognizedNotificationKind    = errors.New("unrecognized notification kind")
+	ErrUnrecognizedRosterEvent         = errors.New("unrecognized roster event")
+	ErrUnrecognizedRosterStatus        = errors.New("unrecognized roster status")
+	ErrUnrecognizedTradeEvent          = errors.New("unrecognized trade event")
+	ErrWrongLeagueID                   = errors.New("league IDs do not match")
+	ErrWrongTeamID                     = errors.New("team IDs do not match")
+	ErrWrongTradeID                    = errors.New("trade IDs do not match")
 )
@@ -1,6 +1,10 @@
 package domain
 
-import "time"
+import (
+	"time"
+
+	"github.com/spcameron/dugout/internal/eventlog"
+)
 
 type DomainEvent interface {
 	isDomainEvent()
@@ -12,12 +16,29 @@ type RosterEvent interface {
 	OccurredAt() time.Time
 }
 
+// AddedPlayerToRoster records the roles the player was eligible for when added,
+// so that later activations can be checked without consulting the catalog.
+// Eligibility is frozen at that point: a role the catalog later grants or takes
+// away applies to the team only once the player is dropped and added again.
 type AddedPlayerToRoster struct {
 	TeamID      TeamID
 	PlayerID    PlayerID
+	Eligibility RoleSet
 	EffectiveAt time.Time
 }
 
+// EligibleRoles returns the roles the player may be activated in. Adds recorded
+// before eligibility was tracked were given their player's catalog roles when
+// the log was migrated; one that still carries no roles is eligible as a hitter
+// only, rather than gaining a role the player never had.
+func (e AddedPlayerToRoster) EligibleRoles() RoleSet {
+	if e.Eligibility.IsEmpty() {
+		return MustRoleSet(RoleHitter)
+	}
+
+	return e.Eligibility
+}
+
 func (e AddedPlayerToRoster) isDomainEvent() {}
 func (e AddedPlayerToRoster) Team() TeamID {
 	return e.TeamID
@@ -68,3 +89,333 @@ func (e InactivatedPlayerOnRoster) Team() TeamID {
 func (e InactivatedPlayerOnRoster) OccurredAt() time.Time {
 	return e.EffectiveAt
 }
+
+// RecordedRosterOverride annotates the compensating events appended alongside it
+// with the commissioner who made the change and why.
+//
+// Reverses is the sequence of the event being undone, or zero for forced moves.
+type RecordedRosterOverride struct {
+	TeamID      TeamID
+	Actor       UserID
+	Kind        OverrideKind
+	Reason      string
+	Reverses    eventlog.Sequence
+	EffectiveAt time.Time
+}
+
+func (e RecordedRosterOverride) isDomainEvent() {}
+func (e RecordedRosterOverride) Team() TeamID {
+	return e.TeamID
+}
+func (e RecordedRosterOverride) OccurredAt() time.Time {
+	return e.EffectiveAt
+}
+
+// AwardedWaiverClaim annotates the add appended just before it as the team
+// winning a waiver claim on the player.
+type AwardedWaiverClaim struct {
+	TeamID      TeamID
+	PlayerID    PlayerID
+	EffectiveAt time.Time
+}
+
+func (e AwardedWaiverClaim) isDomainEvent() {}
+func (e AwardedWaiverClaim) Team() TeamID {
+	return e.TeamID
+}
+func (e AwardedWaiverClaim) OccurredAt() time.Time {
+	return e.EffectiveAt
+}
+
+// ReceivedInTrade annotates the add appended just before it as the team
+// receiving the player in a trade. The league's add limits do not count it.
+type ReceivedInTrade struct {
+	TeamID      TeamID
+	PlayerID    PlayerID
+	TradeID     TradeID
+	EffectiveAt time.Time
+}
+
+func (e ReceivedInTrade) isDomainEvent() {}
+func (e ReceivedInTrade) Team() TeamID {
+	return e.TeamID
+}
+func (e ReceivedInTrade) OccurredAt() time.Time {
+	return e.EffectiveAt
+}
+
+type TradeEvent interface {
+	DomainEvent
+	Trade() TradeID
+	OccurredAt() time.Time
+}
+
+// ProposedTrade records the proposing team's manager offering a trade to the
+// receiving team. Accepting the offer opens review on the same terms.
+type ProposedTrade struct {
+	TradeID    TradeID
+	Terms      TradeTerms
+	ProposedBy UserID
+	ProposedAt time.Time
+}
+
+func (e ProposedTrade) isDomainEvent() {}
+func (e ProposedTrade) Trade() TradeID {
+	return e.TradeID
+}
+func (e ProposedTrade) OccurredAt() time.Time {
+	return e.ProposedAt
+}
+
+type AcceptedTrade struct {
+	TradeID           TradeID
+	Terms             TradeTerms
+	ReviewEndsAt      time.Time
+	VetoVotesRequired int
+	AcceptedAt        time.Time
+}
+
+func (e AcceptedTrade) isDomainEvent() {}
+func (e AcceptedTrade) Trade() TradeID {
+	return e.TradeID
+}
+func (e AcceptedTrade) OccurredAt() time.Time {
+	return e.AcceptedAt
+}
+
+type CastTradeVetoVote struct {
+	TradeID TradeID
+	Voter   TeamID
+	CastAt  time.Time
+}
+
+func (e CastTradeVetoVote) isDomainEvent() {}
+func (e CastTradeVetoVote) Trade() TradeID {
+	return e.TradeID
+}
+func (e CastTradeVetoVote) OccurredAt() time.Time {
+	return e.CastAt
+}
+
+// VetoedTrade records that a trade will not execute. Commissioner is zero when
+// the veto came from the league vote.
+type VetoedTrade struct {
+	TradeID      TradeID
+	Commissioner UserID
+	VetoedAt     time.Time
+}
+
+func (e VetoedTrade) isDomainEvent() {}
+func (e VetoedTrade) Trade() TradeID {
+	return e.TradeID
+}
+func (e VetoedTrade) OccurredAt() time.Time {
+	return e.VetoedAt
+}
+
+type ExecutedTrade struct {
+	TradeID     TradeID
+	EffectiveAt time.Time
+	ExecutedAt  time.Time
+}
+
+func (e ExecutedTrade) isDomainEvent() {}
+func (e ExecutedTrade) Trade() TradeID {
+	return e.TradeID
+}
+func (e ExecutedTrade) OccurredAt() time.Time {
+	return e.ExecutedAt
+}
+
+// FailedTrade records that a trade survived review but could not be applied to
+// the rosters involved, for example because one of them was full.
+type FailedTrade struct {
+	TradeID  TradeID
+	Reason   string
+	FailedAt time.Time
+}
+
+func (e FailedTrade) isDomainEvent() {}
+func (e FailedTrade) Trade() TradeID {
+	return e.TradeID
+}
+func (e FailedTrade) OccurredAt() time.Time {
+	return e.FailedAt
+}
+
+type LeagueEvent interface {
+	DomainEvent
+	League() LeagueID
+	OccurredAt() time.Time
+}
+
+type CreatedLeague struct {
+	LeagueID     LeagueID
+	Name         string
+	Commissioner UserID
+	Settings     LeagueSettings
+	CreatedAt    time.Time
+}
+
+func (e CreatedLeague) isDomainEvent() {}
+func (e CreatedLeague) League() LeagueID {
+	return e.LeagueID
+}
+func (e CreatedLeague) OccurredAt() time.Time {
+	return e.CreatedAt
+}
+
+type JoinedLeague struct {
+	LeagueID LeagueID
+	TeamID   TeamID
+	TeamName string
+	Manager  UserID
+	JoinedAt time.Time
+}
+
+func (e JoinedLeague) isDomainEvent() {}
+func (e JoinedLeague) League() LeagueID {
+	return e.LeagueID
+}
+func (e JoinedLeague) OccurredAt() time.Time {
+	return e.JoinedAt
+}
+
+type RenamedTeam struct {
+	LeagueID  LeagueID
+	TeamID    TeamID
+	Name      string
+	RenamedAt time.Time
+}
+
+func (e RenamedTeam) isDomainEvent() {}
+func (e RenamedTeam) League() LeagueID {
+	return e.LeagueID
+}
+func (e RenamedTeam) OccurredAt() time.Time {
+	return e.RenamedAt
+}
+
+type ChangedLeagueSettings struct {
+	LeagueID  LeagueID
+	Settings  LeagueSettings
+	ChangedAt time.Time
+}
+
+func (e ChangedLeagueSettings) isDomainEvent() {}
+func (e ChangedLeagueSettings) League() LeagueID {
+	return e.LeagueID
+}
+func (e ChangedLeagueSettings) OccurredAt() time.Time {
+	return e.ChangedAt
+}
+
+type AdvancedLeaguePhase struct {
+	LeagueID   LeagueID
+	Phase      LeaguePhase
+	AdvancedAt time.Time
+}
+
+func (e AdvancedLeaguePhase) isDomainEvent() {}
+func (e AdvancedLeaguePhase) League() LeagueID {
+	return e.LeagueID
+}
+func (e AdvancedLeaguePhase) OccurredAt() time.Time {
+	return e.AdvancedAt
+}
+
+type IssuedLeagueInvite struct {
+	LeagueID  LeagueID
+	InviteID  InviteID
+	TokenHash []byte
+	ExpiresAt time.Time
+	SingleUse bool
+	IssuedBy  UserID
+	IssuedAt  time.Time
+}
+
+func (e IssuedLeagueInvite) isDomainEvent() {}
+func (e IssuedLeagueInvite) League() LeagueID {
+	return e.LeagueID
+}
+func (e IssuedLeagueInvite) OccurredAt() time.Time {
+	return e.IssuedAt
+}
+
+type RedeemedLeagueInvite struct {
+	LeagueID   LeagueID
+	InviteID   InviteID
+	TeamID     TeamID
+	RedeemedBy UserID
+	RedeemedAt time.Time
+}
+
+func (e RedeemedLeagueInvite) isDomainEvent() {}
+func (e RedeemedLeagueInvite) League() LeagueID {
+	return e.LeagueID
+}
+func (e RedeemedLeagueInvite) OccurredAt() time.Time {
+	return e.RedeemedAt
+}
+
+type RevokedLeagueInvite struct {
+	LeagueID  LeagueID
+	InviteID  InviteID
+	RevokedAt time.Time
+}
+
+func (e RevokedLeagueInvite) isDomainEvent() {}
+func (e RevokedLeagueInvite) League() LeagueID {
+	return e.LeagueID
+}
+func (e RevokedLeagueInvite) OccurredAt() time.Time {
+	return e.RevokedAt
+}
+
+type GeneratedPlayoffBracket struct {
+	LeagueID    LeagueID
+	Settings    PlayoffSettings
+	Seeds       []TeamID
+	GeneratedAt time.Time
+}
+
+func (e GeneratedPlayoffBracket) isDomainEvent() {}
+func (e GeneratedPlayoffBracket) League() LeagueID {
+	return e.LeagueID
+}
+func (e GeneratedPlayoffBracket) OccurredAt() time.Time {
+	return e.GeneratedAt
+}
+
+// RecordedMatchupResult records the outcome of a regular-season matchup, from
+// which standings are ranked.
+type RecordedMatchupResult struct {
+	LeagueID   LeagueID
+	Result     MatchupResult
+	RecordedAt time.Time
+}
+
+func (e RecordedMatchupResult) isDomainEvent() {}
+func (e RecordedMatchupResult) League() LeagueID {
+	return e.LeagueID
+}
+func (e RecordedMatchupResult) OccurredAt() time.Time {
+	return e.RecordedAt
+}
+
+type RecordedPlayoffResult struct {
+	LeagueID   LeagueID
+	Matchup    MatchupID
+	HighScore  float64
+	LowScore   float64
+	Winner     TeamID
+	RecordedAt time.Time
+}
+
+func (e RecordedPlayoffResult) isDomainEvent() {}
+func (e RecordedPlayoffResult) League() LeagueID {
+	return e.LeagueID
+}
+func (e RecordedPlayoffResult) OccurredAt() time.Time {
+	return e.RecordedAt
+}
@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// InviteID numbers a league's invites from 1 in the order they were issued.
+type InviteID int
+
+// LeagueInvite lets whoever holds its token claim an open team slot. Only the
+// token's hash is kept, so the league's history cannot be used to join it.
+type LeagueInvite struct {
+	InviteID    InviteID
+	TokenHash   []byte
+	ExpiresAt   time.Time
+	SingleUse   bool
+	Redemptions int
+	Revoked     bool
+}
+
+// Usable reports whether the invite can still be redeemed at the given time.
+func (inv LeagueInvite) Usable(at time.Time) error {
+	switch {
+	case inv.Revoked:
+		return fmt.Errorf("%w: invite %v", ErrInviteRevoked, inv.InviteID)
+	case !at.Before(inv.ExpiresAt):
+		return fmt.Errorf("%w: invite %v expired at %v", ErrInviteExpired, inv.InviteID, inv.ExpiresAt)
+	case inv.SingleUse && inv.Redemptions > 0:
+		return fmt.Errorf("%w: invite %v", ErrInviteAlreadyUsed, inv.InviteID)
+	default:
+		return nil
+	}
+}
+
+// DecideIssueInvite returns the IssuedLeagueInvite events for a new invite if
+// allowed. Invites are only issued while the league is taking teams and has room.
+func (lv LeagueView) DecideIssueInvite(tokenHash []byte, expiresAt time.Time, singleUse bool, issuedBy UserID, at time.Time) ([]LeagueEvent, error) {
+	if lv.Phase != PhasePreDraft {
+		return nil, fmt.Errorf("%w: league is in %v", ErrLeagueClosedToTeams, lv.Phase)
+	}
+
+	if len(lv.Teams) >= lv.Settings.MaxTeams {
+		return nil, ErrLeagueFull
+	}
+
+	if len(tokenHash) == 0 || !expiresAt.After(at) {
+		return nil, fmt.Errorf("%w: invites need a token and a future expiry", ErrInvalidInvite)
+	}
+
+	res := []LeagueEvent{
+		IssuedLeagueInvite{
+			LeagueID:  lv.LeagueID,
+			InviteID:  InviteID(len(lv.Invites) + 1),
+			TokenHash: slices.Clone(tokenHash),
+			ExpiresAt: expiresAt,
+			SingleUse: singleUse,
+			IssuedBy:  issuedBy,
+			IssuedAt:  at,
+		},
+	}
+
+	return res, nil
+}
+
+// DecideRedeemInvite returns the events that record the invite matching
+// tokenHash as redeemed and join the manager's new team to the league, if
+// allowed. Redeeming is subject to every rule that DecideJoin enforces.
+func (lv LeagueView) DecideRedeemInvite(tokenHash []byte, team TeamID, name string, manager UserID, at time.Time) ([]LeagueEvent, error) {
+	inv, ok := lv.inviteByToken(tokenHash)
+	if !ok {
+		return nil, ErrInviteNotFound
+	}
+
+	err := inv.Usable(at)
+	if err != nil {
+		return nil, err
+	}
+
+	joined, err := lv.DecideJoin(team, name, manager, at)
+	if err != nil {
+		return nil, err
+	}
+
+	res := append([]LeagueEvent{
+		RedeemedLeagueInvite{
+			LeagueID:   lv.LeagueID,
+			InviteID:   inv.InviteID,
+			TeamID:     team,
+			RedeemedBy: manager,
+			RedeemedAt: at,
+		},
+	}, joined...)
+
+	return res, nil
+}
+
+// DecideRevokeInvite returns the RevokedLeagueInvite events that should be
+// recorded if allowed.
+func (lv LeagueView) DecideRevokeInvite(id InviteID, at time.Time) ([]LeagueEvent, error) {
+	inv, ok := lv.Invite(id)
+	if !ok {
+		return nil, fmt.Errorf("%w: invite %v", ErrInviteNotFound, id)
+	}
+
+	if inv.Revoked {
+		return nil, fmt.Errorf("%w: invite %v", ErrInviteRevoked, id)
+	}
+
+	res := []LeagueEvent{
+		RevokedLeagueInvite{
+			LeagueID:  lv.LeagueID,
+			InviteID:  id,
+			RevokedAt: at,
+		},
+	}
+
+	return res, nil
+}
+
+// Invite returns the invite with the given ID, if it was issued.
+func (lv LeagueView) Invite(id InviteID) (LeagueInvite, bool) {
+	i := lv.inviteIndex(id)
+	if i < 0 {
+		return LeagueInvite{}, false
+	}
+
+	return lv.Invites[i], true
+}
+
+func (lv LeagueView) inviteIndex(id InviteID) int {
+	return slices.IndexFunc(lv.Invites, func(inv LeagueInvite) bool { return inv.InviteID == id })
+}
+
+// inviteByToken compares hashes in constant time so response timing does not
+// reveal how much of a guessed token matched.
+func (lv LeagueView) inviteByToken(tokenHash []byte) (LeagueInvite, bool) {
+	for _, inv := range lv.Invites {
+		if subtle.ConstantTimeCompare(inv.TokenHash, tokenHash) == 1 {
+			return inv, true
+		}
+	}
+
+	return LeagueInvite{}, false
+}
@@ -0,0 +1,202 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+var inviteHash = []byte("invite-hash")
+
+// leagueWithInvite returns pre-draft LeagueA holding invite 1, which expires at
+// TomorrowLock.
+func leagueWithInvite(singleUse bool) domain.LeagueView {
+	lv := leagueInPhase(domain.PhasePreDraft)
+	lv.Apply(domain.IssuedLeagueInvite{
+		LeagueID:  testkit.LeagueA(),
+		InviteID:  1,
+		TokenHash: inviteHash,
+		ExpiresAt: testkit.TomorrowLock(),
+		SingleUse: singleUse,
+		IssuedBy:  testkit.Commissioner(),
+		IssuedAt:  testkit.TodayLock(),
+	})
+
+	return lv
+}
+
+func TestDecideIssueInvite(t *testing.T) {
+	full := leagueInPhase(domain.PhasePreDraft)
+	full.Settings.MaxTeams = 2
+
+	testCases := []struct {
+		name      string
+		view      domain.LeagueView
+		expiresAt time.Time
+		wantErr   error
+	}{
+		{
+			name:      "issue an invite numbered after the last one",
+			view:      leagueWithInvite(false),
+			expiresAt: testkit.TomorrowLock(),
+		},
+		{
+			name:      "reject an invite that has already expired",
+			view:      leagueInPhase(domain.PhasePreDraft),
+			expiresAt: testkit.TodayLock(),
+			wantErr:   domain.ErrInvalidInvite,
+		},
+		{
+			name:      "reject invites to a full league",
+			view:      full,
+			expiresAt: testkit.TomorrowLock(),
+			wantErr:   domain.ErrLeagueFull,
+		},
+		{
+			name:      "reject invites once the season starts",
+			view:      leagueInPhase(domain.PhaseInSeason),
+			expiresAt: testkit.TomorrowLock(),
+			wantErr:   domain.ErrLeagueClosedToTeams,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			events, err := tc.view.DecideIssueInvite([]byte("next-hash"), tc.expiresAt, false, testkit.Commissioner(), testkit.TodayLock())
+
+			if tc.wantErr != nil {
+				assert.Nil(t, events)
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, len(events), 1)
+			issued, ok := events[0].(domain.IssuedLeagueInvite)
+			require.True(t, ok)
+			assert.Equal(t, issued.InviteID, domain.InviteID(len(tc.view.Invites)+1))
+		})
+	}
+}
+
+func TestDecideRedeemInvite(t *testing.T) {
+	used := leagueWithInvite(true)
+	used.Invites[0].Redemptions = 1
+
+	revoked := leagueWithInvite(false)
+	revoked.Invites[0].Revoked = true
+
+	newcomer := domain.UserID(33)
+
+	testCases := []struct {
+		name    string
+		view    domain.LeagueView
+		hash    []byte
+		at      time.Time
+		wantErr error
+	}{
+		{
+			name: "redeem an invite to join the league",
+			view: leagueWithInvite(true),
+			hash: inviteHash,
+			at:   testkit.TodayLock(),
+		},
+		{
+			name:    "reject an unknown token",
+			view:    leagueWithInvite(false),
+			hash:    []byte("guess"),
+			at:      testkit.TodayLock(),
+			wantErr: domain.ErrInviteNotFound,
+		},
+		{
+			name:    "reject an invite at its expiry",
+			view:    leagueWithInvite(false),
+			hash:    inviteHash,
+			at:      testkit.TomorrowLock(),
+			wantErr: domain.ErrInviteExpired,
+		},
+		{
+			name:    "reject a used single-use invite",
+			view:    used,
+			hash:    inviteHash,
+			at:      testkit.TodayLock(),
+			wantErr: domain.ErrInviteAlreadyUsed,
+		},
+		{
+			name:    "reject a revoked invite",
+			view:    revoked,
+			hash:    inviteHash,
+			at:      testkit.TodayLock(),
+			wantErr: domain.ErrInviteRevoked,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			events, err := tc.view.DecideRedeemInvite(tc.hash, testkit.TeamC(), "Team C", newcomer, tc.at)
+
+			if tc.wantErr != nil {
+				assert.Nil(t, events)
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, events, []domain.LeagueEvent{
+				domain.RedeemedLeagueInvite{
+					LeagueID:   testkit.LeagueA(),
+					InviteID:   1,
+					TeamID:     testkit.TeamC(),
+					RedeemedBy: newcomer,
+					RedeemedAt: tc.at,
+				},
+				domain.JoinedLeague{
+					LeagueID: testkit.LeagueA(),
+					TeamID:   testkit.TeamC(),
+					TeamName: "Team C",
+					Manager:  newcomer,
+					JoinedAt: tc.at,
+				},
+			})
+
+			view := tc.view
+			view.Invites = append([]domain.LeagueInvite(nil), tc.view.Invites...)
+			for _, ev := range events {
+				view.Apply(ev)
+			}
+
+			inv, ok := view.Invite(1)
+			require.True(t, ok)
+			assert.ErrorIs(t, inv.Usable(tc.at), domain.ErrInviteAlreadyUsed)
+		})
+	}
+
+	t.Run("redeeming still enforces the league's join rules", func(t *testing.T) {
+		events, err := leagueWithInvite(false).DecideRedeemInvite(inviteHash, testkit.TeamC(), "Team C", testkit.ManagerA(), testkit.TodayLock())
+
+		assert.Nil(t, events)
+		assert.ErrorIs(t, err, domain.ErrManagerAlreadyInLeague)
+	})
+}
+
+func TestDecideRevokeInvite(t *testing.T) {
+	lv := leagueWithInvite(false)
+
+	events, err := lv.DecideRevokeInvite(2, testkit.TodayLock())
+	assert.Nil(t, events)
+	assert.ErrorIs(t, err, domain.ErrInviteNotFound)
+
+	events, err = lv.DecideRevokeInvite(1, testkit.TodayLock())
+	require.NoError(t, err)
+	require.Equal(t, len(events), 1)
+
+	lv.Apply(events[0])
+
+	events, err = lv.DecideRevokeInvite(1, testkit.TodayLock())
+	assert.Nil(t, events)
+	assert.ErrorIs(t, err, domain.ErrInviteRevoked)
+}
@@ -0,0 +1,393 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// LeaguePhase is where a league is in its season. Leagues only move forward,
+// one phase at a time.
+type LeaguePhase int
+
+const (
+	PhasePreDraft LeaguePhase = iota + 1
+	PhaseDrafting
+	PhaseInSeason
+	PhasePlayoffs
+	PhaseComplete
+)
+
+func (p LeaguePhase) String() string {
+	switch p {
+	case PhasePreDraft:
+		return "PhasePreDraft"
+	case PhaseDrafting:
+		return "PhaseDrafting"
+	case PhaseInSeason:
+		return "PhaseInSeason"
+	case PhasePlayoffs:
+		return "PhasePlayoffs"
+	case PhaseComplete:
+		return "PhaseComplete"
+	default:
+		return fmt.Sprintf("LeaguePhase(%d)", int(p))
+	}
+}
+
+// AllowsRosterMoves reports whether managers may add, drop, activate, and bench
+// players. Rosters are built by the draft, so moves open once the season starts
+// and close when it is complete.
+func (p LeaguePhase) AllowsRosterMoves() bool {
+	return p == PhaseInSeason || p == PhasePlayoffs
+}
+
+const (
+	MinLeagueTeams = 2
+	MaxLeagueTeams = 30
+)
+
+// LeagueSettings are chosen by the commissioner and locked once the draft starts.
+type LeagueSettings struct {
+	MaxTeams     int
+	TradeReview  TradeReviewPolicy
+	Playoffs     PlayoffSettings
+	Calendar     MatchupCalendar
+	Transactions TransactionLimits
+	Caps         UsageCaps
+}
+
+// TransactionRules returns the limits roster moves are held to in this league.
+func (s LeagueSettings) TransactionRules() TransactionRules {
+	return TransactionRules{
+		Limits:   s.Transactions,
+		Calendar: s.Calendar,
+	}
+}
+
+func DefaultLeagueSettings() LeagueSettings {
+	return LeagueSettings{
+		MaxTeams: 12,
+		TradeReview: TradeReviewPolicy{
+			Window:            48 * time.Hour,
+			VetoVotesRequired: 4,
+		},
+		Playoffs: PlayoffSettings{
+			Teams: 4,
+		},
+	}
+}
+
+func (s LeagueSettings) Validate() error {
+	if s.MaxTeams < MinLeagueTeams || s.MaxTeams > MaxLeagueTeams {
+		return fmt.Errorf("%w: max teams must be %d to %d, got %d", ErrInvalidLeagueSettings, MinLeagueTeams, MaxLeagueTeams, s.MaxTeams)
+	}
+
+	if s.TradeReview.Window < 0 || s.TradeReview.VetoVotesRequired < 0 {
+		return fmt.Errorf("%w: trade review window and veto votes cannot be negative", ErrInvalidLeagueSettings)
+	}
+
+	if s.Calendar.PeriodDays < 0 || s.Calendar.Periods < 0 {
+		return fmt.Errorf("%w: matchup periods cannot be negative", ErrInvalidLeagueSettings)
+	}
+
+	err := s.Transactions.Validate()
+	if err != nil {
+		return err
+	}
+
+	if s.Transactions.perPeriod() && !s.Calendar.Scheduled() {
+		return fmt.Errorf("%w: per-period limits need a matchup calendar", ErrInvalidLeagueSettings)
+	}
+
+	err = s.Caps.Validate()
+	if err != nil {
+		return err
+	}
+
+	if s.Playoffs.Teams > s.MaxTeams {
+		return fmt.Errorf("%w: %d playoff teams in a league of %d", ErrInvalidLeagueSettings, s.Playoffs.Teams, s.MaxTeams)
+	}
+
+	return s.Playoffs.Validate()
+}
+
+// LeagueTeam is a team as its league knows it. Teams live in their league's
+// stream because the league enforces rules across them: how many may join and
+// that their names are distinct.
+type LeagueTeam struct {
+	TeamID  TeamID
+	Name    string
+	Manager UserID
+}
+
+type LeagueView struct {
+	LeagueID     LeagueID
+	Name         string
+	Commissioner UserID
+	Phase        LeaguePhase
+	Settings     LeagueSettings
+	Teams        []LeagueTeam
+	Invites      []LeagueInvite
+	Results      []MatchupResult
+	Bracket      PlayoffBracket
+}
+
+// Exists reports whether the league has been created.
+func (lv LeagueView) Exists() bool {
+	return lv.Phase != 0
+}
+
+// Team returns the team with the given ID, if it is in the league.
+func (lv LeagueView) Team(id TeamID) (LeagueTeam, bool) {
+	i := slices.IndexFunc(lv.Teams, func(t LeagueTeam) bool { return t.TeamID == id })
+	if i < 0 {
+		return LeagueTeam{}, false
+	}
+
+	return lv.Teams[i], true
+}
+
+// DecideCreate returns the CreatedLeague events that start a league in the
+// pre-draft phase if allowed.
+func (lv LeagueView) DecideCreate(name string, commissioner UserID, settings LeagueSettings, at time.Time) ([]LeagueEvent, error) {
+	if lv.Exists() {
+		return nil, ErrLeagueAlreadyCreated
+	}
+
+	name, err := cleanName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = settings.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	res := []LeagueEvent{
+		CreatedLeague{
+			LeagueID:     lv.LeagueID,
+			Name:         name,
+			Commissioner: commissioner,
+			Settings:     settings,
+			CreatedAt:    at,
+		},
+	}
+
+	return res, nil
+}
+
+// DecideJoin returns the JoinedLeague events that add a team managed by manager
+// if allowed. Teams may only join before the draft.
+func (lv LeagueView) DecideJoin(team TeamID, name string, manager UserID, at time.Time) ([]LeagueEvent, error) {
+	if lv.Phase != PhasePreDraft {
+		return nil, fmt.Errorf("%w: league is in %v", ErrLeagueClosedToTeams, lv.Phase)
+	}
+
+	if len(lv.Teams) >= lv.Settings.MaxTeams {
+		return nil, ErrLeagueFull
+	}
+
+	if slices.ContainsFunc(lv.Teams, func(t LeagueTeam) bool { return t.Manager == manager }) {
+		return nil, fmt.Errorf("%w: user %v", ErrManagerAlreadyInLeague, manager)
+	}
+
+	name, err := lv.validateTeamName(team, name)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []LeagueEvent{
+		JoinedLeague{
+			LeagueID: lv.LeagueID,
+			TeamID:   team,
+			TeamName: name,
+			Manager:  manager,
+			JoinedAt: at,
+		},
+	}
+
+	return res, nil
+}
+
+// DecideRenameTeam returns the RenamedTeam events that should be recorded if allowed.
+func (lv LeagueView) DecideRenameTeam(team TeamID, name string, at time.Time) ([]LeagueEvent, error) {
+	if _, ok := lv.Team(team); !ok {
+		return nil, fmt.Errorf("%w: team %v, league %v", ErrTeamNotInLeague, team, lv.LeagueID)
+	}
+
+	name, err := lv.validateTeamName(team, name)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []LeagueEvent{
+		RenamedTeam{
+			LeagueID:  lv.LeagueID,
+			TeamID:    team,
+			Name:      name,
+			RenamedAt: at,
+		},
+	}
+
+	return res, nil
+}
+
+// DecideChangeSettings returns the ChangedLeagueSettings events that should be
+// recorded if allowed. Settings cannot drop MaxTeams below the teams already in
+// the league.
+func (lv LeagueView) DecideChangeSettings(settings LeagueSettings, at time.Time) ([]LeagueEvent, error) {
+	if lv.Phase != PhasePreDraft {
+		return nil, fmt.Errorf("%w: league is in %v", ErrLeagueSettingsLocked, lv.Phase)
+	}
+
+	err := settings.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if settings.MaxTeams < len(lv.Teams) {
+		return nil, fmt.Errorf("%w: league already has %d teams", ErrInvalidLeagueSettings, len(lv.Teams))
+	}
+
+	res := []LeagueEvent{
+		ChangedLeagueSettings{
+			LeagueID:  lv.LeagueID,
+			Settings:  settings,
+			ChangedAt: at,
+		},
+	}
+
+	return res, nil
+}
+
+// DecideAdvancePhase returns the AdvancedLeaguePhase events that move the league
+// to phase if it is the next one. The draft cannot start with fewer than
+// MinLeagueTeams teams or before the playoff bracket can be filled, since
+// settings lock once it starts. A season with a bracket cannot complete until every
+// playoff matchup is decided. Use DecideStartPlayoffs to seed the bracket when
+// the regular season ends.
+func (lv LeagueView) DecideAdvancePhase(phase LeaguePhase, at time.Time) ([]LeagueEvent, error) {
+	if !lv.Exists() || lv.Phase == PhaseComplete || phase != lv.Phase+1 {
+		return nil, fmt.Errorf("%w: %v to %v", ErrInvalidPhaseTransition, lv.Phase, phase)
+	}
+
+	if phase == PhaseDrafting {
+		need := max(MinLeagueTeams, lv.Settings.Playoffs.Teams)
+		if len(lv.Teams) < need {
+			return nil, fmt.Errorf("%w: has %d, needs %d", ErrNotEnoughTeams, len(lv.Teams), need)
+		}
+	}
+
+	if phase == PhaseComplete && lv.Bracket.Generated() && !lv.Bracket.Complete() {
+		return nil, ErrPlayoffsUndecided
+	}
+
+	res := []LeagueEvent{
+		AdvancedLeaguePhase{
+			LeagueID:   lv.LeagueID,
+			Phase:      phase,
+			AdvancedAt: at,
+		},
+	}
+
+	return res, nil
+}
+
+// Apply applies a league domain event to the view.
+//
+// Events that do not belong to this league or are unrecognized cause Apply to panic.
+func (lv *LeagueView) Apply(event LeagueEvent) {
+	if lv.LeagueID != event.League() {
+		panic(fmt.Errorf("%w: event league %v, view league %v", ErrWrongLeagueID, event.League(), lv.LeagueID))
+	}
+
+	switch ev := event.(type) {
+	case CreatedLeague:
+		lv.Name = ev.Name
+		lv.Commissioner = ev.Commissioner
+		lv.Settings = ev.Settings
+		lv.Phase = PhasePreDraft
+	case JoinedLeague:
+		lv.Teams = append(lv.Teams, LeagueTeam{
+			TeamID:  ev.TeamID,
+			Name:    ev.TeamName,
+			Manager: ev.Manager,
+		})
+	case RenamedTeam:
+		i := slices.IndexFunc(lv.Teams, func(t LeagueTeam) bool { return t.TeamID == ev.TeamID })
+		if i < 0 {
+			panic(fmt.Errorf("%w: team %v, league %v", ErrTeamNotInLeague, ev.TeamID, lv.LeagueID))
+		}
+		lv.Teams[i].Name = ev.Name
+	case ChangedLeagueSettings:
+		lv.Settings = ev.Settings
+	case AdvancedLeaguePhase:
+		lv.Phase = ev.Phase
+	case IssuedLeagueInvite:
+		lv.Invites = append(lv.Invites, LeagueInvite{
+			InviteID:  ev.InviteID,
+			TokenHash: ev.TokenHash,
+			ExpiresAt: ev.ExpiresAt,
+			SingleUse: ev.SingleUse,
+		})
+	case RedeemedLeagueInvite:
+		i := lv.inviteIndex(ev.InviteID)
+		if i < 0 {
+			panic(fmt.Errorf("%w: invite %v, league %v", ErrInviteNotFound, ev.InviteID, lv.LeagueID))
+		}
+		lv.Invites[i].Redemptions++
+	case RecordedMatchupResult:
+		lv.Results = append(lv.Results, ev.Result)
+	case GeneratedPlayoffBracket:
+		lv.Bracket = PlayoffBracket{
+			Settings: ev.Settings,
+			Seeds:    ev.Seeds,
+		}
+	case RecordedPlayoffResult:
+		lv.Bracket.Results = append(lv.Bracket.Results, PlayoffResult{
+			Matchup:   ev.Matchup,
+			HighScore: ev.HighScore,
+			LowScore:  ev.LowScore,
+			Winner:    ev.Winner,
+		})
+	case RevokedLeagueInvite:
+		i := lv.inviteIndex(ev.InviteID)
+		if i < 0 {
+			panic(fmt.Errorf("%w: invite %v, league %v", ErrInviteNotFound, ev.InviteID, lv.LeagueID))
+		}
+		lv.Invites[i].Revoked = true
+	default:
+		panic(fmt.Errorf("%w: %T", ErrUnrecognizedLeagueEvent, event))
+	}
+}
+
+// validateTeamName trims name and checks that no other team in the league uses
+// it, ignoring case.
+func (lv LeagueView) validateTeamName(team TeamID, name string) (string, error) {
+	name, err := cleanName(name)
+	if err != nil {
+		return "", err
+	}
+
+	for _, t := range lv.Teams {
+		if t.TeamID != team && strings.EqualFold(t.Name, name) {
+			return "", fmt.Errorf("%w: %q", ErrDuplicateTeamName, name)
+		}
+	}
+
+	return name, nil
+}
+
+const MaxNameLength = 60
+
+func cleanName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len([]rune(name)) > MaxNameLength {
+		return "", fmt.Errorf("%w: names must be 1 to %d characters", ErrInvalidName, MaxNameLength)
+	}
+
+	return name, nil
+}
@@ -0,0 +1,418 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+// leagueInPhase returns LeagueA with TeamA and TeamB, advanced to phase.
+func leagueInPhase(phase domain.LeaguePhase) domain.LeagueView {
+	lv := domain.LeagueView{LeagueID: testkit.LeagueA()}
+	for _, ev := range testkit.LeagueHistory(phase) {
+		lv.Apply(ev)
+	}
+
+	return lv
+}
+
+func TestLeaguePhaseAllowsRosterMoves(t *testing.T) {
+	assert.False(t, domain.PhasePreDraft.AllowsRosterMoves())
+	assert.False(t, domain.PhaseDrafting.AllowsRosterMoves())
+	assert.True(t, domain.PhaseInSeason.AllowsRosterMoves())
+	assert.True(t, domain.PhasePlayoffs.AllowsRosterMoves())
+	assert.False(t, domain.PhaseComplete.AllowsRosterMoves())
+}
+
+func TestDecideCreate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		view     domain.LeagueView
+		league   string
+		settings domain.LeagueSettings
+		wantErr  error
+	}{
+		{
+			name:     "create a league with trimmed name",
+			view:     domain.LeagueView{LeagueID: testkit.LeagueA()},
+			league:   "  Flushing Meadows ",
+			settings: domain.DefaultLeagueSettings(),
+		},
+		{
+			name:     "reject creating a league twice",
+			view:     leagueInPhase(domain.PhasePreDraft),
+			league:   "Flushing Meadows",
+			settings: domain.DefaultLeagueSettings(),
+			wantErr:  domain.ErrLeagueAlreadyCreated,
+		},
+		{
+			name:     "reject a blank name",
+			view:     domain.LeagueView{LeagueID: testkit.LeagueA()},
+			league:   "   ",
+			settings: domain.DefaultLeagueSettings(),
+			wantErr:  domain.ErrInvalidName,
+		},
+		{
+			name:     "reject a league too small to play",
+			view:     domain.LeagueView{LeagueID: testkit.LeagueA()},
+			league:   "Flushing Meadows",
+			settings: domain.LeagueSettings{MaxTeams: 1},
+			wantErr:  domain.ErrInvalidLeagueSettings,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			events, err := tc.view.DecideCreate(tc.league, testkit.Commissioner(), tc.settings, testkit.TodayLock())
+
+			if tc.wantErr != nil {
+				assert.Nil(t, events)
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, events, []domain.LeagueEvent{
+				domain.CreatedLeague{
+					LeagueID:     testkit.LeagueA(),
+					Name:         "Flushing Meadows",
+					Commissioner: testkit.Commissioner(),
+					Settings:     tc.settings,
+					CreatedAt:    testkit.TodayLock(),
+				},
+			})
+		})
+	}
+}
+
+func TestDecideJoin(t *testing.T) {
+	full := leagueInPhase(domain.PhasePreDraft)
+	full.Settings.MaxTeams = 2
+
+	newcomer := domain.UserID(33)
+
+	testCases := []struct {
+		name     string
+		view     domain.LeagueView
+		teamName string
+		manager  domain.UserID
+		wantErr  error
+	}{
+		{
+			name:     "join a league before the draft",
+			view:     leagueInPhase(domain.PhasePreDraft),
+			teamName: "Team C",
+			manager:  newcomer,
+		},
+		{
+			name:     "reject joining once the draft starts",
+			view:     leagueInPhase(domain.PhaseDrafting),
+			teamName: "Team C",
+			manager:  newcomer,
+			wantErr:  domain.ErrLeagueClosedToTeams,
+		},
+		{
+			name:     "reject joining a league that was never created",
+			view:     domain.LeagueView{LeagueID: testkit.LeagueA()},
+			teamName: "Team C",
+			manager:  newcomer,
+			wantErr:  domain.ErrLeagueClosedToTeams,
+		},
+		{
+			name:     "reject joining a full league",
+			view:     full,
+			teamName: "Team C",
+			manager:  newcomer,
+			wantErr:  domain.ErrLeagueFull,
+		},
+		{
+			name:     "reject a second team for the same manager",
+			view:     leagueInPhase(domain.PhasePreDraft),
+			teamName: "Team C",
+			manager:  testkit.ManagerA(),
+			wantErr:  domain.ErrManagerAlreadyInLeague,
+		},
+		{
+			name:     "reject a name already taken, ignoring case",
+			view:     leagueInPhase(domain.PhasePreDraft),
+			teamName: "team a",
+			manager:  newcomer,
+			wantErr:  domain.ErrDuplicateTeamName,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			events, err := tc.view.DecideJoin(testkit.TeamC(), tc.teamName, tc.manager, testkit.TodayLock())
+
+			if tc.wantErr != nil {
+				assert.Nil(t, events)
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, events, []domain.LeagueEvent{
+				domain.JoinedLeague{
+					LeagueID: testkit.LeagueA(),
+					TeamID:   testkit.TeamC(),
+					TeamName: tc.teamName,
+					Manager:  tc.manager,
+					JoinedAt: testkit.TodayLock(),
+				},
+			})
+		})
+	}
+}
+
+func TestDecideRenameTeam(t *testing.T) {
+	testCases := []struct {
+		name    string
+		teamID  domain.TeamID
+		newName string
+		wantErr error
+	}{
+		{
+			name:    "rename a team",
+			teamID:  testkit.TeamA(),
+			newName: "Amazins",
+		},
+		{
+			name:    "a team may change the case of its own name",
+			teamID:  testkit.TeamA(),
+			newName: "TEAM A",
+		},
+		{
+			name:    "reject another team's name",
+			teamID:  testkit.TeamA(),
+			newName: "Team B",
+			wantErr: domain.ErrDuplicateTeamName,
+		},
+		{
+			name:    "reject a name that is too long",
+			teamID:  testkit.TeamA(),
+			newName: strings.Repeat("a", domain.MaxNameLength+1),
+			wantErr: domain.ErrInvalidName,
+		},
+		{
+			name:    "reject a team outside the league",
+			teamID:  testkit.TeamC(),
+			newName: "Amazins",
+			wantErr: domain.ErrTeamNotInLeague,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			view := leagueInPhase(domain.PhaseInSeason)
+
+			events, err := view.DecideRenameTeam(tc.teamID, tc.newName, testkit.TodayLock())
+
+			if tc.wantErr != nil {
+				assert.Nil(t, events)
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, len(events), 1)
+
+			view.Apply(events[0])
+			team, ok := view.Team(tc.teamID)
+			require.True(t, ok)
+			assert.Equal(t, team.Name, tc.newName)
+		})
+	}
+}
+
+func TestDecideChangeSettings(t *testing.T) {
+	testCases := []struct {
+		name     string
+		view     domain.LeagueView
+		settings domain.LeagueSettings
+		wantErr  error
+	}{
+		{
+			name:     "change settings before the draft",
+			view:     leagueInPhase(domain.PhasePreDraft),
+			settings: domain.LeagueSettings{MaxTeams: 10},
+		},
+		{
+			name:     "reject settings once the draft starts",
+			view:     leagueInPhase(domain.PhaseDrafting),
+			settings: domain.LeagueSettings{MaxTeams: 10},
+			wantErr:  domain.ErrLeagueSettingsLocked,
+		},
+		{
+			name:     "reject a negative veto threshold",
+			view:     leagueInPhase(domain.PhasePreDraft),
+			settings: domain.LeagueSettings{MaxTeams: 10, TradeReview: domain.TradeReviewPolicy{VetoVotesRequired: -1}},
+			wantErr:  domain.ErrInvalidLeagueSettings,
+		},
+		{
+			name: "change transaction limits with a matchup calendar",
+			view: leagueInPhase(domain.PhasePreDraft),
+			settings: domain.LeagueSettings{
+				MaxTeams:     10,
+				Calendar:     domain.MatchupCalendar{SeasonStart: testkit.TomorrowLock(), PeriodDays: 7},
+				Transactions: domain.TransactionLimits{AddsPerPeriod: 4, AddsPerSeason: 50, LineupMovesPerPeriod: 2},
+			},
+		},
+		{
+			name:     "reject per-period limits without a matchup calendar",
+			view:     leagueInPhase(domain.PhasePreDraft),
+			settings: domain.LeagueSettings{MaxTeams: 10, Transactions: domain.TransactionLimits{AddsPerPeriod: 4}},
+			wantErr:  domain.ErrInvalidLeagueSettings,
+		},
+		{
+			name:     "reject a negative season add limit",
+			view:     leagueInPhase(domain.PhasePreDraft),
+			settings: domain.LeagueSettings{MaxTeams: 10, Transactions: domain.TransactionLimits{AddsPerSeason: -1}},
+			wantErr:  domain.ErrInvalidLeagueSettings,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			events, err := tc.view.DecideChangeSettings(tc.settings, testkit.TodayLock())
+
+			if tc.wantErr != nil {
+				assert.Nil(t, events)
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, events, []domain.LeagueEvent{
+				domain.ChangedLeagueSettings{
+					LeagueID:  testkit.LeagueA(),
+					Settings:  tc.settings,
+					ChangedAt: testkit.TodayLock(),
+				},
+			})
+		})
+	}
+
+	t.Run("reject fewer team slots than teams already joined", func(t *testing.T) {
+		view := leagueInPhase(domain.PhasePreDraft)
+		view.Teams = append(view.Teams, domain.LeagueTeam{TeamID: testkit.TeamC(), Name: "Team C", Manager: 33})
+
+		events, err := view.DecideChangeSettings(domain.LeagueSettings{MaxTeams: 2}, testkit.TodayLock())
+
+		assert.Nil(t, events)
+		assert.ErrorIs(t, err, domain.ErrInvalidLeagueSettings)
+	})
+}
+
+func TestDecideAdvancePhase(t *testing.T) {
+	alone := domain.LeagueView{LeagueID: testkit.LeagueA()}
+	alone.Apply(testkit.LeagueHistory(domain.PhasePreDraft)[0])
+
+	bigBracket := leagueInPhase(domain.PhasePreDraft)
+	bigBracket.Settings.Playoffs = domain.PlayoffSettings{Teams: 4}
+
+	testCases := []struct {
+		name    string
+		view    domain.LeagueView
+		phase   domain.LeaguePhase
+		wantErr error
+	}{
+		{
+			name:  "start the draft",
+			view:  leagueInPhase(domain.PhasePreDraft),
+			phase: domain.PhaseDrafting,
+		},
+		{
+			name:  "start the playoffs",
+			view:  leagueInPhase(domain.PhaseInSeason),
+			phase: domain.PhasePlayoffs,
+		},
+		{
+			name:  "complete the season",
+			view:  leagueInPhase(domain.PhasePlayoffs),
+			phase: domain.PhaseComplete,
+		},
+		{
+			name:    "reject skipping a phase",
+			view:    leagueInPhase(domain.PhasePreDraft),
+			phase:   domain.PhaseInSeason,
+			wantErr: domain.ErrInvalidPhaseTransition,
+		},
+		{
+			name:    "reject moving backward",
+			view:    leagueInPhase(domain.PhaseInSeason),
+			phase:   domain.PhaseDrafting,
+			wantErr: domain.ErrInvalidPhaseTransition,
+		},
+		{
+			name:    "reject advancing past complete",
+			view:    leagueInPhase(domain.PhaseComplete),
+			phase:   domain.PhaseComplete + 1,
+			wantErr: domain.ErrInvalidPhaseTransition,
+		},
+		{
+			name:    "reject advancing a league that was never created",
+			view:    domain.LeagueView{LeagueID: testkit.LeagueA()},
+			phase:   domain.PhasePreDraft,
+			wantErr: domain.ErrInvalidPhaseTransition,
+		},
+		{
+			name:    "reject drafting without enough teams",
+			view:    alone,
+			phase:   domain.PhaseDrafting,
+			wantErr: domain.ErrNotEnoughTeams,
+		},
+		{
+			name:    "reject drafting with too few teams to fill the bracket",
+			view:    bigBracket,
+			phase:   domain.PhaseDrafting,
+			wantErr: domain.ErrNotEnoughTeams,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			events, err := tc.view.DecideAdvancePhase(tc.phase, testkit.TodayLock())
+
+			if tc.wantErr != nil {
+				assert.Nil(t, events)
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, events, []domain.LeagueEvent{
+				domain.AdvancedLeaguePhase{
+					LeagueID:   testkit.LeagueA(),
+					Phase:      tc.phase,
+					AdvancedAt: testkit.TodayLock(),
+				},
+			})
+		})
+	}
+}
+
+func TestLeagueViewApply(t *testing.T) {
+	lv := leagueInPhase(domain.PhaseInSeason)
+
+	assert.Equal(t, lv.Name, "Flushing Meadows")
+	assert.Equal(t, lv.Commissioner, testkit.Commissioner())
+	assert.Equal(t, lv.Phase, domain.PhaseInSeason)
+	assert.Equal(t, len(lv.Teams), 2)
+
+	fn := func() { lv.Apply(domain.AdvancedLeaguePhase{LeagueID: 2, Phase: domain.PhasePlayoffs}) }
+
+	err := require.PanicsError(t, fn)
+	require.ErrorIs(t, err, domain.ErrWrongLeagueID)
+
+	fn = func() {
+		lv.Apply(domain.RenamedTeam{LeagueID: testkit.LeagueA(), TeamID: testkit.TeamC(), Name: "Team C"})
+	}
+
+	err = require.PanicsError(t, fn)
+	require.ErrorIs(t, err, domain.ErrTeamNotInLeague)
+}
@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MembershipRole is a user's standing in a league. Managers and co-managers hold
+// it for one team; commissioners hold it for the whole league.
+type MembershipRole int
+
+const (
+	MembershipManager MembershipRole = iota + 1
+	MembershipCoManager
+	MembershipCommissioner
+)
+
+func (r MembershipRole) String() string {
+	switch r {
+	case MembershipManager:
+		return "MembershipManager"
+	case MembershipCoManager:
+		return "MembershipCoManager"
+	case MembershipCommissioner:
+		return "MembershipCommissioner"
+	default:
+		return fmt.Sprintf("MembershipRole(%d)", int(r))
+	}
+}
+
+// Code returns the lowercase name used for the role in storage.
+func (r MembershipRole) Code() string {
+	switch r {
+	case MembershipManager:
+		return "manager"
+	case MembershipCoManager:
+		return "co_manager"
+	case MembershipCommissioner:
+		return "commissioner"
+	default:
+		return ""
+	}
+}
+
+// ParseMembershipRole converts a role code, ignoring case and surrounding space.
+func ParseMembershipRole(code string) (MembershipRole, error) {
+	switch strings.ToLower(strings.TrimSpace(code)) {
+	case "manager":
+		return MembershipManager, nil
+	case "co_manager":
+		return MembershipCoManager, nil
+	case "commissioner":
+		return MembershipCommissioner, nil
+	default:
+		return 0, fmt.Errorf("%w: %q", ErrUnrecognizedMembershipRole, code)
+	}
+}
+
+// Membership links a user to a league, and for team roles to one of its teams.
+// Commissioner memberships have a zero TeamID.
+type Membership struct {
+	UserID   UserID
+	LeagueID LeagueID
+	TeamID   TeamID
+	Role     MembershipRole
+}
+
+func (m Membership) Validate() error {
+	switch m.Role {
+	case MembershipManager, MembershipCoManager:
+		if m.TeamID == 0 {
+			return fmt.Errorf("%w: %v requires a team", ErrInvalidMembership, m.Role)
+		}
+	case MembershipCommissioner:
+		if m.TeamID != 0 {
+			return fmt.Errorf("%w: %v cannot hold a team", ErrInvalidMembership, m.Role)
+		}
+	default:
+		return fmt.Errorf("%w: %v", ErrUnrecognizedMembershipRole, m.Role)
+	}
+
+	if m.UserID == 0 || m.LeagueID == 0 {
+		return fmt.Errorf("%w: user and league are required", ErrInvalidMembership)
+	}
+
+	return nil
+}
+
+// Memberships are every membership a single user holds.
+type Memberships []Membership
+
+// CanManageRoster reports whether the user may change team's roster. Managers
+// and co-managers may change their own team's; commissioners may change any
+// roster in their league.
+func (ms Memberships) CanManageRoster(league LeagueID, team TeamID) bool {
+	for _, m := range ms {
+		switch m.Role {
+		case MembershipManager, MembershipCoManager:
+			if m.TeamID == team {
+				return true
+			}
+		case MembershipCommissioner:
+			if m.LeagueID == league {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ManagesTeam reports whether the user manages or co-manages team. Unlike
+// CanManageRoster it leaves out commissioners, who do not act for a team.
+func (ms Memberships) ManagesTeam(team TeamID) bool {
+	for _, m := range ms {
+		if (m.Role == MembershipManager || m.Role == MembershipCoManager) && m.TeamID == team {
+			return true
+		}
+	}
+
+	return false
+}
+
+// InLeague reports whether the user holds any membership in league.
+func (ms Memberships) InLeague(league LeagueID) bool {
+	for _, m := range ms {
+		if m.LeagueID == league {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsCommissioner reports whether the user is a commissioner of league.
+func (ms Memberships) IsCommissioner(league LeagueID) bool {
+	for _, m := range ms {
+		if m.Role == MembershipCommissioner && m.LeagueID == league {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,143 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+)
+
+func TestMemberships_CanManageRoster(t *testing.T) {
+	const (
+		league      = domain.LeagueID(1)
+		otherLeague = domain.LeagueID(2)
+		team        = domain.TeamID(111)
+		otherTeam   = domain.TeamID(222)
+	)
+
+	testCases := []struct {
+		name        string
+		memberships domain.Memberships
+		want        bool
+	}{
+		{
+			name:        "manager of the team",
+			memberships: domain.Memberships{{UserID: 1, LeagueID: league, TeamID: team, Role: domain.MembershipManager}},
+			want:        true,
+		},
+		{
+			name:        "co-manager of the team",
+			memberships: domain.Memberships{{UserID: 1, LeagueID: league, TeamID: team, Role: domain.MembershipCoManager}},
+			want:        true,
+		},
+		{
+			name:        "manager of another team in the league",
+			memberships: domain.Memberships{{UserID: 1, LeagueID: league, TeamID: otherTeam, Role: domain.MembershipManager}},
+			want:        false,
+		},
+		{
+			name:        "commissioner of the league",
+			memberships: domain.Memberships{{UserID: 1, LeagueID: league, Role: domain.MembershipCommissioner}},
+			want:        true,
+		},
+		{
+			name:        "commissioner of another league",
+			memberships: domain.Memberships{{UserID: 1, LeagueID: otherLeague, Role: domain.MembershipCommissioner}},
+			want:        false,
+		},
+		{
+			name:        "no memberships",
+			memberships: nil,
+			want:        false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.memberships.CanManageRoster(league, team), tc.want)
+		})
+	}
+}
+
+func TestMemberships_ManagesTeam(t *testing.T) {
+	ms := domain.Memberships{
+		{UserID: 1, LeagueID: 1, TeamID: 111, Role: domain.MembershipCoManager},
+		{UserID: 1, LeagueID: 2, Role: domain.MembershipCommissioner},
+	}
+
+	assert.True(t, ms.ManagesTeam(111))
+	assert.False(t, ms.ManagesTeam(222))
+	assert.False(t, ms.ManagesTeam(0))
+}
+
+func TestMemberships_InLeague(t *testing.T) {
+	ms := domain.Memberships{
+		{UserID: 1, LeagueID: 1, TeamID: 111, Role: domain.MembershipManager},
+		{UserID: 1, LeagueID: 3, Role: domain.MembershipCommissioner},
+	}
+
+	assert.True(t, ms.InLeague(1))
+	assert.True(t, ms.InLeague(3))
+	assert.False(t, ms.InLeague(2))
+	assert.False(t, domain.Memberships(nil).InLeague(1))
+}
+
+func TestMembership_Validate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		membership domain.Membership
+		wantErr    error
+	}{
+		{
+			name:       "manager with a team is valid",
+			membership: domain.Membership{UserID: 1, LeagueID: 1, TeamID: 111, Role: domain.MembershipManager},
+		},
+		{
+			name:       "commissioner without a team is valid",
+			membership: domain.Membership{UserID: 1, LeagueID: 1, Role: domain.MembershipCommissioner},
+		},
+		{
+			name:       "manager without a team is invalid",
+			membership: domain.Membership{UserID: 1, LeagueID: 1, Role: domain.MembershipManager},
+			wantErr:    domain.ErrInvalidMembership,
+		},
+		{
+			name:       "commissioner with a team is invalid",
+			membership: domain.Membership{UserID: 1, LeagueID: 1, TeamID: 111, Role: domain.MembershipCommissioner},
+			wantErr:    domain.ErrInvalidMembership,
+		},
+		{
+			name:       "missing league is invalid",
+			membership: domain.Membership{UserID: 1, TeamID: 111, Role: domain.MembershipCoManager},
+			wantErr:    domain.ErrInvalidMembership,
+		},
+		{
+			name:       "unknown role is invalid",
+			membership: domain.Membership{UserID: 1, LeagueID: 1, TeamID: 111},
+			wantErr:    domain.ErrUnrecognizedMembershipRole,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.membership.Validate()
+
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseMembershipRole(t *testing.T) {
+	for _, role := range []domain.MembershipRole{domain.MembershipManager, domain.MembershipCoManager, domain.MembershipCommissioner} {
+		got, err := domain.ParseMembershipRole(role.Code())
+		assert.NoError(t, err)
+		assert.Equal(t, got, role)
+	}
+
+	_, err := domain.ParseMembershipRole("owner")
+	assert.ErrorIs(t, err, domain.ErrUnrecognizedMembershipRole)
+}
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NotificationChannel is a way of reaching a user. Users are reached on every
+// channel they have not turned off.
+type NotificationChannel int
+
+const (
+	ChannelInApp NotificationChannel = iota + 1
+	ChannelEmail
+)
+
+func (c NotificationChannel) String() string {
+	switch c {
+	case ChannelInApp:
+		return "ChannelInApp"
+	case ChannelEmail:
+		return "ChannelEmail"
+	default:
+		return fmt.Sprintf("NotificationChannel(%d)", int(c))
+	}
+}
+
+// Code returns the lowercase name used for the channel in storage.
+func (c NotificationChannel) Code() string {
+	switch c {
+	case ChannelInApp:
+		return "in_app"
+	case ChannelEmail:
+		return "email"
+	default:
+		return ""
+	}
+}
+
+// ParseNotificationChannel converts a channel code, ignoring case and surrounding
+// space.
+func ParseNotificationChannel(code string) (NotificationChannel, error) {
+	switch strings.ToLower(strings.TrimSpace(code)) {
+	case "in_app":
+		return ChannelInApp, nil
+	case "email":
+		return ChannelEmail, nil
+	default:
+		return 0, fmt.Errorf("%w: %q", ErrUnrecognizedNotificationChannel, code)
+	}
+}
+
+// NotificationKind names what a notification is about.
+type NotificationKind int
+
+const (
+	NotifyCommissionerDrop NotificationKind = iota + 1
+	NotifyWaiverClaimWon
+	NotifyTradeOffer
+)
+
+func (k NotificationKind) String() string {
+	switch k {
+	case NotifyCommissionerDrop:
+		return "NotifyCommissionerDrop"
+	case NotifyWaiverClaimWon:
+		return "NotifyWaiverClaimWon"
+	case NotifyTradeOffer:
+		return "NotifyTradeOffer"
+	default:
+		return fmt.Sprintf("NotificationKind(%d)", int(k))
+	}
+}
+
+// Code returns the lowercase name used for the kind in storage.
+func (k NotificationKind) Code() string {
+	switch k {
+	case NotifyCommissionerDrop:
+		return "commissioner_drop"
+	case NotifyWaiverClaimWon:
+		return "waiver_claim_won"
+	case NotifyTradeOffer:
+		return "trade_offer"
+	default:
+		return ""
+	}
+}
+
+// ParseNotificationKind converts a kind code, ignoring case and surrounding space.
+func ParseNotificationKind(code string) (NotificationKind, error) {
+	switch strings.ToLower(strings.TrimSpace(code)) {
+	case "commissioner_drop":
+		return NotifyCommissionerDrop, nil
+	case "waiver_claim_won":
+		return NotifyWaiverClaimWon, nil
+	case "trade_offer":
+		return NotifyTradeOffer, nil
+	default:
+		return 0, fmt.Errorf("%w: %q", ErrUnrecognizedNotificationKind, code)
+	}
+}
@@ -0,0 +1,30 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+)
+
+func TestParseNotificationChannel(t *testing.T) {
+	for _, channel := range []domain.NotificationChannel{domain.ChannelInApp, domain.ChannelEmail} {
+		got, err := domain.ParseNotificationChannel(channel.Code())
+		assert.NoError(t, err)
+		assert.Equal(t, got, channel)
+	}
+
+	_, err := domain.ParseNotificationChannel("pager")
+	assert.ErrorIs(t, err, domain.ErrUnrecognizedNotificationChannel)
+}
+
+func TestParseNotificationKind(t *testing.T) {
+	for _, kind := range []domain.NotificationKind{domain.NotifyCommissionerDrop, domain.NotifyWaiverClaimWon, domain.NotifyTradeOffer} {
+		got, err := domain.ParseNotificationKind(kind.Code())
+		assert.NoError(t, err)
+		assert.Equal(t, got, kind)
+	}
+
+	_, err := domain.ParseNotificationKind("waiver_lost")
+	assert.ErrorIs(t, err, domain.ErrUnrecognizedNotificationKind)
+}
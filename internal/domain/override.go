@@ -0,0 +1,24 @@
+package domain
+
+import "fmt"
+
+type OverrideKind int
+
+const (
+	OverrideReversal OverrideKind = iota + 1
+	OverrideForcedAdd
+	OverrideForcedRemove
+)
+
+func (k OverrideKind) String() string {
+	switch k {
+	case OverrideReversal:
+		return "OverrideReversal"
+	case OverrideForcedAdd:
+		return "OverrideForcedAdd"
+	case OverrideForcedRemove:
+		return "OverrideForcedRemove"
+	default:
+		return fmt.Sprintf("OverrideKind(%d)", int(k))
+	}
+}
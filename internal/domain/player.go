@@ -1,6 +1,9 @@
 package domain
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type PlayerRole int
 
@@ -20,12 +23,173 @@ func (s PlayerRole) String() string {
 	}
 }
 
+// Code returns the lowercase name used for the role in imports and storage.
+func (s PlayerRole) Code() string {
+	switch s {
+	case RoleHitter:
+		return "hitter"
+	case RolePitcher:
+		return "pitcher"
+	default:
+		return ""
+	}
+}
+
+// ParsePlayerRole converts a role code, ignoring case and surrounding space.
+func ParsePlayerRole(code string) (PlayerRole, error) {
+	switch strings.ToLower(strings.TrimSpace(code)) {
+	case "hitter":
+		return RoleHitter, nil
+	case "pitcher":
+		return RolePitcher, nil
+	default:
+		return 0, fmt.Errorf("%w: %q", ErrUnrecognizedPlayerRole, code)
+	}
+}
+
+// RoleSet is the set of roles a player is eligible to be activated in.
+// Two-way players are eligible as both a hitter and a pitcher.
+type RoleSet uint8
+
+// allRoles holds every recognized role.
+var allRoles = MustRoleSet(RoleHitter, RolePitcher)
+
+// NewRoleSet returns the set of roles, or ErrUnrecognizedPlayerRole if any of
+// them is not a recognized role.
+func NewRoleSet(roles ...PlayerRole) (RoleSet, error) {
+	var rs RoleSet
+	for _, r := range roles {
+		if r.Code() == "" {
+			return 0, fmt.Errorf("%w: %v", ErrUnrecognizedPlayerRole, r)
+		}
+		rs |= 1 << r
+	}
+
+	return rs, nil
+}
+
+// MustRoleSet is NewRoleSet for roles known to be recognized, such as the
+// declared constants.
+//
+// Panics if a role is not recognized.
+func MustRoleSet(roles ...PlayerRole) RoleSet {
+	rs, err := NewRoleSet(roles...)
+	if err != nil {
+		panic(err)
+	}
+
+	return rs
+}
+
+// Has reports whether role is in the set.
+func (rs RoleSet) Has(role PlayerRole) bool {
+	return role.Code() != "" && rs&(1<<role) != 0
+}
+
+func (rs RoleSet) IsEmpty() bool {
+	return rs == 0
+}
+
+// Roles returns the recognized roles in the set, in declaration order.
+func (rs RoleSet) Roles() []PlayerRole {
+	var roles []PlayerRole
+	for _, r := range []PlayerRole{RoleHitter, RolePitcher} {
+		if rs.Has(r) {
+			roles = append(roles, r)
+		}
+	}
+
+	return roles
+}
+
+// Codes returns the role codes in the set, in declaration order.
+func (rs RoleSet) Codes() []string {
+	roles := rs.Roles()
+	codes := make([]string, len(roles))
+	for i, r := range roles {
+		codes[i] = r.Code()
+	}
+
+	return codes
+}
+
+func (rs RoleSet) String() string {
+	return "RoleSet{" + strings.Join(rs.Codes(), ", ") + "}"
+}
+
+// valid reports whether the set is non-empty and holds only recognized roles.
+func (rs RoleSet) valid() bool {
+	return !rs.IsEmpty() && rs&^allRoles == 0
+}
+
+// ParseRoleSet converts a list of role codes, such as "hitter" or
+// "hitter/pitcher", into a RoleSet. Codes may be separated by "/" or ",".
+func ParseRoleSet(codes string) (RoleSet, error) {
+	fields := strings.FieldsFunc(codes, func(r rune) bool {
+		return r == '/' || r == ','
+	})
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("%w: %q", ErrUnrecognizedPlayerRole, codes)
+	}
+
+	return parseRoleCodes(fields)
+}
+
+// ParseRoleCodes converts role codes, as returned by RoleSet.Codes, into a RoleSet.
+func ParseRoleCodes(codes []string) (RoleSet, error) {
+	if len(codes) == 0 {
+		return 0, fmt.Errorf("%w: no roles", ErrUnrecognizedPlayerRole)
+	}
+
+	return parseRoleCodes(codes)
+}
+
+func parseRoleCodes(codes []string) (RoleSet, error) {
+	var rs RoleSet
+	for _, code := range codes {
+		role, err := ParsePlayerRole(code)
+		if err != nil {
+			return 0, err
+		}
+		rs |= MustRoleSet(role)
+	}
+
+	return rs, nil
+}
+
 type PlayerID int
 type MLBPlayerID int
 
+// Position is a player's listed fielding position, such as "SS" or "SP".
+type Position string
+
 type Player struct {
-	ID    PlayerID
-	MLBID MLBPlayerID
-	Name  string
-	Role  PlayerRole
+	ID       PlayerID
+	MLBID    MLBPlayerID
+	Name     string
+	Roles    RoleSet
+	Position Position
+	MLBTeam  string
+}
+
+// Validate checks the fields a catalog entry needs before it can be stored.
+func (p Player) Validate() error {
+	if p.MLBID <= 0 {
+		return fmt.Errorf("%w: MLB ID %v", ErrInvalidPlayer, p.MLBID)
+	}
+
+	if strings.TrimSpace(p.Name) == "" {
+		return fmt.Errorf("%w: MLB ID %v has no name", ErrInvalidPlayer, p.MLBID)
+	}
+
+	if !p.Roles.valid() {
+		return fmt.Errorf("%w: MLB ID %v: %w", ErrInvalidPlayer, p.MLBID, ErrUnrecognizedPlayerRole)
+	}
+
+	return nil
+}
+
+// EligibleFor reports whether the player may be activated in role.
+func (p Player) EligibleFor(role PlayerRole) bool {
+	return p.Roles.Has(role)
 }
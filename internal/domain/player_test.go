@@ -0,0 +1,105 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+)
+
+func TestNewRoleSet(t *testing.T) {
+	testCases := []struct {
+		name    string
+		roles   []domain.PlayerRole
+		want    []string
+		wantErr error
+	}{
+		{
+			name:  "holds each role given",
+			roles: []domain.PlayerRole{domain.RolePitcher, domain.RoleHitter},
+			want:  []string{"hitter", "pitcher"},
+		},
+		{
+			name: "no roles is the empty set",
+			want: []string{},
+		},
+		{
+			name:    "rejects the zero role",
+			roles:   []domain.PlayerRole{0},
+			wantErr: domain.ErrUnrecognizedPlayerRole,
+		},
+		{
+			name:    "rejects an undeclared role",
+			roles:   []domain.PlayerRole{domain.RoleHitter, 9},
+			wantErr: domain.ErrUnrecognizedPlayerRole,
+		},
+		{
+			name:    "rejects a negative role",
+			roles:   []domain.PlayerRole{-1},
+			wantErr: domain.ErrUnrecognizedPlayerRole,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := domain.NewRoleSet(tc.roles...)
+
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, got.Codes(), tc.want)
+			} else {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.True(t, got.IsEmpty())
+			}
+		})
+	}
+}
+
+func TestParseRoleSet(t *testing.T) {
+	testCases := []struct {
+		name    string
+		codes   string
+		want    domain.RoleSet
+		wantErr error
+	}{
+		{
+			name:  "parses a single role",
+			codes: "pitcher",
+			want:  domain.MustRoleSet(domain.RolePitcher),
+		},
+		{
+			name:  "parses a two-way player separated by a slash",
+			codes: "hitter/pitcher",
+			want:  domain.MustRoleSet(domain.RoleHitter, domain.RolePitcher),
+		},
+		{
+			name:  "parses roles separated by a comma, ignoring case and space",
+			codes: " Pitcher , HITTER ",
+			want:  domain.MustRoleSet(domain.RoleHitter, domain.RolePitcher),
+		},
+		{
+			name:    "rejects an empty list",
+			codes:   "",
+			wantErr: domain.ErrUnrecognizedPlayerRole,
+		},
+		{
+			name:    "rejects an unknown role in the list",
+			codes:   "hitter/closer",
+			wantErr: domain.ErrUnrecognizedPlayerRole,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := domain.ParseRoleSet(tc.codes)
+
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, got, tc.want)
+				assert.Equal(t, got.Codes(), tc.want.Codes())
+			} else {
+				assert.ErrorIs(t, err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,410 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// PlayoffSettings shape the bracket generated when the regular season ends.
+//
+// The top Byes seeds skip the opening round, whose winners join them in a
+// standard single-elimination bracket, so Byes plus half the remaining teams
+// must be a power of two. A zero Teams means the league has no bracket.
+type PlayoffSettings struct {
+	Teams       int
+	Byes        int
+	ThirdPlace  bool
+	Consolation bool
+}
+
+func (s PlayoffSettings) Validate() error {
+	if s.Teams == 0 && s.Byes == 0 && !s.ThirdPlace && !s.Consolation {
+		return nil
+	}
+
+	openers := s.Teams - s.Byes
+	if s.Teams < 2 || s.Byes < 0 || openers < 2 || openers%2 != 0 || !isPowerOfTwo(s.Byes+openers/2) {
+		return fmt.Errorf("%w: %d teams cannot play a bracket with %d byes", ErrInvalidPlayoffSettings, s.Teams, s.Byes)
+	}
+
+	if s.ThirdPlace && !hasSemifinals(s) {
+		return fmt.Errorf("%w: a third-place game needs two semifinals", ErrInvalidPlayoffSettings)
+	}
+
+	if s.Consolation && (openers/2 < 2 || !isPowerOfTwo(openers/2)) {
+		return fmt.Errorf("%w: a consolation bracket needs a power of two opening-round losers", ErrInvalidPlayoffSettings)
+	}
+
+	return nil
+}
+
+type BracketKind int
+
+const (
+	BracketChampionship BracketKind = iota + 1
+	BracketThirdPlace
+	BracketConsolation
+)
+
+func (k BracketKind) String() string {
+	switch k {
+	case BracketChampionship:
+		return "BracketChampionship"
+	case BracketThirdPlace:
+		return "BracketThirdPlace"
+	case BracketConsolation:
+		return "BracketConsolation"
+	default:
+		return fmt.Sprintf("BracketKind(%d)", int(k))
+	}
+}
+
+// MatchupID locates a matchup in a bracket. Rounds count from 1 and slots from 0
+// in bracket order, so the winners of slots 0 and 1 meet in slot 0 of the next
+// round.
+type MatchupID struct {
+	Bracket BracketKind
+	Round   int
+	Slot    int
+}
+
+// Matchup is a playoff game as far as the bracket has progressed. High is the
+// better seed. Teams are zero until the matchups that feed them are decided, and
+// Winner is zero until a result is recorded.
+type Matchup struct {
+	ID        MatchupID
+	High      TeamID
+	Low       TeamID
+	HighScore float64
+	LowScore  float64
+	Winner    TeamID
+}
+
+// Ready reports whether both teams are known.
+func (m Matchup) Ready() bool {
+	return m.High != 0 && m.Low != 0
+}
+
+// Loser returns the team that did not win, or zero if the matchup is undecided.
+func (m Matchup) Loser() TeamID {
+	switch m.Winner {
+	case 0:
+		return 0
+	case m.High:
+		return m.Low
+	default:
+		return m.High
+	}
+}
+
+// PlayoffResult is the head-to-head outcome of one matchup.
+type PlayoffResult struct {
+	Matchup   MatchupID
+	HighScore float64
+	LowScore  float64
+	Winner    TeamID
+}
+
+// PlayoffBracket is fixed by its settings and seeds when generated; everything
+// else about it is derived from the results recorded since.
+type PlayoffBracket struct {
+	Settings PlayoffSettings
+	Seeds    []TeamID
+	Results  []PlayoffResult
+}
+
+// NewPlayoffBracket seeds a bracket from standings, which list the league's
+// teams from first place down. Teams beyond settings.Teams miss the playoffs.
+func NewPlayoffBracket(settings PlayoffSettings, standings []TeamID) (PlayoffBracket, error) {
+	err := settings.Validate()
+	if err != nil {
+		return PlayoffBracket{}, err
+	}
+
+	if len(standings) < settings.Teams {
+		return PlayoffBracket{}, fmt.Errorf("%w: %d teams in standings, %d make the playoffs", ErrInvalidStandings, len(standings), settings.Teams)
+	}
+
+	b := PlayoffBracket{
+		Settings: settings,
+		Seeds:    slices.Clone(standings[:settings.Teams]),
+	}
+
+	return b, nil
+}
+
+// Generated reports whether the bracket has been seeded.
+func (b PlayoffBracket) Generated() bool {
+	return len(b.Seeds) > 0
+}
+
+// Matchups returns every matchup in the bracket, with earlier rounds before the
+// later ones they feed.
+func (b PlayoffBracket) Matchups() []Matchup {
+	specs := bracketLayout(b.Settings)
+	resolved := make(map[MatchupID]Matchup, len(specs))
+	res := make([]Matchup, 0, len(specs))
+
+	for _, spec := range specs {
+		m := Matchup{ID: spec.id}
+		first, second := b.resolve(spec.a, resolved), b.resolve(spec.b, resolved)
+		m.High, m.Low = first, second
+		if first != 0 && second != 0 && b.seedOf(second) < b.seedOf(first) {
+			m.High, m.Low = second, first
+		}
+
+		i := slices.IndexFunc(b.Results, func(r PlayoffResult) bool { return r.Matchup == spec.id })
+		if i >= 0 {
+			m.HighScore = b.Results[i].HighScore
+			m.LowScore = b.Results[i].LowScore
+			m.Winner = b.Results[i].Winner
+		}
+
+		resolved[spec.id] = m
+		res = append(res, m)
+	}
+
+	return res
+}
+
+// Matchup returns the matchup with the given ID, if the bracket has one.
+func (b PlayoffBracket) Matchup(id MatchupID) (Matchup, bool) {
+	for _, m := range b.Matchups() {
+		if m.ID == id {
+			return m, true
+		}
+	}
+
+	return Matchup{}, false
+}
+
+// Champion returns the winner of the championship final, or zero if it has not
+// been decided.
+func (b PlayoffBracket) Champion() TeamID {
+	var champion TeamID
+	for _, m := range b.Matchups() {
+		if m.ID.Bracket == BracketChampionship {
+			champion = m.Winner
+		}
+	}
+
+	return champion
+}
+
+// Complete reports whether every matchup in the bracket has been decided.
+func (b PlayoffBracket) Complete() bool {
+	return !slices.ContainsFunc(b.Matchups(), func(m Matchup) bool { return m.Winner == 0 })
+}
+
+// DecideStartPlayoffs returns the events that move the league into the playoffs
+// and seed its bracket from standings, if allowed. Leagues whose settings have
+// no bracket only change phase.
+func (lv LeagueView) DecideStartPlayoffs(standings []TeamID, at time.Time) ([]LeagueEvent, error) {
+	res, err := lv.DecideAdvancePhase(PhasePlayoffs, at)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := lv.Settings.Playoffs
+	if settings.Teams == 0 {
+		return res, nil
+	}
+
+	if settings.Teams > len(lv.Teams) {
+		return nil, fmt.Errorf("%w: has %d, bracket needs %d", ErrNotEnoughTeams, len(lv.Teams), settings.Teams)
+	}
+
+	for i, id := range standings {
+		if _, ok := lv.Team(id); !ok || slices.Contains(standings[:i], id) {
+			return nil, fmt.Errorf("%w: team %v is not a distinct team in the league", ErrInvalidStandings, id)
+		}
+	}
+
+	bracket, err := NewPlayoffBracket(settings, standings)
+	if err != nil {
+		return nil, err
+	}
+
+	res = append(res, GeneratedPlayoffBracket{
+		LeagueID:    lv.LeagueID,
+		Settings:    bracket.Settings,
+		Seeds:       bracket.Seeds,
+		GeneratedAt: at,
+	})
+
+	return res, nil
+}
+
+// DecideRecordPlayoffResult returns the RecordedPlayoffResult events for a
+// head-to-head result if allowed. The higher score advances, and the better
+// seed wins a tie.
+func (lv LeagueView) DecideRecordPlayoffResult(id MatchupID, highScore, lowScore float64, at time.Time) ([]LeagueEvent, error) {
+	if lv.Phase != PhasePlayoffs || !lv.Bracket.Generated() {
+		return nil, fmt.Errorf("%w: league is in %v", ErrPlayoffsNotStarted, lv.Phase)
+	}
+
+	m, ok := lv.Bracket.Matchup(id)
+	if !ok {
+		return nil, fmt.Errorf("%w: %+v", ErrMatchupNotFound, id)
+	}
+
+	if !m.Ready() {
+		return nil, fmt.Errorf("%w: %+v", ErrMatchupNotReady, id)
+	}
+
+	if m.Winner != 0 {
+		return nil, fmt.Errorf("%w: %+v", ErrMatchupAlreadyDecided, id)
+	}
+
+	winner := m.High
+	if lowScore > highScore {
+		winner = m.Low
+	}
+
+	res := []LeagueEvent{
+		RecordedPlayoffResult{
+			LeagueID:   lv.LeagueID,
+			Matchup:    id,
+			HighScore:  highScore,
+			LowScore:   lowScore,
+			Winner:     winner,
+			RecordedAt: at,
+		},
+	}
+
+	return res, nil
+}
+
+func (b PlayoffBracket) resolve(src bracketSource, resolved map[MatchupID]Matchup) TeamID {
+	switch {
+	case src.seed > 0:
+		return b.Seeds[src.seed-1]
+	case src.loser:
+		return resolved[src.from].Loser()
+	default:
+		return resolved[src.from].Winner
+	}
+}
+
+func (b PlayoffBracket) seedOf(team TeamID) int {
+	return slices.Index(b.Seeds, team)
+}
+
+// bracketSource is where a matchup's team comes from: a seed, or the winner or
+// loser of an earlier matchup.
+type bracketSource struct {
+	seed  int
+	from  MatchupID
+	loser bool
+}
+
+type matchupSpec struct {
+	id   MatchupID
+	a, b bracketSource
+}
+
+// bracketLayout lays out every matchup that valid settings produce. The opening
+// round pairs the seeds after the byes from the outside in; from then on the
+// field is paired in standard seeding order, so the top seeds cannot meet
+// until the last rounds.
+func bracketLayout(s PlayoffSettings) []matchupSpec {
+	if s.Teams == 0 {
+		return nil
+	}
+
+	var specs []matchupSpec
+	openers := (s.Teams - s.Byes) / 2
+
+	opening := make([]MatchupID, openers)
+	for k := range openers {
+		opening[k] = MatchupID{Bracket: BracketChampionship, Round: 1, Slot: k}
+		specs = append(specs, matchupSpec{
+			id: opening[k],
+			a:  bracketSource{seed: s.Byes + 1 + k},
+			b:  bracketSource{seed: s.Teams - k},
+		})
+	}
+
+	field := make([]bracketSource, s.Byes+openers)
+	for i := range field {
+		if i < s.Byes {
+			field[i] = bracketSource{seed: i + 1}
+		} else {
+			field[i] = bracketSource{from: opening[i-s.Byes]}
+		}
+	}
+
+	champ := eliminate(BracketChampionship, 2, inSeedingOrder(field))
+	specs = append(specs, champ...)
+
+	if s.ThirdPlace {
+		final := specs[len(specs)-1]
+		specs = append(specs, matchupSpec{
+			id: MatchupID{Bracket: BracketThirdPlace, Round: final.id.Round, Slot: 0},
+			a:  bracketSource{from: final.a.from, loser: true},
+			b:  bracketSource{from: final.b.from, loser: true},
+		})
+	}
+
+	if s.Consolation {
+		losers := make([]bracketSource, openers)
+		for k, id := range opening {
+			losers[k] = bracketSource{from: id, loser: true}
+		}
+		specs = append(specs, eliminate(BracketConsolation, 2, inSeedingOrder(losers))...)
+	}
+
+	return specs
+}
+
+// eliminate pairs field neighbours round by round, numbering rounds from
+// round, until one team is left.
+func eliminate(kind BracketKind, round int, field []bracketSource) []matchupSpec {
+	var specs []matchupSpec
+	for ; len(field) > 1; round++ {
+		next := make([]bracketSource, 0, len(field)/2)
+		for i := 0; i < len(field); i += 2 {
+			id := MatchupID{Bracket: kind, Round: round, Slot: i / 2}
+			specs = append(specs, matchupSpec{id: id, a: field[i], b: field[i+1]})
+			next = append(next, bracketSource{from: id})
+		}
+		field = next
+	}
+
+	return specs
+}
+
+// inSeedingOrder arranges a power-of-two field, best first, so that neighbours
+// are paired 1 v n, n/2 v n/2+1, and so on.
+func inSeedingOrder(field []bracketSource) []bracketSource {
+	order := []int{1}
+	for len(order) < len(field) {
+		size := len(order) * 2
+		next := make([]int, 0, size)
+		for _, s := range order {
+			next = append(next, s, size+1-s)
+		}
+		order = next
+	}
+
+	res := make([]bracketSource, len(field))
+	for i, s := range order {
+		res[i] = field[s-1]
+	}
+
+	return res
+}
+
+// hasSemifinals reports whether both teams in the championship final come out
+// of earlier matchups rather than a bye straight into it.
+func hasSemifinals(s PlayoffSettings) bool {
+	specs := bracketLayout(PlayoffSettings{Teams: s.Teams, Byes: s.Byes})
+	final := specs[len(specs)-1]
+
+	return final.a.seed == 0 && final.b.seed == 0
+}
+
+func isPowerOfTwo(n int) bool {
+	return n > 0 && n&(n-1) == 0
+}
@@ -0,0 +1,273 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+// seededLeague returns an in-season league of n teams, numbered 1 to n, whose
+// standings are in team order.
+func seededLeague(n int, playoffs domain.PlayoffSettings) (domain.LeagueView, []domain.TeamID) {
+	lv := domain.LeagueView{
+		LeagueID: testkit.LeagueA(),
+		Phase:    domain.PhaseInSeason,
+		Settings: domain.LeagueSettings{MaxTeams: n, Playoffs: playoffs},
+	}
+
+	standings := make([]domain.TeamID, n)
+	for i := range n {
+		standings[i] = domain.TeamID(i + 1)
+		lv.Teams = append(lv.Teams, domain.LeagueTeam{TeamID: standings[i], Manager: domain.UserID(i + 1)})
+	}
+
+	return lv, standings
+}
+
+// startPlayoffs seeds the league's bracket and applies the events.
+func startPlayoffs(t *testing.T, lv domain.LeagueView, standings []domain.TeamID) domain.LeagueView {
+	t.Helper()
+
+	events, err := lv.DecideStartPlayoffs(standings, testkit.TodayLock())
+	require.NoError(t, err)
+	for _, ev := range events {
+		lv.Apply(ev)
+	}
+
+	return lv
+}
+
+// record decides a matchup and applies the result.
+func record(t *testing.T, lv *domain.LeagueView, id domain.MatchupID, high, low float64) {
+	t.Helper()
+
+	events, err := lv.DecideRecordPlayoffResult(id, high, low, testkit.TodayLock())
+	require.NoError(t, err)
+	for _, ev := range events {
+		lv.Apply(ev)
+	}
+}
+
+func champ(round, slot int) domain.MatchupID {
+	return domain.MatchupID{Bracket: domain.BracketChampionship, Round: round, Slot: slot}
+}
+
+type pairing struct {
+	high, low domain.TeamID
+}
+
+func pairings(b domain.PlayoffBracket, kind domain.BracketKind, round int) []pairing {
+	var res []pairing
+	for _, m := range b.Matchups() {
+		if m.ID.Bracket == kind && m.ID.Round == round {
+			res = append(res, pairing{m.High, m.Low})
+		}
+	}
+
+	return res
+}
+
+func TestPlayoffSettingsValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		settings domain.PlayoffSettings
+		wantErr  error
+	}{
+		{name: "no bracket", settings: domain.PlayoffSettings{}},
+		{name: "four teams", settings: domain.PlayoffSettings{Teams: 4, ThirdPlace: true}},
+		{name: "six teams with two byes", settings: domain.PlayoffSettings{Teams: 6, Byes: 2, ThirdPlace: true}},
+		{name: "seven teams with one bye", settings: domain.PlayoffSettings{Teams: 7, Byes: 1}},
+		{name: "eight teams with a consolation bracket", settings: domain.PlayoffSettings{Teams: 8, Consolation: true}},
+		{
+			name:     "six teams without byes cannot pair off",
+			settings: domain.PlayoffSettings{Teams: 6},
+			wantErr:  domain.ErrInvalidPlayoffSettings,
+		},
+		{
+			name:     "every team on a bye leaves no opening round",
+			settings: domain.PlayoffSettings{Teams: 4, Byes: 4},
+			wantErr:  domain.ErrInvalidPlayoffSettings,
+		},
+		{
+			name:     "a single team is not a bracket",
+			settings: domain.PlayoffSettings{Teams: 1},
+			wantErr:  domain.ErrInvalidPlayoffSettings,
+		},
+		{
+			name:     "two teams have no semifinal losers for third place",
+			settings: domain.PlayoffSettings{Teams: 2, ThirdPlace: true},
+			wantErr:  domain.ErrInvalidPlayoffSettings,
+		},
+		{
+			name:     "three opening losers cannot pair off for consolation",
+			settings: domain.PlayoffSettings{Teams: 7, Byes: 1, Consolation: true},
+			wantErr:  domain.ErrInvalidPlayoffSettings,
+		},
+		{
+			name:     "options need a bracket",
+			settings: domain.PlayoffSettings{ThirdPlace: true},
+			wantErr:  domain.ErrInvalidPlayoffSettings,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.settings.Validate()
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("league settings cannot send more teams to the playoffs than play", func(t *testing.T) {
+		settings := domain.LeagueSettings{MaxTeams: 4, Playoffs: domain.PlayoffSettings{Teams: 8}}
+
+		assert.ErrorIs(t, settings.Validate(), domain.ErrInvalidLeagueSettings)
+	})
+}
+
+func TestPlayoffBracketSeeding(t *testing.T) {
+	t.Run("eight teams pair the best seeds with the worst", func(t *testing.T) {
+		lv, standings := seededLeague(10, domain.PlayoffSettings{Teams: 8})
+		lv = startPlayoffs(t, lv, standings)
+
+		assert.Equal(t, lv.Phase, domain.PhasePlayoffs)
+		assert.Equal(t, pairings(lv.Bracket, domain.BracketChampionship, 1), []pairing{{1, 8}, {2, 7}, {3, 6}, {4, 5}})
+		assert.Equal(t, pairings(lv.Bracket, domain.BracketChampionship, 2), []pairing{{0, 0}, {0, 0}})
+	})
+
+	t.Run("byes go to the top seeds", func(t *testing.T) {
+		lv, standings := seededLeague(6, domain.PlayoffSettings{Teams: 6, Byes: 2})
+		lv = startPlayoffs(t, lv, standings)
+
+		assert.Equal(t, pairings(lv.Bracket, domain.BracketChampionship, 1), []pairing{{3, 6}, {4, 5}})
+
+		record(t, &lv, champ(1, 0), 90, 80)
+		record(t, &lv, champ(1, 1), 70, 75)
+
+		assert.Equal(t, pairings(lv.Bracket, domain.BracketChampionship, 2), []pairing{{1, 5}, {2, 3}})
+	})
+}
+
+func TestPlayoffBracketProgression(t *testing.T) {
+	lv, standings := seededLeague(8, domain.PlayoffSettings{Teams: 4, ThirdPlace: true})
+	lv = startPlayoffs(t, lv, standings)
+
+	final := champ(2, 0)
+	third := domain.MatchupID{Bracket: domain.BracketThirdPlace, Round: 2, Slot: 0}
+
+	_, err := lv.DecideRecordPlayoffResult(final, 100, 90, testkit.TodayLock())
+	assert.ErrorIs(t, err, domain.ErrMatchupNotReady)
+
+	record(t, &lv, champ(1, 0), 88, 91)
+	record(t, &lv, champ(1, 1), 75, 75)
+
+	assert.Equal(t, pairings(lv.Bracket, domain.BracketChampionship, 2), []pairing{{2, 4}})
+	assert.Equal(t, pairings(lv.Bracket, domain.BracketThirdPlace, 2), []pairing{{1, 3}})
+
+	_, err = lv.DecideRecordPlayoffResult(champ(1, 0), 100, 90, testkit.TodayLock())
+	assert.ErrorIs(t, err, domain.ErrMatchupAlreadyDecided)
+
+	_, err = lv.DecideRecordPlayoffResult(champ(3, 0), 100, 90, testkit.TodayLock())
+	assert.ErrorIs(t, err, domain.ErrMatchupNotFound)
+
+	_, err = lv.DecideAdvancePhase(domain.PhaseComplete, testkit.TodayLock())
+	assert.ErrorIs(t, err, domain.ErrPlayoffsUndecided)
+
+	record(t, &lv, final, 60, 70)
+	assert.Equal(t, lv.Bracket.Champion(), domain.TeamID(4))
+	assert.False(t, lv.Bracket.Complete())
+
+	record(t, &lv, third, 80, 50)
+	assert.True(t, lv.Bracket.Complete())
+
+	_, err = lv.DecideAdvancePhase(domain.PhaseComplete, testkit.TodayLock())
+	assert.NoError(t, err)
+}
+
+func TestPlayoffConsolationBracket(t *testing.T) {
+	lv, standings := seededLeague(8, domain.PlayoffSettings{Teams: 8, Consolation: true})
+	lv = startPlayoffs(t, lv, standings)
+
+	for slot := range 4 {
+		record(t, &lv, champ(1, slot), 100, 50)
+	}
+
+	assert.Equal(t, pairings(lv.Bracket, domain.BracketConsolation, 2), []pairing{{5, 8}, {6, 7}})
+}
+
+func TestDecideStartPlayoffs(t *testing.T) {
+	testCases := []struct {
+		name      string
+		teams     int
+		phase     domain.LeaguePhase
+		standings func([]domain.TeamID) []domain.TeamID
+		wantErr   error
+	}{
+		{
+			name:      "reject standings missing playoff teams",
+			teams:     4,
+			phase:     domain.PhaseInSeason,
+			standings: func(s []domain.TeamID) []domain.TeamID { return s[:3] },
+			wantErr:   domain.ErrInvalidStandings,
+		},
+		{
+			name:      "reject standings that repeat a team",
+			teams:     4,
+			phase:     domain.PhaseInSeason,
+			standings: func(s []domain.TeamID) []domain.TeamID { return []domain.TeamID{s[0], s[1], s[0], s[3]} },
+			wantErr:   domain.ErrInvalidStandings,
+		},
+		{
+			name:      "reject standings with a team from another league",
+			teams:     4,
+			phase:     domain.PhaseInSeason,
+			standings: func(s []domain.TeamID) []domain.TeamID { return []domain.TeamID{s[0], s[1], s[2], 99} },
+			wantErr:   domain.ErrInvalidStandings,
+		},
+		{
+			name:      "reject a bracket bigger than the league",
+			teams:     3,
+			phase:     domain.PhaseInSeason,
+			standings: func(s []domain.TeamID) []domain.TeamID { return s },
+			wantErr:   domain.ErrNotEnoughTeams,
+		},
+		{
+			name:      "reject starting the playoffs before the season",
+			teams:     4,
+			phase:     domain.PhaseDrafting,
+			standings: func(s []domain.TeamID) []domain.TeamID { return s },
+			wantErr:   domain.ErrInvalidPhaseTransition,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lv, standings := seededLeague(tc.teams, domain.PlayoffSettings{Teams: 4})
+			lv.Phase = tc.phase
+
+			events, err := lv.DecideStartPlayoffs(tc.standings(standings), testkit.TodayLock())
+
+			assert.Nil(t, events)
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+
+	t.Run("leagues without a bracket only change phase", func(t *testing.T) {
+		lv, standings := seededLeague(4, domain.PlayoffSettings{})
+
+		events, err := lv.DecideStartPlayoffs(standings, testkit.TodayLock())
+		require.NoError(t, err)
+		assert.Equal(t, len(events), 1)
+
+		_, err = lv.DecideRecordPlayoffResult(champ(1, 0), 1, 0, testkit.TodayLock())
+		assert.ErrorIs(t, err, domain.ErrPlayoffsNotStarted)
+	})
+}
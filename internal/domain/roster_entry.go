@@ -1,6 +1,9 @@
 package domain
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type RosterStatus int
 
@@ -23,8 +26,37 @@ func (s RosterStatus) String() string {
 	}
 }
 
+// Code returns the lowercase name used for the status in storage.
+func (s RosterStatus) Code() string {
+	switch s {
+	case StatusInactive:
+		return "inactive"
+	case StatusActiveHitter:
+		return "active_hitter"
+	case StatusActivePitcher:
+		return "active_pitcher"
+	default:
+		return ""
+	}
+}
+
+// ParseRosterStatus converts a status code, ignoring case and surrounding space.
+func ParseRosterStatus(code string) (RosterStatus, error) {
+	switch strings.ToLower(strings.TrimSpace(code)) {
+	case "inactive":
+		return StatusInactive, nil
+	case "active_hitter":
+		return StatusActiveHitter, nil
+	case "active_pitcher":
+		return StatusActivePitcher, nil
+	default:
+		return 0, fmt.Errorf("%w: %q", ErrUnrecognizedRosterStatus, code)
+	}
+}
+
 type RosterEntry struct {
 	TeamID       TeamID
 	PlayerID     PlayerID
 	RosterStatus RosterStatus
+	Eligibility  RoleSet
 }
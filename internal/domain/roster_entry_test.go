@@ -0,0 +1,19 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+)
+
+func TestParseRosterStatus(t *testing.T) {
+	for _, status := range []domain.RosterStatus{domain.StatusInactive, domain.StatusActiveHitter, domain.StatusActivePitcher} {
+		got, err := domain.ParseRosterStatus(status.Code())
+		assert.NoError(t, err)
+		assert.Equal(t, got, status)
+	}
+
+	_, err := domain.ParseRosterStatus("injured")
+	assert.ErrorIs(t, err, domain.ErrUnrecognizedRosterStatus)
+}
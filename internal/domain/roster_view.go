@@ -3,6 +3,8 @@ package domain
 import (
 	"fmt"
 	"time"
+
+	"github.com/spcameron/dugout/internal/eventlog"
 )
 
 const (
@@ -18,10 +20,14 @@ type RosterCounts struct {
 	Inactive       int
 }
 
+// RosterView is a team's roster as of EffectiveThrough. Transactions records the
+// adds and lineup moves applied to it, which Rules may limit.
 type RosterView struct {
 	TeamID           TeamID
 	Entries          []RosterEntry
 	EffectiveThrough time.Time
+	Transactions     []Transaction
+	Rules            TransactionRules
 }
 
 // Counts tabulates the number of total players, active hitters, active pitchers,
@@ -29,6 +35,17 @@ type RosterView struct {
 //
 // Panics if an unrecognized RosterStatus is encountered.
 func (rv RosterView) Counts() RosterCounts {
+	rc, err := rv.TryCounts()
+	if err != nil {
+		panic(err)
+	}
+
+	return rc
+}
+
+// TryCounts is Counts, returning an error for an unrecognized RosterStatus
+// instead of panicking.
+func (rv RosterView) TryCounts() (RosterCounts, error) {
 	rc := RosterCounts{}
 
 	for _, e := range rv.Entries {
@@ -40,18 +57,18 @@ func (rv RosterView) Counts() RosterCounts {
 		case StatusInactive:
 			rc.Inactive++
 		default:
-			panic(fmt.Errorf("%w: %v", ErrUnrecognizedRosterStatus, e.RosterStatus))
+			return RosterCounts{}, fmt.Errorf("%w: %v", ErrUnrecognizedRosterStatus, e.RosterStatus)
 		}
 
 		rc.Total++
 	}
 
-	return rc
+	return rc, nil
 }
 
 // DecideAddPlayer returns the AddedPlayerToRoster events that should be recorded if allowed.
-func (rv RosterView) DecideAddPlayer(id PlayerID) ([]RosterEvent, error) {
-	err := rv.validateAddPlayer(id)
+func (rv RosterView) DecideAddPlayer(id PlayerID, eligibility RoleSet) ([]RosterEvent, error) {
+	err := rv.validateAddPlayer(id, eligibility)
 	if err != nil {
 		return nil, err
 	}
@@ -60,6 +77,7 @@ func (rv RosterView) DecideAddPlayer(id PlayerID) ([]RosterEvent, error) {
 		AddedPlayerToRoster{
 			TeamID:      rv.TeamID,
 			PlayerID:    id,
+			Eligibility: eligibility,
 			EffectiveAt: rv.EffectiveThrough,
 		},
 	}
@@ -122,31 +140,111 @@ func (rv RosterView) DecideInactivatePlayer(id PlayerID) ([]RosterEvent, error)
 	return res, nil
 }
 
+// DecideReverse returns the compensating events that undo target if allowed.
+//
+// before is the view immediately prior to target, and is used to recover the role
+// of a player whose inactivation is being undone and the eligibility of a player
+// whose removal is being undone. Undoing a removal restores the player as inactive.
+func (rv RosterView) DecideReverse(target RosterEvent, before RosterView) ([]RosterEvent, error) {
+	switch ev := target.(type) {
+	case AddedPlayerToRoster:
+		return rv.DecideRemovePlayer(ev.PlayerID)
+	case RemovedPlayerFromRoster:
+		entry, ok := before.Entry(ev.PlayerID)
+		if !ok {
+			return nil, fmt.Errorf("%w: player %v was not on the roster", ErrRosterEventNotReversible, ev.PlayerID)
+		}
+
+		return rv.DecideAddPlayer(ev.PlayerID, entry.Eligibility)
+	case ActivatedPlayerOnRoster:
+		return rv.DecideInactivatePlayer(ev.PlayerID)
+	case InactivatedPlayerOnRoster:
+		role, ok := before.activeRole(ev.PlayerID)
+		if !ok {
+			return nil, fmt.Errorf("%w: player %v was not active", ErrRosterEventNotReversible, ev.PlayerID)
+		}
+
+		return rv.DecideActivatePlayer(ev.PlayerID, role)
+	case RecordedRosterOverride, AwardedWaiverClaim, ReceivedInTrade:
+		return nil, fmt.Errorf("%w: %T", ErrRosterEventNotReversible, target)
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnrecognizedRosterEvent, target)
+	}
+}
+
 // Apply applies a roster domain event to the view.
 //
 // Events whose postconditions already hold are treated as no-ops.
 // Events that would violate roster invariants cause Apply to panic.
 func (rv *RosterView) Apply(event RosterEvent) {
+	err := rv.TryApply(event)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// TryApply is Apply, returning an error for an event that would violate a
+// roster invariant instead of panicking. The view is unchanged when it does.
+func (rv *RosterView) TryApply(event RosterEvent) error {
+	return rv.apply(0, event)
+}
+
+// TryApplyRecorded is TryApply for an event recorded at re.Sequence. Annotations
+// name the events they exempt from the league's limits by sequence, so a view
+// that counts transactions is built from recorded events.
+func (rv *RosterView) TryApplyRecorded(re eventlog.Recorded[RosterEvent]) error {
+	return rv.apply(re.Sequence, re.Event)
+}
+
+// apply applies event, recorded at seq, or zero if it is still pending.
+func (rv *RosterView) apply(seq eventlog.Sequence, event RosterEvent) error {
 	if event.OccurredAt().After(rv.EffectiveThrough) {
-		panic(fmt.Errorf("%w: event lock %v, view lock %v", ErrEventOutsideViewWindow, event.OccurredAt(), rv.EffectiveThrough))
+		return fmt.Errorf("%w: event lock %v, view lock %v", ErrEventOutsideViewWindow, event.OccurredAt(), rv.EffectiveThrough)
 	}
 
 	if rv.TeamID != event.Team() {
-		panic(fmt.Errorf("%w: event team %v, view team %v", ErrWrongTeamID, event.Team(), rv.TeamID))
+		return fmt.Errorf("%w: event team %v, view team %v", ErrWrongTeamID, event.Team(), rv.TeamID)
 	}
 
 	switch ev := event.(type) {
 	case AddedPlayerToRoster:
-		rv.addPlayer(ev.PlayerID)
+		err := rv.addPlayer(ev.PlayerID, ev.EligibleRoles())
+		if err != nil {
+			return err
+		}
+		rv.record(TransactionAdd, seq, ev.PlayerID, ev.EffectiveAt)
 	case RemovedPlayerFromRoster:
 		rv.removePlayer(ev.PlayerID)
 	case ActivatedPlayerOnRoster:
-		rv.activatePlayer(ev.PlayerID, ev.PlayerRole)
+		err := rv.activatePlayer(ev.PlayerID, ev.PlayerRole)
+		if err != nil {
+			return err
+		}
+		rv.record(TransactionLineupMove, seq, ev.PlayerID, ev.EffectiveAt)
 	case InactivatedPlayerOnRoster:
-		rv.inactivatePlayer(ev.PlayerID)
+		err := rv.inactivatePlayer(ev.PlayerID)
+		if err != nil {
+			return err
+		}
+		rv.record(TransactionLineupMove, seq, ev.PlayerID, ev.EffectiveAt)
+	case RecordedRosterOverride:
+		// A forced add, or the add restoring a reversed removal, is the event
+		// recorded just before the override. A reversed add no longer counts either.
+		if ev.Kind == OverrideForcedAdd || ev.Kind == OverrideReversal {
+			rv.exemptAdd(seq - 1)
+		}
+		if ev.Kind == OverrideReversal {
+			rv.exemptAdd(ev.Reverses)
+		}
+	case ReceivedInTrade:
+		rv.exemptAdd(seq - 1)
+	case AwardedWaiverClaim:
+		// Waiver awards only annotate the add recorded with them.
 	default:
-		panic(fmt.Errorf("%w: %T", ErrUnrecognizedRosterEvent, event))
+		return fmt.Errorf("%w: %T", ErrUnrecognizedRosterEvent, event)
 	}
+
+	return nil
 }
 
 // PlayerOnRoster checks the PlayerID for each RosterEntry, and returns true if
@@ -161,7 +259,41 @@ func (rv RosterView) PlayerOnRoster(id PlayerID) bool {
 	return false
 }
 
-func (rv RosterView) validateAddPlayer(id PlayerID) error {
+// Entry returns the RosterEntry for the given PlayerID, if the player is on the roster.
+func (rv RosterView) Entry(id PlayerID) (RosterEntry, bool) {
+	for _, e := range rv.Entries {
+		if e.PlayerID == id {
+			return e, true
+		}
+	}
+
+	return RosterEntry{}, false
+}
+
+func (rv RosterView) activeRole(id PlayerID) (PlayerRole, bool) {
+	for _, e := range rv.Entries {
+		if e.PlayerID != id {
+			continue
+		}
+
+		switch e.RosterStatus {
+		case StatusActiveHitter:
+			return RoleHitter, true
+		case StatusActivePitcher:
+			return RolePitcher, true
+		}
+
+		return 0, false
+	}
+
+	return 0, false
+}
+
+func (rv RosterView) validateAddPlayer(id PlayerID, eligibility RoleSet) error {
+	if !eligibility.valid() {
+		return fmt.Errorf("%w: player %v has eligibility %v", ErrUnrecognizedPlayerRole, id, eligibility)
+	}
+
 	if len(rv.Entries) >= MaxRosterSize {
 		return ErrRosterFull
 	}
@@ -170,7 +302,7 @@ func (rv RosterView) validateAddPlayer(id PlayerID) error {
 		return ErrPlayerAlreadyOnRoster
 	}
 
-	return nil
+	return rv.validateAddLimits()
 }
 
 func (rv RosterView) validateRemovePlayer(id PlayerID) error {
@@ -183,10 +315,12 @@ func (rv RosterView) validateRemovePlayer(id PlayerID) error {
 
 func (rv RosterView) validateActivatePlayer(id PlayerID, role PlayerRole) error {
 	var onRoster bool
+	var eligibility RoleSet
 	for _, e := range rv.Entries {
 		if e.PlayerID == id {
 			if e.RosterStatus == StatusInactive {
 				onRoster = true
+				eligibility = e.Eligibility
 				break
 			}
 
@@ -202,7 +336,14 @@ func (rv RosterView) validateActivatePlayer(id PlayerID, role PlayerRole) error
 		return ErrPlayerNotOnRoster
 	}
 
-	rc := rv.Counts()
+	if role.Code() != "" && !eligibility.Has(role) {
+		return fmt.Errorf("%w: player %v is eligible for %v, not %v", ErrPlayerNotEligibleForRole, id, eligibility, role)
+	}
+
+	rc, err := rv.TryCounts()
+	if err != nil {
+		return err
+	}
 
 	switch role {
 	case RoleHitter:
@@ -217,7 +358,7 @@ func (rv RosterView) validateActivatePlayer(id PlayerID, role PlayerRole) error
 		return ErrUnrecognizedPlayerRole
 	}
 
-	return nil
+	return rv.validateLineupMoveLimit(id)
 }
 
 func (rv RosterView) validateInactivatePlayer(id PlayerID) error {
@@ -242,19 +383,49 @@ func (rv RosterView) validateInactivatePlayer(id PlayerID) error {
 		return ErrPlayerNotOnRoster
 	}
 
-	return nil
+	return rv.validateLineupMoveLimit(id)
+}
+
+func (rv *RosterView) record(kind TransactionKind, seq eventlog.Sequence, id PlayerID, at time.Time) {
+	rv.Transactions = append(rv.Transactions, Transaction{
+		Kind:        kind,
+		Sequence:    seq,
+		PlayerID:    id,
+		EffectiveAt: at,
+	})
+}
+
+// exemptAdd stops counting the add recorded at seq. Pending events have no
+// sequence, so an annotation staged with them exempts nothing; the rules are not
+// applied to the moves that stage annotations.
+func (rv *RosterView) exemptAdd(seq eventlog.Sequence) {
+	if seq <= 0 {
+		return
+	}
+
+	// Copies of the view share the slice, so build a new one.
+	kept := make([]Transaction, 0, len(rv.Transactions))
+	for _, tx := range rv.Transactions {
+		if tx.Kind != TransactionAdd || tx.Sequence != seq {
+			kept = append(kept, tx)
+		}
+	}
+	rv.Transactions = kept
 }
 
-func (rv *RosterView) addPlayer(id PlayerID) {
+func (rv *RosterView) addPlayer(id PlayerID, eligibility RoleSet) error {
 	if rv.PlayerOnRoster(id) {
-		panic(fmt.Errorf("%w: player ID %v", ErrPlayerAlreadyOnRoster, id))
+		return fmt.Errorf("%w: player ID %v", ErrPlayerAlreadyOnRoster, id)
 	}
 
 	rv.Entries = append(rv.Entries, RosterEntry{
 		TeamID:       rv.TeamID,
 		PlayerID:     id,
 		RosterStatus: StatusInactive,
+		Eligibility:  eligibility,
 	})
+
+	return nil
 }
 
 func (rv *RosterView) removePlayer(id PlayerID) {
@@ -273,27 +444,31 @@ func (rv *RosterView) removePlayer(id PlayerID) {
 	}
 }
 
-func (rv *RosterView) activatePlayer(id PlayerID, role PlayerRole) {
+func (rv *RosterView) activatePlayer(id PlayerID, role PlayerRole) error {
 	for i, e := range rv.Entries {
 		if e.PlayerID != id {
 			continue
 		}
 
+		if role.Code() != "" && !e.Eligibility.Has(role) {
+			return fmt.Errorf("%w: player ID %v is eligible for %v, not %v", ErrPlayerNotEligibleForRole, id, e.Eligibility, role)
+		}
+
 		switch role {
 		case RoleHitter:
 			rv.Entries[i].RosterStatus = StatusActiveHitter
 		case RolePitcher:
 			rv.Entries[i].RosterStatus = StatusActivePitcher
 		default:
-			panic(fmt.Errorf("%w: %s", ErrUnrecognizedPlayerRole, role))
+			return fmt.Errorf("%w: %s", ErrUnrecognizedPlayerRole, role)
 		}
-		return
+		return nil
 	}
 
-	panic(fmt.Errorf("%w: player ID %v", ErrPlayerNotOnRoster, id))
+	return fmt.Errorf("%w: player ID %v", ErrPlayerNotOnRoster, id)
 }
 
-func (rv *RosterView) inactivatePlayer(id PlayerID) {
+func (rv *RosterView) inactivatePlayer(id PlayerID) error {
 	for i, e := range rv.Entries {
 		if e.PlayerID != id {
 			continue
@@ -305,13 +480,13 @@ func (rv *RosterView) inactivatePlayer(id PlayerID) {
 		case StatusActivePitcher:
 			rv.Entries[i].RosterStatus = StatusInactive
 		case StatusInactive:
-			return
+			return nil
 		default:
-			panic(fmt.Errorf("%w: %v", ErrUnrecognizedRosterStatus, e.RosterStatus))
+			return fmt.Errorf("%w: %v", ErrUnrecognizedRosterStatus, e.RosterStatus)
 		}
 
-		return
+		return nil
 	}
 
-	panic(fmt.Errorf("%w: playerID %v", ErrPlayerNotOnRoster, id))
+	return fmt.Errorf("%w: playerID %v", ErrPlayerNotOnRoster, id)
 }
@@ -1,6 +1,7 @@
 package domain_test
 
 import (
+	"fmt"
 	"slices"
 	"testing"
 
@@ -48,7 +49,9 @@ func TestDecideAddPlayer(t *testing.T) {
 			candidateID := domain.PlayerID(tc.playerID)
 			rv := testkit.NewRosterView(testkit.TeamA(), tc.rosterSize, testkit.TodayLock())
 
-			events, err := rv.DecideAddPlayer(candidateID)
+			eligibility := domain.MustRoleSet(domain.RoleHitter)
+
+			events, err := rv.DecideAddPlayer(candidateID, eligibility)
 
 			if tc.wantErr == nil {
 				assert.NoError(t, err)
@@ -60,12 +63,22 @@ func TestDecideAddPlayer(t *testing.T) {
 				assert.Equal(t, ev.TeamID, testkit.TeamA())
 				assert.Equal(t, ev.EffectiveAt, rv.EffectiveThrough)
 				assert.Equal(t, ev.PlayerID, candidateID)
+				assert.Equal(t, ev.Eligibility, eligibility)
 			} else {
 				assert.Nil(t, events)
 				assert.ErrorIs(t, err, tc.wantErr)
 			}
 		})
 	}
+
+	t.Run("reject adding player with no eligible roles", func(t *testing.T) {
+		rv := testkit.NewRosterView(testkit.TeamA(), 0, testkit.TodayLock())
+
+		events, err := rv.DecideAddPlayer(1, domain.MustRoleSet())
+
+		assert.Nil(t, events)
+		assert.ErrorIs(t, err, domain.ErrUnrecognizedPlayerRole)
+	})
 }
 
 func TestDecideRemovePlayer(t *testing.T) {
@@ -267,6 +280,66 @@ func TestDecideActivatePlayer(t *testing.T) {
 		assert.Nil(t, events)
 		assert.ErrorIs(t, err, domain.ErrUnrecognizedRosterStatus)
 	})
+
+	eligibilityCases := []struct {
+		name        string
+		eligibility domain.RoleSet
+		role        domain.PlayerRole
+		wantErr     error
+	}{
+		{
+			name:        "reject activating a hitter as a pitcher",
+			eligibility: domain.MustRoleSet(domain.RoleHitter),
+			role:        domain.RolePitcher,
+			wantErr:     domain.ErrPlayerNotEligibleForRole,
+		},
+		{
+			name:        "reject activating a pitcher as a hitter",
+			eligibility: domain.MustRoleSet(domain.RolePitcher),
+			role:        domain.RoleHitter,
+			wantErr:     domain.ErrPlayerNotEligibleForRole,
+		},
+		{
+			name:        "accept activating a two-way player as a hitter",
+			eligibility: domain.MustRoleSet(domain.RoleHitter, domain.RolePitcher),
+			role:        domain.RoleHitter,
+			wantErr:     nil,
+		},
+		{
+			name:        "accept activating a two-way player as a pitcher",
+			eligibility: domain.MustRoleSet(domain.RoleHitter, domain.RolePitcher),
+			role:        domain.RolePitcher,
+			wantErr:     nil,
+		},
+	}
+
+	for _, tc := range eligibilityCases {
+		t.Run(tc.name, func(t *testing.T) {
+			candidateID := domain.PlayerID(1)
+			rv := domain.RosterView{
+				TeamID: testkit.TeamA(),
+				Entries: []domain.RosterEntry{
+					{
+						TeamID:       testkit.TeamA(),
+						PlayerID:     candidateID,
+						RosterStatus: domain.StatusInactive,
+						Eligibility:  tc.eligibility,
+					},
+				},
+				EffectiveThrough: testkit.TodayLock(),
+			}
+
+			events, err := rv.DecideActivatePlayer(candidateID, tc.role)
+
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+				require.Equal(t, len(events), 1)
+			} else {
+				assert.Nil(t, events)
+				assert.ErrorIs(t, err, tc.wantErr)
+			}
+		})
+	}
 }
 
 func TestDecideInactivatePlayer(t *testing.T) {
@@ -357,6 +430,166 @@ func TestDecideInactivatePlayer(t *testing.T) {
 	})
 }
 
+func TestDecideReverse(t *testing.T) {
+	testCases := []struct {
+		name     string
+		view     domain.RosterView
+		before   domain.RosterView
+		target   domain.RosterEvent
+		wantType domain.RosterEvent
+		wantRole domain.PlayerRole
+		wantErr  error
+	}{
+		{
+			name:   "reversing an add removes the player",
+			view:   testkit.NewRosterView(testkit.TeamA(), 1, testkit.TodayLock()),
+			before: testkit.NewRosterView(testkit.TeamA(), 0, testkit.TodayLock()),
+			target: domain.AddedPlayerToRoster{
+				TeamID:      testkit.TeamA(),
+				PlayerID:    1,
+				EffectiveAt: testkit.TodayLock(),
+			},
+			wantType: domain.RemovedPlayerFromRoster{},
+		},
+		{
+			name:   "reversing a removal adds the player back",
+			view:   testkit.NewRosterView(testkit.TeamA(), 0, testkit.TodayLock()),
+			before: testkit.NewRosterView(testkit.TeamA(), 1, testkit.TodayLock()),
+			target: domain.RemovedPlayerFromRoster{
+				TeamID:      testkit.TeamA(),
+				PlayerID:    1,
+				EffectiveAt: testkit.TodayLock(),
+			},
+			wantType: domain.AddedPlayerToRoster{},
+		},
+		{
+			name: "reversing an activation inactivates the player",
+			view: testkit.ActivatedRosterView(
+				testkit.NewRosterView(testkit.TeamA(), 1, testkit.TodayLock()),
+				1, 0,
+			),
+			before: testkit.NewRosterView(testkit.TeamA(), 1, testkit.TodayLock()),
+			target: domain.ActivatedPlayerOnRoster{
+				TeamID:      testkit.TeamA(),
+				PlayerID:    1,
+				PlayerRole:  domain.RoleHitter,
+				EffectiveAt: testkit.TodayLock(),
+			},
+			wantType: domain.InactivatedPlayerOnRoster{},
+		},
+		{
+			name: "reversing an inactivation restores the prior active role",
+			view: testkit.NewRosterView(testkit.TeamA(), 1, testkit.TodayLock()),
+			before: testkit.ActivatedRosterView(
+				testkit.NewRosterView(testkit.TeamA(), 1, testkit.TodayLock()),
+				0, 1,
+			),
+			target: domain.InactivatedPlayerOnRoster{
+				TeamID:      testkit.TeamA(),
+				PlayerID:    1,
+				EffectiveAt: testkit.TodayLock(),
+			},
+			wantType: domain.ActivatedPlayerOnRoster{},
+			wantRole: domain.RolePitcher,
+		},
+		{
+			name:   "reject reversing an inactivation of a player who was not active",
+			view:   testkit.NewRosterView(testkit.TeamA(), 1, testkit.TodayLock()),
+			before: testkit.NewRosterView(testkit.TeamA(), 1, testkit.TodayLock()),
+			target: domain.InactivatedPlayerOnRoster{
+				TeamID:      testkit.TeamA(),
+				PlayerID:    1,
+				EffectiveAt: testkit.TodayLock(),
+			},
+			wantErr: domain.ErrRosterEventNotReversible,
+		},
+		{
+			name: "reject reversing a removal when the roster is now full",
+			view: testkit.NewRosterView(testkit.TeamA(), domain.MaxRosterSize, testkit.TodayLock()),
+			before: domain.RosterView{
+				TeamID: testkit.TeamA(),
+				Entries: []domain.RosterEntry{
+					{
+						TeamID:       testkit.TeamA(),
+						PlayerID:     domain.MaxRosterSize + 1,
+						RosterStatus: domain.StatusInactive,
+						Eligibility:  domain.MustRoleSet(domain.RoleHitter),
+					},
+				},
+				EffectiveThrough: testkit.TodayLock(),
+			},
+			target: domain.RemovedPlayerFromRoster{
+				TeamID:      testkit.TeamA(),
+				PlayerID:    domain.MaxRosterSize + 1,
+				EffectiveAt: testkit.TodayLock(),
+			},
+			wantErr: domain.ErrRosterFull,
+		},
+		{
+			name:   "reject reversing a removal of a player missing from the prior roster",
+			view:   testkit.NewRosterView(testkit.TeamA(), 0, testkit.TodayLock()),
+			before: testkit.NewRosterView(testkit.TeamA(), 0, testkit.TodayLock()),
+			target: domain.RemovedPlayerFromRoster{
+				TeamID:      testkit.TeamA(),
+				PlayerID:    1,
+				EffectiveAt: testkit.TodayLock(),
+			},
+			wantErr: domain.ErrRosterEventNotReversible,
+		},
+		{
+			name:   "reject reversing an add when the player has since left",
+			view:   testkit.NewRosterView(testkit.TeamA(), 0, testkit.TodayLock()),
+			before: testkit.NewRosterView(testkit.TeamA(), 0, testkit.TodayLock()),
+			target: domain.AddedPlayerToRoster{
+				TeamID:      testkit.TeamA(),
+				PlayerID:    1,
+				EffectiveAt: testkit.TodayLock(),
+			},
+			wantErr: domain.ErrPlayerNotOnRoster,
+		},
+		{
+			name:   "reject reversing an override",
+			view:   testkit.NewRosterView(testkit.TeamA(), 0, testkit.TodayLock()),
+			before: testkit.NewRosterView(testkit.TeamA(), 0, testkit.TodayLock()),
+			target: domain.RecordedRosterOverride{
+				TeamID:      testkit.TeamA(),
+				Kind:        domain.OverrideForcedAdd,
+				Reason:      "fix",
+				EffectiveAt: testkit.TodayLock(),
+			},
+			wantErr: domain.ErrRosterEventNotReversible,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			events, err := tc.view.DecideReverse(tc.target, tc.before)
+
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+				require.Equal(t, len(events), 1)
+
+				ev := events[0]
+				assert.Equal(t, fmt.Sprintf("%T", ev), fmt.Sprintf("%T", tc.wantType))
+				assert.Equal(t, ev.Team(), testkit.TeamA())
+				assert.Equal(t, ev.OccurredAt(), tc.view.EffectiveThrough)
+
+				if activated, ok := ev.(domain.ActivatedPlayerOnRoster); ok {
+					assert.Equal(t, activated.PlayerRole, tc.wantRole)
+				}
+
+				if added, ok := ev.(domain.AddedPlayerToRoster); ok {
+					prior, _ := tc.before.Entry(added.PlayerID)
+					assert.Equal(t, added.Eligibility, prior.Eligibility)
+				}
+			} else {
+				assert.Nil(t, events)
+				assert.ErrorIs(t, err, tc.wantErr)
+			}
+		})
+	}
+}
+
 func TestCounts(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -426,6 +659,17 @@ func TestCounts(t *testing.T) {
 		require.NotNil(t, err)
 		require.ErrorIs(t, err, domain.ErrUnrecognizedRosterStatus)
 	})
+
+	t.Run("TryCounts returns an error on unrecognized roster status", func(t *testing.T) {
+		r := domain.RosterView{
+			TeamID:  testkit.TeamA(),
+			Entries: []domain.RosterEntry{{PlayerID: 1, RosterStatus: domain.RosterStatus(999)}},
+		}
+
+		_, err := r.TryCounts()
+
+		assert.ErrorIs(t, err, domain.ErrUnrecognizedRosterStatus)
+	})
 }
 
 func TestApply(t *testing.T) {
@@ -484,6 +728,21 @@ func TestApply(t *testing.T) {
 		})
 	}
 
+	t.Run("add recorded without eligibility is eligible as a hitter only", func(t *testing.T) {
+		rv := testkit.NewRosterView(testkit.TeamA(), 0, testkit.TomorrowLock())
+
+		rv.Apply(domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 1, EffectiveAt: testkit.TodayLock()})
+		entry, ok := rv.Entry(1)
+		require.True(t, ok)
+		assert.Equal(t, entry.Eligibility, domain.MustRoleSet(domain.RoleHitter))
+
+		err := rv.TryApply(domain.ActivatedPlayerOnRoster{TeamID: testkit.TeamA(), PlayerID: 1, PlayerRole: domain.RolePitcher, EffectiveAt: testkit.TomorrowLock()})
+		assert.ErrorIs(t, err, domain.ErrPlayerNotEligibleForRole)
+
+		err = rv.TryApply(domain.ActivatedPlayerOnRoster{TeamID: testkit.TeamA(), PlayerID: 1, PlayerRole: domain.RoleHitter, EffectiveAt: testkit.TomorrowLock()})
+		assert.NoError(t, err)
+	})
+
 	testRemovePlayerCases := []struct {
 		name          string
 		view          domain.RosterView
@@ -734,6 +993,24 @@ func TestApply(t *testing.T) {
 		})
 	}
 
+	t.Run("applying an override annotation leaves entries unchanged", func(t *testing.T) {
+		rv := testkit.ActivatedRosterView(
+			testkit.NewRosterView(testkit.TeamA(), 3, testkit.TodayLock()),
+			1, 1,
+		)
+		startingEntries := slices.Clone(rv.Entries)
+
+		rv.Apply(domain.RecordedRosterOverride{
+			TeamID:      testkit.TeamA(),
+			Actor:       1,
+			Kind:        domain.OverrideForcedRemove,
+			Reason:      "player retired",
+			EffectiveAt: testkit.TodayLock(),
+		})
+
+		assert.Equal(t, rv.Entries, startingEntries)
+	})
+
 	panicCases := []struct {
 		name           string
 		view           domain.RosterView
@@ -782,6 +1059,28 @@ func TestApply(t *testing.T) {
 			},
 			wantErr: domain.ErrUnrecognizedPlayerRole,
 		},
+		{
+			name: "apply ActivatedPlayerOnRoster panics if player is not eligible for the role",
+			view: domain.RosterView{
+				TeamID: testkit.TeamA(),
+				Entries: []domain.RosterEntry{
+					{
+						TeamID:       testkit.TeamA(),
+						PlayerID:     1,
+						RosterStatus: domain.StatusInactive,
+						Eligibility:  domain.MustRoleSet(domain.RoleHitter),
+					},
+				},
+				EffectiveThrough: testkit.TodayLock(),
+			},
+			event: domain.ActivatedPlayerOnRoster{
+				TeamID:      testkit.TeamA(),
+				PlayerID:    1,
+				PlayerRole:  domain.RolePitcher,
+				EffectiveAt: testkit.TodayLock(),
+			},
+			wantErr: domain.ErrPlayerNotEligibleForRole,
+		},
 		{
 			name: "apply ActivatedPlayerOnRoster panics if player is not already on roster",
 			view: testkit.NewRosterView(testkit.TeamA(), 1, testkit.TodayLock()),
@@ -851,4 +1150,24 @@ func TestApply(t *testing.T) {
 			assert.Equal(t, rv.EffectiveThrough, startingLock)
 		})
 	}
+
+	for _, tc := range panicCases {
+		t.Run("TryApply returns the error: "+tc.name, func(t *testing.T) {
+			rv := tc.view
+			startingEntries := slices.Clone(rv.Entries)
+
+			err := rv.TryApply(tc.event)
+
+			require.NotNil(t, err)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+			}
+			if tc.wantErrMessage != "" {
+				assert.Contains(t, err.Error(), tc.wantErrMessage)
+			}
+
+			assert.Equal(t, rv.Entries, startingEntries)
+			assert.Equal(t, len(rv.Transactions), 0)
+		})
+	}
 }
@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// MatchupResult is the head-to-head outcome of one regular-season matchup.
+// Periods count from 1, as the league's matchup calendar does.
+type MatchupResult struct {
+	Period    int
+	Home      TeamID
+	Away      TeamID
+	HomeScore float64
+	AwayScore float64
+}
+
+// TeamRecord is a team's regular-season record.
+type TeamRecord struct {
+	TeamID    TeamID
+	Wins      int
+	Losses    int
+	Ties      int
+	PointsFor float64
+}
+
+// winShare counts a tie as half a win, doubled so it stays whole.
+func (r TeamRecord) winShare() int {
+	return 2*r.Wins + r.Ties
+}
+
+func (r TeamRecord) games() int {
+	return r.Wins + r.Losses + r.Ties
+}
+
+// RankStandings returns teams from first place down by winning percentage,
+// counting a tie as half a win. Points scored break ties, then TeamID, so the
+// order is the same however the results arrived. Teams without results rank as
+// .000.
+func RankStandings(teams []TeamID, results []MatchupResult) []TeamID {
+	records := make(map[TeamID]*TeamRecord, len(teams))
+	for _, id := range teams {
+		records[id] = &TeamRecord{TeamID: id}
+	}
+
+	for _, r := range results {
+		home, away := records[r.Home], records[r.Away]
+		if home == nil || away == nil {
+			continue
+		}
+
+		home.PointsFor += r.HomeScore
+		away.PointsFor += r.AwayScore
+
+		switch {
+		case r.HomeScore > r.AwayScore:
+			home.Wins++
+			away.Losses++
+		case r.AwayScore > r.HomeScore:
+			away.Wins++
+			home.Losses++
+		default:
+			home.Ties++
+			away.Ties++
+		}
+	}
+
+	ranked := slices.Clone(teams)
+	slices.SortFunc(ranked, func(a, b TeamID) int {
+		ra, rb := records[a], records[b]
+
+		// Compare winShare/games across teams without dividing.
+		c := cmp.Compare(rb.winShare()*max(ra.games(), 1), ra.winShare()*max(rb.games(), 1))
+		if c != 0 {
+			return c
+		}
+
+		c = cmp.Compare(rb.PointsFor, ra.PointsFor)
+		if c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a, b)
+	})
+
+	return ranked
+}
+
+// DecideRecordMatchupResult returns the RecordedMatchupResult events for a
+// regular-season result if allowed. Each team plays once a period, so neither
+// team may already have a result for it.
+func (lv LeagueView) DecideRecordMatchupResult(result MatchupResult, at time.Time) ([]LeagueEvent, error) {
+	if lv.Phase != PhaseInSeason {
+		return nil, fmt.Errorf("%w: league is in %v", ErrRegularSeasonNotInProgress, lv.Phase)
+	}
+
+	periods := lv.Settings.Calendar.Periods
+	if result.Period < 1 || (periods > 0 && result.Period > periods) || result.Home == result.Away {
+		return nil, fmt.Errorf("%w: %+v", ErrInvalidMatchupResult, result)
+	}
+
+	for _, id := range []TeamID{result.Home, result.Away} {
+		if _, ok := lv.Team(id); !ok {
+			return nil, fmt.Errorf("%w: team %v, league %v", ErrTeamNotInLeague, id, lv.LeagueID)
+		}
+
+		played := slices.ContainsFunc(lv.Results, func(r MatchupResult) bool {
+			return r.Period == result.Period && (r.Home == id || r.Away == id)
+		})
+		if played {
+			return nil, fmt.Errorf("%w: team %v, period %d", ErrMatchupResultRecorded, id, result.Period)
+		}
+	}
+
+	res := []LeagueEvent{
+		RecordedMatchupResult{
+			LeagueID:   lv.LeagueID,
+			Result:     result,
+			RecordedAt: at,
+		},
+	}
+
+	return res, nil
+}
@@ -0,0 +1,146 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+func result(period int, home, away domain.TeamID, homeScore, awayScore float64) domain.MatchupResult {
+	return domain.MatchupResult{Period: period, Home: home, Away: away, HomeScore: homeScore, AwayScore: awayScore}
+}
+
+func TestRankStandings(t *testing.T) {
+	teams := []domain.TeamID{1, 2, 3, 4}
+
+	testCases := []struct {
+		name    string
+		results []domain.MatchupResult
+		want    []domain.TeamID
+	}{
+		{
+			name: "teams without results rank by TeamID",
+			want: []domain.TeamID{1, 2, 3, 4},
+		},
+		{
+			name: "winning percentage ranks first",
+			results: []domain.MatchupResult{
+				result(1, 1, 4, 80, 90),
+				result(1, 2, 3, 70, 60),
+				result(2, 4, 2, 100, 50),
+				result(2, 3, 1, 75, 70),
+			},
+			want: []domain.TeamID{4, 3, 2, 1},
+		},
+		{
+			name: "a tie counts as half a win",
+			results: []domain.MatchupResult{
+				result(1, 1, 2, 80, 80),
+				result(1, 3, 4, 90, 60),
+				result(2, 1, 3, 50, 90),
+				result(2, 2, 4, 70, 60),
+			},
+			want: []domain.TeamID{3, 2, 1, 4},
+		},
+		{
+			name: "points scored break ties in percentage",
+			results: []domain.MatchupResult{
+				result(1, 1, 2, 80, 90),
+				result(1, 3, 4, 100, 95),
+			},
+			want: []domain.TeamID{3, 2, 4, 1},
+		},
+		{
+			name: "results for teams outside the list are ignored",
+			results: []domain.MatchupResult{
+				result(1, 9, 4, 100, 0),
+			},
+			want: []domain.TeamID{1, 2, 3, 4},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := domain.RankStandings(teams, tc.results)
+
+			require.Equal(t, len(got), len(tc.want))
+			for i := range tc.want {
+				assert.Equal(t, got[i], tc.want[i])
+			}
+		})
+	}
+}
+
+func TestDecideRecordMatchupResult(t *testing.T) {
+	inSeason, _ := seededLeague(4, domain.PlayoffSettings{})
+	inSeason.Settings.Calendar = domain.MatchupCalendar{SeasonStart: testkit.TodayLock(), PeriodDays: 7, Periods: 20}
+	inSeason.Results = []domain.MatchupResult{result(1, 1, 2, 80, 70)}
+
+	playoffs := inSeason
+	playoffs.Phase = domain.PhasePlayoffs
+
+	testCases := []struct {
+		name    string
+		view    domain.LeagueView
+		result  domain.MatchupResult
+		wantErr error
+	}{
+		{
+			name:   "records a result between two teams in the league",
+			view:   inSeason,
+			result: result(1, 3, 4, 90, 85),
+		},
+		{
+			name:    "only during the regular season",
+			view:    playoffs,
+			result:  result(2, 3, 4, 90, 85),
+			wantErr: domain.ErrRegularSeasonNotInProgress,
+		},
+		{
+			name:    "a team cannot play itself",
+			view:    inSeason,
+			result:  result(2, 3, 3, 90, 85),
+			wantErr: domain.ErrInvalidMatchupResult,
+		},
+		{
+			name:    "period must be in the season",
+			view:    inSeason,
+			result:  result(21, 3, 4, 90, 85),
+			wantErr: domain.ErrInvalidMatchupResult,
+		},
+		{
+			name:    "both teams must be in the league",
+			view:    inSeason,
+			result:  result(2, 3, 9, 90, 85),
+			wantErr: domain.ErrTeamNotInLeague,
+		},
+		{
+			name:    "a team plays once a period",
+			view:    inSeason,
+			result:  result(1, 2, 3, 90, 85),
+			wantErr: domain.ErrMatchupResultRecorded,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			events, err := tc.view.DecideRecordMatchupResult(tc.result, testkit.TodayLock())
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Equal(t, len(events), 0)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, len(events), 1)
+
+			view := tc.view
+			view.Apply(events[0])
+			assert.Equal(t, view.Results[len(view.Results)-1], tc.result)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// HittingLine holds counting stats credited to active hitters.
+type HittingLine struct {
+	AtBats       int
+	Hits         int
+	Runs         int
+	HomeRuns     int
+	RunsBattedIn int
+	StolenBases  int
+	Walks        int
+}
+
+func (l HittingLine) Add(o HittingLine) HittingLine {
+	return HittingLine{
+		AtBats:       l.AtBats + o.AtBats,
+		Hits:         l.Hits + o.Hits,
+		Runs:         l.Runs + o.Runs,
+		HomeRuns:     l.HomeRuns + o.HomeRuns,
+		RunsBattedIn: l.RunsBattedIn + o.RunsBattedIn,
+		StolenBases:  l.StolenBases + o.StolenBases,
+		Walks:        l.Walks + o.Walks,
+	}
+}
+
+// PitchingLine holds counting stats credited to active pitchers.
+type PitchingLine struct {
+	OutsRecorded int
+	HitsAllowed  int
+	WalksAllowed int
+	EarnedRuns   int
+	Strikeouts   int
+	Wins         int
+	Saves        int
+}
+
+func (l PitchingLine) Add(o PitchingLine) PitchingLine {
+	return PitchingLine{
+		OutsRecorded: l.OutsRecorded + o.OutsRecorded,
+		HitsAllowed:  l.HitsAllowed + o.HitsAllowed,
+		WalksAllowed: l.WalksAllowed + o.WalksAllowed,
+		EarnedRuns:   l.EarnedRuns + o.EarnedRuns,
+		Strikeouts:   l.Strikeouts + o.Strikeouts,
+		Wins:         l.Wins + o.Wins,
+		Saves:        l.Saves + o.Saves,
+	}
+}
+
+// GameStats is one player's stat line from a single MLB game. Two-way players may
+// record both a hitting and a pitching line in the same game.
+//
+// Lock is the league lock the game was played under, which determines the lineup
+// that the stats are credited against.
+type GameStats struct {
+	PlayerID PlayerID
+	Lock     time.Time
+	Hitting  HittingLine
+	Pitching PitchingLine
+}
+
+type StatTotals struct {
+	Hitting  HittingLine
+	Pitching PitchingLine
+}
+
+func (t StatTotals) Add(o StatTotals) StatTotals {
+	return StatTotals{
+		Hitting:  t.Hitting.Add(o.Hitting),
+		Pitching: t.Pitching.Add(o.Pitching),
+	}
+}
+
+// Credit returns the part of a game's stats that counts for the team: the hitting
+// line if the player is an active hitter, the pitching line if the player is an
+// active pitcher, and nothing if the player is inactive or not on the roster.
+//
+// Panics if an unrecognized RosterStatus is encountered.
+func (rv RosterView) Credit(game GameStats) StatTotals {
+	e, ok := rv.Entry(game.PlayerID)
+	if !ok {
+		return StatTotals{}
+	}
+
+	switch e.RosterStatus {
+	case StatusActiveHitter:
+		return StatTotals{Hitting: game.Hitting}
+	case StatusActivePitcher:
+		return StatTotals{Pitching: game.Pitching}
+	case StatusInactive:
+		return StatTotals{}
+	default:
+		panic(fmt.Errorf("%w: %v", ErrUnrecognizedRosterStatus, e.RosterStatus))
+	}
+}
@@ -0,0 +1,76 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+func TestCredit(t *testing.T) {
+	twoWayGame := domain.GameStats{
+		PlayerID: 1,
+		Lock:     testkit.TodayLock(),
+		Hitting:  domain.HittingLine{AtBats: 4, Hits: 2, HomeRuns: 1},
+		Pitching: domain.PitchingLine{OutsRecorded: 18, Strikeouts: 10},
+	}
+
+	testCases := []struct {
+		name     string
+		hitters  int
+		pitchers int
+		game     domain.GameStats
+		want     domain.StatTotals
+	}{
+		{
+			name:    "active hitter is credited only the hitting line",
+			hitters: 1,
+			game:    twoWayGame,
+			want:    domain.StatTotals{Hitting: twoWayGame.Hitting},
+		},
+		{
+			name:     "active pitcher is credited only the pitching line",
+			pitchers: 1,
+			game:     twoWayGame,
+			want:     domain.StatTotals{Pitching: twoWayGame.Pitching},
+		},
+		{
+			name: "inactive player is credited nothing",
+			game: twoWayGame,
+			want: domain.StatTotals{},
+		},
+		{
+			name:    "player not on roster is credited nothing",
+			hitters: 1,
+			game:    domain.GameStats{PlayerID: 2, Hitting: domain.HittingLine{Hits: 3}},
+			want:    domain.StatTotals{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rv := testkit.ActivatedRosterView(
+				testkit.NewRosterView(testkit.TeamA(), 1, testkit.TodayLock()),
+				tc.hitters,
+				tc.pitchers,
+			)
+
+			assert.Equal(t, rv.Credit(tc.game), tc.want)
+		})
+	}
+
+	t.Run("panics on an unrecognized roster status", func(t *testing.T) {
+		rv := domain.RosterView{
+			TeamID: testkit.TeamA(),
+			Entries: []domain.RosterEntry{
+				{TeamID: testkit.TeamA(), PlayerID: 1, RosterStatus: domain.RosterStatus(999)},
+			},
+			EffectiveThrough: testkit.TodayLock(),
+		}
+
+		err := require.PanicsError(t, func() { _ = rv.Credit(twoWayGame) })
+		assert.ErrorIs(t, err, domain.ErrUnrecognizedRosterStatus)
+	})
+}
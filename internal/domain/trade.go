@@ -0,0 +1,294 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+type TradeID int
+
+type TradeStatus int
+
+const (
+	TradeUnderReview TradeStatus = iota + 1
+	TradeVetoed
+	TradeExecuted
+	TradeFailed
+	TradeOffered
+)
+
+func (s TradeStatus) String() string {
+	switch s {
+	case TradeUnderReview:
+		return "TradeUnderReview"
+	case TradeVetoed:
+		return "TradeVetoed"
+	case TradeExecuted:
+		return "TradeExecuted"
+	case TradeFailed:
+		return "TradeFailed"
+	case TradeOffered:
+		return "TradeOffered"
+	default:
+		return fmt.Sprintf("TradeStatus(%d)", int(s))
+	}
+}
+
+// TradeTerms lists the players each side of a two-team trade gives up.
+type TradeTerms struct {
+	Proposer      TeamID
+	Receiver      TeamID
+	ProposerSends []PlayerID
+	ReceiverSends []PlayerID
+}
+
+// Involves reports whether the team is a party to the trade.
+func (tt TradeTerms) Involves(id TeamID) bool {
+	return tt.Proposer == id || tt.Receiver == id
+}
+
+func (tt TradeTerms) equal(other TradeTerms) bool {
+	return tt.Proposer == other.Proposer &&
+		tt.Receiver == other.Receiver &&
+		slices.Equal(tt.ProposerSends, other.ProposerSends) &&
+		slices.Equal(tt.ReceiverSends, other.ReceiverSends)
+}
+
+func (tt TradeTerms) validate() error {
+	if tt.Proposer == tt.Receiver {
+		return fmt.Errorf("%w: team %v cannot trade with itself", ErrInvalidTradeTerms, tt.Proposer)
+	}
+
+	if len(tt.ProposerSends) == 0 && len(tt.ReceiverSends) == 0 {
+		return fmt.Errorf("%w: no players exchanged", ErrInvalidTradeTerms)
+	}
+
+	for _, id := range tt.ProposerSends {
+		if slices.Contains(tt.ReceiverSends, id) {
+			return fmt.Errorf("%w: player %v on both sides", ErrInvalidTradeTerms, id)
+		}
+	}
+
+	return nil
+}
+
+// TradeReviewPolicy configures how long accepted trades stay open to a veto and
+// how many league votes it takes to veto one.
+type TradeReviewPolicy struct {
+	Window            time.Duration
+	VetoVotesRequired int
+}
+
+type TradeView struct {
+	TradeID           TradeID
+	Terms             TradeTerms
+	Status            TradeStatus
+	ReviewEndsAt      time.Time
+	VetoVotesRequired int
+	VetoVoters        []TeamID
+}
+
+// DecidePropose returns the ProposedTrade events that offer the trade if allowed.
+func (tv TradeView) DecidePropose(terms TradeTerms, by UserID, at time.Time) ([]TradeEvent, error) {
+	if tv.Status != 0 {
+		return nil, ErrTradeAlreadyOffered
+	}
+
+	err := terms.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	res := []TradeEvent{
+		ProposedTrade{
+			TradeID:    tv.TradeID,
+			Terms:      terms,
+			ProposedBy: by,
+			ProposedAt: at,
+		},
+	}
+
+	return res, nil
+}
+
+// DecideAccept returns the AcceptedTrade events that open the review window if allowed.
+// A trade that was offered can only be accepted on the terms offered; one agreed
+// outside dugout can be accepted without an offer.
+func (tv TradeView) DecideAccept(terms TradeTerms, policy TradeReviewPolicy, at time.Time) ([]TradeEvent, error) {
+	if tv.Status != 0 && tv.Status != TradeOffered {
+		return nil, ErrTradeAlreadyAccepted
+	}
+
+	err := terms.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if tv.Status == TradeOffered && !tv.Terms.equal(terms) {
+		return nil, fmt.Errorf("%w: terms differ from the offer", ErrInvalidTradeTerms)
+	}
+
+	res := []TradeEvent{
+		AcceptedTrade{
+			TradeID:           tv.TradeID,
+			Terms:             terms,
+			ReviewEndsAt:      at.Add(policy.Window),
+			VetoVotesRequired: policy.VetoVotesRequired,
+			AcceptedAt:        at,
+		},
+	}
+
+	return res, nil
+}
+
+// DecideCastVetoVote returns the CastTradeVetoVote events that should be recorded if allowed,
+// followed by a VetoedTrade event when the vote reaches the required count.
+func (tv TradeView) DecideCastVetoVote(voter TeamID, at time.Time) ([]TradeEvent, error) {
+	err := tv.validateUnderReview(at)
+	if err != nil {
+		return nil, err
+	}
+
+	if tv.Terms.Involves(voter) {
+		return nil, ErrTradeParticipantCannotVote
+	}
+
+	if slices.Contains(tv.VetoVoters, voter) {
+		return nil, ErrAlreadyVotedOnTrade
+	}
+
+	res := []TradeEvent{
+		CastTradeVetoVote{
+			TradeID: tv.TradeID,
+			Voter:   voter,
+			CastAt:  at,
+		},
+	}
+
+	if tv.VetoVotesRequired > 0 && len(tv.VetoVoters)+1 >= tv.VetoVotesRequired {
+		res = append(res, VetoedTrade{
+			TradeID:  tv.TradeID,
+			VetoedAt: at,
+		})
+	}
+
+	return res, nil
+}
+
+// DecideCommissionerVeto returns the VetoedTrade events that should be recorded if allowed.
+func (tv TradeView) DecideCommissionerVeto(commissioner UserID, at time.Time) ([]TradeEvent, error) {
+	err := tv.validateUnderReview(at)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []TradeEvent{
+		VetoedTrade{
+			TradeID:      tv.TradeID,
+			Commissioner: commissioner,
+			VetoedAt:     at,
+		},
+	}
+
+	return res, nil
+}
+
+// DecideExecute returns the ExecutedTrade events that should be recorded once the
+// review window has closed without a veto.
+func (tv TradeView) DecideExecute(at, effective time.Time) ([]TradeEvent, error) {
+	err := tv.validateReviewClosed(at)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []TradeEvent{
+		ExecutedTrade{
+			TradeID:     tv.TradeID,
+			EffectiveAt: effective,
+			ExecutedAt:  at,
+		},
+	}
+
+	return res, nil
+}
+
+// DecideFail returns the FailedTrade events that should be recorded when a trade
+// whose review has closed cannot be applied to the rosters.
+func (tv TradeView) DecideFail(at time.Time, reason string) ([]TradeEvent, error) {
+	err := tv.validateReviewClosed(at)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []TradeEvent{
+		FailedTrade{
+			TradeID:  tv.TradeID,
+			Reason:   reason,
+			FailedAt: at,
+		},
+	}
+
+	return res, nil
+}
+
+// ReviewClosed reports whether the trade's review window has ended at the given time.
+func (tv TradeView) ReviewClosed(at time.Time) bool {
+	return !at.Before(tv.ReviewEndsAt)
+}
+
+// Apply applies a trade domain event to the view.
+//
+// Events that do not belong to this trade or are unrecognized cause Apply to panic.
+func (tv *TradeView) Apply(event TradeEvent) {
+	if tv.TradeID != event.Trade() {
+		panic(fmt.Errorf("%w: event trade %v, view trade %v", ErrWrongTradeID, event.Trade(), tv.TradeID))
+	}
+
+	switch ev := event.(type) {
+	case ProposedTrade:
+		tv.Terms = ev.Terms
+		tv.Status = TradeOffered
+	case AcceptedTrade:
+		tv.Terms = ev.Terms
+		tv.Status = TradeUnderReview
+		tv.ReviewEndsAt = ev.ReviewEndsAt
+		tv.VetoVotesRequired = ev.VetoVotesRequired
+	case CastTradeVetoVote:
+		if !slices.Contains(tv.VetoVoters, ev.Voter) {
+			tv.VetoVoters = append(tv.VetoVoters, ev.Voter)
+		}
+	case VetoedTrade:
+		tv.Status = TradeVetoed
+	case ExecutedTrade:
+		tv.Status = TradeExecuted
+	case FailedTrade:
+		tv.Status = TradeFailed
+	default:
+		panic(fmt.Errorf("%w: %T", ErrUnrecognizedTradeEvent, event))
+	}
+}
+
+func (tv TradeView) validateUnderReview(at time.Time) error {
+	if tv.Status != TradeUnderReview {
+		return ErrTradeNotUnderReview
+	}
+
+	if tv.ReviewClosed(at) {
+		return ErrTradeReviewClosed
+	}
+
+	return nil
+}
+
+func (tv TradeView) validateReviewClosed(at time.Time) error {
+	if tv.Status != TradeUnderReview {
+		return ErrTradeNotUnderReview
+	}
+
+	if !tv.ReviewClosed(at) {
+		return ErrTradeReviewOpen
+	}
+
+	return nil
+}
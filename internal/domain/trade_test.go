@@ -0,0 +1,272 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+func underReviewTrade(votes ...domain.TeamID) domain.TradeView {
+	return domain.TradeView{
+		TradeID: 1,
+		Terms: domain.TradeTerms{
+			Proposer:      testkit.TeamA(),
+			Receiver:      testkit.TeamB(),
+			ProposerSends: []domain.PlayerID{1},
+			ReceiverSends: []domain.PlayerID{2},
+		},
+		Status:            domain.TradeUnderReview,
+		ReviewEndsAt:      testkit.TomorrowLock(),
+		VetoVotesRequired: 2,
+		VetoVoters:        votes,
+	}
+}
+
+func TestDecideAccept(t *testing.T) {
+	policy := domain.TradeReviewPolicy{Window: 48 * time.Hour, VetoVotesRequired: 3}
+
+	testCases := []struct {
+		name    string
+		view    domain.TradeView
+		terms   domain.TradeTerms
+		wantErr error
+	}{
+		{
+			name: "accept opens a review window",
+			view: domain.TradeView{TradeID: 1},
+			terms: domain.TradeTerms{
+				Proposer:      testkit.TeamA(),
+				Receiver:      testkit.TeamB(),
+				ProposerSends: []domain.PlayerID{1},
+			},
+		},
+		{
+			name:  "accept an offer on its terms",
+			view:  domain.TradeView{TradeID: 1, Terms: underReviewTrade().Terms, Status: domain.TradeOffered},
+			terms: underReviewTrade().Terms,
+		},
+		{
+			name: "reject accepting an offer on other terms",
+			view: domain.TradeView{TradeID: 1, Terms: underReviewTrade().Terms, Status: domain.TradeOffered},
+			terms: domain.TradeTerms{
+				Proposer:      testkit.TeamA(),
+				Receiver:      testkit.TeamB(),
+				ProposerSends: []domain.PlayerID{1},
+			},
+			wantErr: domain.ErrInvalidTradeTerms,
+		},
+		{
+			name: "reject accepting a trade twice",
+			view: underReviewTrade(),
+			terms: domain.TradeTerms{
+				Proposer:      testkit.TeamA(),
+				Receiver:      testkit.TeamB(),
+				ProposerSends: []domain.PlayerID{1},
+			},
+			wantErr: domain.ErrTradeAlreadyAccepted,
+		},
+		{
+			name: "reject a team trading with itself",
+			view: domain.TradeView{TradeID: 1},
+			terms: domain.TradeTerms{
+				Proposer:      testkit.TeamA(),
+				Receiver:      testkit.TeamA(),
+				ProposerSends: []domain.PlayerID{1},
+			},
+			wantErr: domain.ErrInvalidTradeTerms,
+		},
+		{
+			name: "reject a trade with no players",
+			view: domain.TradeView{TradeID: 1},
+			terms: domain.TradeTerms{
+				Proposer: testkit.TeamA(),
+				Receiver: testkit.TeamB(),
+			},
+			wantErr: domain.ErrInvalidTradeTerms,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			events, err := tc.view.DecideAccept(tc.terms, policy, testkit.TodayLock())
+
+			if tc.wantErr != nil {
+				assert.Nil(t, events)
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			require.Equal(t, len(events), 1)
+
+			ev, ok := events[0].(domain.AcceptedTrade)
+			require.True(t, ok)
+			assert.Equal(t, ev.ReviewEndsAt, testkit.TodayLock().Add(policy.Window))
+			assert.Equal(t, ev.VetoVotesRequired, policy.VetoVotesRequired)
+		})
+	}
+}
+
+func TestDecidePropose(t *testing.T) {
+	terms := underReviewTrade().Terms
+
+	t.Run("propose offers the trade", func(t *testing.T) {
+		events, err := domain.TradeView{TradeID: 1}.DecidePropose(terms, testkit.ManagerA(), testkit.TodayLock())
+		require.NoError(t, err)
+		require.Equal(t, len(events), 1)
+
+		view := domain.TradeView{TradeID: 1}
+		view.Apply(events[0])
+		assert.Equal(t, view.Status, domain.TradeOffered)
+		assert.Equal(t, view.Terms.Receiver, testkit.TeamB())
+	})
+
+	t.Run("reject offering a trade twice", func(t *testing.T) {
+		offered := domain.TradeView{TradeID: 1, Terms: terms, Status: domain.TradeOffered}
+
+		events, err := offered.DecidePropose(terms, testkit.ManagerA(), testkit.TodayLock())
+		assert.Nil(t, events)
+		assert.ErrorIs(t, err, domain.ErrTradeAlreadyOffered)
+	})
+
+	t.Run("reject invalid terms", func(t *testing.T) {
+		events, err := domain.TradeView{TradeID: 1}.DecidePropose(domain.TradeTerms{Proposer: testkit.TeamA(), Receiver: testkit.TeamA()}, testkit.ManagerA(), testkit.TodayLock())
+		assert.Nil(t, events)
+		assert.ErrorIs(t, err, domain.ErrInvalidTradeTerms)
+	})
+}
+
+func TestDecideCastVetoVote(t *testing.T) {
+	testCases := []struct {
+		name       string
+		view       domain.TradeView
+		voter      domain.TeamID
+		at         time.Time
+		wantVetoed bool
+		wantErr    error
+	}{
+		{
+			name:  "vote below threshold is recorded without a veto",
+			view:  underReviewTrade(),
+			voter: testkit.TeamC(),
+			at:    testkit.TodayLock(),
+		},
+		{
+			name:       "vote reaching threshold also vetoes the trade",
+			view:       underReviewTrade(domain.TeamID(444)),
+			voter:      testkit.TeamC(),
+			at:         testkit.TodayLock(),
+			wantVetoed: true,
+		},
+		{
+			name:    "reject vote from a team in the trade",
+			view:    underReviewTrade(),
+			voter:   testkit.TeamA(),
+			at:      testkit.TodayLock(),
+			wantErr: domain.ErrTradeParticipantCannotVote,
+		},
+		{
+			name:    "reject a second vote from the same team",
+			view:    underReviewTrade(testkit.TeamC()),
+			voter:   testkit.TeamC(),
+			at:      testkit.TodayLock(),
+			wantErr: domain.ErrAlreadyVotedOnTrade,
+		},
+		{
+			name:    "reject vote once the review window closes",
+			view:    underReviewTrade(),
+			voter:   testkit.TeamC(),
+			at:      testkit.TomorrowLock(),
+			wantErr: domain.ErrTradeReviewClosed,
+		},
+		{
+			name:    "reject vote on a trade no longer under review",
+			view:    domain.TradeView{TradeID: 1, Status: domain.TradeVetoed},
+			voter:   testkit.TeamC(),
+			at:      testkit.TodayLock(),
+			wantErr: domain.ErrTradeNotUnderReview,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			events, err := tc.view.DecideCastVetoVote(tc.voter, tc.at)
+
+			if tc.wantErr != nil {
+				assert.Nil(t, events)
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			require.True(t, len(events) > 0)
+
+			vote, ok := events[0].(domain.CastTradeVetoVote)
+			require.True(t, ok)
+			assert.Equal(t, vote.Voter, tc.voter)
+
+			if tc.wantVetoed {
+				require.Equal(t, len(events), 2)
+				_, ok := events[1].(domain.VetoedTrade)
+				assert.True(t, ok)
+			} else {
+				assert.Equal(t, len(events), 1)
+			}
+		})
+	}
+}
+
+func TestDecideExecute(t *testing.T) {
+	t.Run("reject executing while the review window is open", func(t *testing.T) {
+		events, err := underReviewTrade().DecideExecute(testkit.TodayLock(), testkit.TomorrowLock())
+
+		assert.Nil(t, events)
+		assert.ErrorIs(t, err, domain.ErrTradeReviewOpen)
+	})
+
+	t.Run("execute once the review window closes", func(t *testing.T) {
+		events, err := underReviewTrade().DecideExecute(testkit.TomorrowLock(), testkit.TomorrowLock())
+
+		assert.NoError(t, err)
+		require.Equal(t, len(events), 1)
+
+		ev, ok := events[0].(domain.ExecutedTrade)
+		require.True(t, ok)
+		assert.Equal(t, ev.EffectiveAt, testkit.TomorrowLock())
+	})
+
+	t.Run("reject commissioner veto after the review window closes", func(t *testing.T) {
+		events, err := underReviewTrade().DecideCommissionerVeto(1, testkit.TomorrowLock())
+
+		assert.Nil(t, events)
+		assert.ErrorIs(t, err, domain.ErrTradeReviewClosed)
+	})
+}
+
+func TestTradeViewApply(t *testing.T) {
+	tv := domain.TradeView{TradeID: 1}
+
+	tv.Apply(domain.AcceptedTrade{
+		TradeID:           1,
+		Terms:             underReviewTrade().Terms,
+		ReviewEndsAt:      testkit.TomorrowLock(),
+		VetoVotesRequired: 2,
+		AcceptedAt:        testkit.TodayLock(),
+	})
+	assert.Equal(t, tv.Status, domain.TradeUnderReview)
+
+	tv.Apply(domain.CastTradeVetoVote{TradeID: 1, Voter: testkit.TeamC(), CastAt: testkit.TodayLock()})
+	assert.Equal(t, tv.VetoVoters, []domain.TeamID{testkit.TeamC()})
+
+	tv.Apply(domain.VetoedTrade{TradeID: 1, VetoedAt: testkit.TodayLock()})
+	assert.Equal(t, tv.Status, domain.TradeVetoed)
+
+	fn := func() { tv.Apply(domain.ExecutedTrade{TradeID: 2}) }
+
+	err := require.PanicsError(t, fn)
+	require.ErrorIs(t, err, domain.ErrWrongTradeID)
+}
@@ -0,0 +1,208 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spcameron/dugout/internal/eventlog"
+)
+
+// MatchupCalendar divides a season into matchup periods of PeriodDays calendar
+// days, counted from the date of SeasonStart in its location. Periods begin at
+// midnight, so they follow the calendar rather than elapsed hours and a lock
+// keeps its period across daylight saving changes. The regular season runs for
+// Periods periods; zero leaves its end open.
+type MatchupCalendar struct {
+	SeasonStart time.Time
+	PeriodDays  int
+	Periods     int
+}
+
+// Scheduled reports whether the calendar has been set.
+func (c MatchupCalendar) Scheduled() bool {
+	return !c.SeasonStart.IsZero() && c.PeriodDays > 0
+}
+
+// Period returns the bounds of the matchup period containing at, as a half-open
+// interval [start, end). Times before the season fall in a period that ends on
+// the season's first day.
+func (c MatchupCalendar) Period(at time.Time) (time.Time, time.Time) {
+	loc := c.SeasonStart.Location()
+	days := civilDay(at.In(loc)) - civilDay(c.SeasonStart)
+	if days < 0 {
+		return time.Time{}, c.periodStart(0)
+	}
+
+	n := days / c.PeriodDays
+
+	return c.periodStart(n), c.periodStart(n + 1)
+}
+
+// SeasonEnd returns the end of the last period, or the zero time if the season
+// has no set length.
+func (c MatchupCalendar) SeasonEnd() time.Time {
+	if !c.Scheduled() || c.Periods == 0 {
+		return time.Time{}
+	}
+
+	return c.periodStart(c.Periods)
+}
+
+// DaysElapsed counts the calendar days of the season before the day of at.
+func (c MatchupCalendar) DaysElapsed(at time.Time) int {
+	if !c.Scheduled() {
+		return 0
+	}
+
+	return max(civilDay(at.In(c.SeasonStart.Location()))-civilDay(c.SeasonStart), 0)
+}
+
+// DaysLeft counts the calendar days of the season from the day of at onward.
+func (c MatchupCalendar) DaysLeft(at time.Time) int {
+	end := c.SeasonEnd()
+	if end.IsZero() {
+		return 0
+	}
+
+	return max(civilDay(end)-max(civilDay(at.In(end.Location())), civilDay(c.SeasonStart)), 0)
+}
+
+func (c MatchupCalendar) periodStart(n int) time.Time {
+	y, m, d := c.SeasonStart.Date()
+
+	return time.Date(y, m, d+n*c.PeriodDays, 0, 0, 0, 0, c.SeasonStart.Location())
+}
+
+// civilDay numbers the calendar date of t, ignoring its time of day.
+func civilDay(t time.Time) int {
+	y, m, d := t.Date()
+
+	return int(time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / (24 * 60 * 60))
+}
+
+// TransactionLimits cap how often managers may change their rosters. Zero means
+// no limit. Adds count toward the season cap from the season's start, so adds
+// made during the draft are free.
+type TransactionLimits struct {
+	AddsPerPeriod        int
+	AddsPerSeason        int
+	LineupMovesPerPeriod int
+}
+
+func (l TransactionLimits) Validate() error {
+	if l.AddsPerPeriod < 0 || l.AddsPerSeason < 0 || l.LineupMovesPerPeriod < 0 {
+		return fmt.Errorf("%w: transaction limits cannot be negative", ErrInvalidLeagueSettings)
+	}
+
+	return nil
+}
+
+func (l TransactionLimits) perPeriod() bool {
+	return l.AddsPerPeriod > 0 || l.LineupMovesPerPeriod > 0
+}
+
+// TransactionRules are the limits a roster view enforces, with the calendar that
+// defines their periods. The zero value enforces nothing.
+type TransactionRules struct {
+	Limits   TransactionLimits
+	Calendar MatchupCalendar
+}
+
+type TransactionKind int
+
+const (
+	TransactionAdd TransactionKind = iota + 1
+	TransactionLineupMove
+)
+
+// Transaction is a roster change that counts toward the league's limits.
+// Activations and inactivations are both lineup moves. Sequence is that of the
+// event that made it, or zero for a pending one.
+type Transaction struct {
+	Kind        TransactionKind
+	Sequence    eventlog.Sequence
+	PlayerID    PlayerID
+	EffectiveAt time.Time
+}
+
+// TransactionUsage counts the transactions made in the current period and
+// season.
+type TransactionUsage struct {
+	AddsThisPeriod int
+	AddsThisSeason int
+}
+
+// Usage counts the adds made in the matchup period containing the view's lock,
+// and since the season started. Adds a commissioner forced or reversed, and
+// players received in trades, are not counted.
+func (rv RosterView) Usage() TransactionUsage {
+	var u TransactionUsage
+	start, end := rv.period()
+
+	for _, tx := range rv.Transactions {
+		if tx.Kind != TransactionAdd {
+			continue
+		}
+
+		if !tx.EffectiveAt.Before(rv.Rules.Calendar.SeasonStart) {
+			u.AddsThisSeason++
+		}
+
+		if rv.Rules.Calendar.Scheduled() && !tx.EffectiveAt.Before(start) && tx.EffectiveAt.Before(end) {
+			u.AddsThisPeriod++
+		}
+	}
+
+	return u
+}
+
+// lineupMovesThisPeriod counts the player's activations and inactivations in the
+// matchup period containing the view's lock.
+func (rv RosterView) lineupMovesThisPeriod(id PlayerID) int {
+	start, end := rv.period()
+
+	var n int
+	for _, tx := range rv.Transactions {
+		if tx.Kind == TransactionLineupMove && tx.PlayerID == id && !tx.EffectiveAt.Before(start) && tx.EffectiveAt.Before(end) {
+			n++
+		}
+	}
+
+	return n
+}
+
+func (rv RosterView) period() (time.Time, time.Time) {
+	if !rv.Rules.Calendar.Scheduled() {
+		return time.Time{}, time.Time{}
+	}
+
+	return rv.Rules.Calendar.Period(rv.EffectiveThrough)
+}
+
+func (rv RosterView) validateAddLimits() error {
+	limits := rv.Rules.Limits
+	u := rv.Usage()
+
+	if limits.AddsPerPeriod > 0 && u.AddsThisPeriod >= limits.AddsPerPeriod {
+		return fmt.Errorf("%w: %d of %d used", ErrPeriodAddLimitReached, u.AddsThisPeriod, limits.AddsPerPeriod)
+	}
+
+	if limits.AddsPerSeason > 0 && u.AddsThisSeason >= limits.AddsPerSeason {
+		return fmt.Errorf("%w: %d of %d used", ErrSeasonAddLimitReached, u.AddsThisSeason, limits.AddsPerSeason)
+	}
+
+	return nil
+}
+
+func (rv RosterView) validateLineupMoveLimit(id PlayerID) error {
+	limit := rv.Rules.Limits.LineupMovesPerPeriod
+	if limit == 0 || !rv.Rules.Calendar.Scheduled() {
+		return nil
+	}
+
+	if used := rv.lineupMovesThisPeriod(id); used >= limit {
+		return fmt.Errorf("%w: player %v moved %d of %d times", ErrLineupMoveLimitReached, id, used, limit)
+	}
+
+	return nil
+}
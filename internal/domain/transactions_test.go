@@ -0,0 +1,304 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+// weeklyCalendar starts the season on the Monday before TodayLock, so TodayLock
+// falls on the last day of the first period.
+func weeklyCalendar() domain.MatchupCalendar {
+	return domain.MatchupCalendar{
+		SeasonStart: testkit.TodayLock().AddDate(0, 0, -6),
+		PeriodDays:  7,
+	}
+}
+
+func TestMatchupCalendarPeriod(t *testing.T) {
+	calendar := weeklyCalendar()
+	start := calendar.SeasonStart
+
+	testCases := []struct {
+		name      string
+		at        time.Time
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			name:      "season start opens the first period",
+			at:        start,
+			wantStart: start,
+			wantEnd:   start.AddDate(0, 0, 7),
+		},
+		{
+			name:      "last day of the first period",
+			at:        testkit.TodayLock(),
+			wantStart: start,
+			wantEnd:   start.AddDate(0, 0, 7),
+		},
+		{
+			name:      "period boundary after a daylight saving change",
+			at:        testkit.TomorrowLock(),
+			wantStart: testkit.TomorrowLock(),
+			wantEnd:   testkit.TomorrowLock().AddDate(0, 0, 7),
+		},
+		{
+			name:      "later in the season",
+			at:        start.AddDate(0, 0, 20),
+			wantStart: start.AddDate(0, 0, 14),
+			wantEnd:   start.AddDate(0, 0, 21),
+		},
+		{
+			name:      "before the season",
+			at:        start.Add(-time.Hour),
+			wantStart: time.Time{},
+			wantEnd:   start,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotStart, gotEnd := calendar.Period(tc.at)
+
+			assert.True(t, gotStart.Equal(tc.wantStart))
+			assert.True(t, gotEnd.Equal(tc.wantEnd))
+		})
+	}
+}
+
+func TestMatchupCalendarDays(t *testing.T) {
+	calendar := weeklyCalendar()
+	calendar.Periods = 2
+
+	testCases := []struct {
+		name        string
+		at          time.Time
+		wantElapsed int
+		wantLeft    int
+	}{
+		{name: "season start", at: calendar.SeasonStart, wantElapsed: 0, wantLeft: 14},
+		{name: "across a daylight saving change", at: testkit.TomorrowLock(), wantElapsed: 7, wantLeft: 7},
+		{name: "before the season", at: calendar.SeasonStart.AddDate(0, 0, -3), wantElapsed: 0, wantLeft: 14},
+		{name: "after the season", at: calendar.SeasonEnd().AddDate(0, 0, 1), wantElapsed: 15, wantLeft: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, calendar.DaysElapsed(tc.at), tc.wantElapsed)
+			assert.Equal(t, calendar.DaysLeft(tc.at), tc.wantLeft)
+		})
+	}
+}
+
+func TestTransactionLimits(t *testing.T) {
+	calendar := weeklyCalendar()
+	lastPeriod := calendar.SeasonStart.AddDate(0, 0, -1)
+
+	added := func(id domain.PlayerID, at time.Time) domain.RosterEvent {
+		return domain.AddedPlayerToRoster{
+			TeamID:      testkit.TeamA(),
+			PlayerID:    id,
+			Eligibility: domain.MustRoleSet(domain.RoleHitter),
+			EffectiveAt: at,
+		}
+	}
+
+	viewWith := func(limits domain.TransactionLimits, lock time.Time, events ...domain.RosterEvent) domain.RosterView {
+		rv := testkit.NewRosterView(testkit.TeamA(), 0, lock)
+		for _, ev := range events {
+			rv.Apply(ev)
+		}
+		rv.Rules = domain.TransactionRules{Limits: limits, Calendar: calendar}
+
+		return rv
+	}
+
+	testCases := []struct {
+		name    string
+		view    domain.RosterView
+		wantErr error
+	}{
+		{
+			name: "accept add below the period limit",
+			view: viewWith(
+				domain.TransactionLimits{AddsPerPeriod: 2},
+				testkit.TodayLock(),
+				added(1, calendar.SeasonStart),
+			),
+		},
+		{
+			name: "reject add at the period limit",
+			view: viewWith(
+				domain.TransactionLimits{AddsPerPeriod: 2},
+				testkit.TodayLock(),
+				added(1, calendar.SeasonStart),
+				added(2, testkit.TodayLock()),
+			),
+			wantErr: domain.ErrPeriodAddLimitReached,
+		},
+		{
+			name: "accept add when earlier adds fall in a past period",
+			view: viewWith(
+				domain.TransactionLimits{AddsPerPeriod: 1},
+				testkit.TomorrowLock(),
+				added(1, testkit.TodayLock()),
+			),
+		},
+		{
+			name: "reject add at the season limit",
+			view: viewWith(
+				domain.TransactionLimits{AddsPerSeason: 2},
+				testkit.TomorrowLock(),
+				added(1, calendar.SeasonStart),
+				added(2, testkit.TodayLock()),
+			),
+			wantErr: domain.ErrSeasonAddLimitReached,
+		},
+		{
+			name: "adds before the season do not count toward the season limit",
+			view: viewWith(
+				domain.TransactionLimits{AddsPerSeason: 1},
+				testkit.TodayLock(),
+				added(1, lastPeriod),
+				added(2, lastPeriod),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			events, err := tc.view.DecideAddPlayer(99, domain.MustRoleSet(domain.RoleHitter))
+
+			if tc.wantErr != nil {
+				assert.Nil(t, events)
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, len(events), 1)
+		})
+	}
+
+	t.Run("reject lineup moves past the period limit", func(t *testing.T) {
+		rv := viewWith(
+			domain.TransactionLimits{LineupMovesPerPeriod: 2},
+			testkit.TodayLock(),
+			added(1, lastPeriod),
+			added(2, lastPeriod),
+			domain.ActivatedPlayerOnRoster{TeamID: testkit.TeamA(), PlayerID: 1, PlayerRole: domain.RoleHitter, EffectiveAt: calendar.SeasonStart},
+			domain.InactivatedPlayerOnRoster{TeamID: testkit.TeamA(), PlayerID: 1, EffectiveAt: testkit.TodayLock()},
+		)
+
+		events, err := rv.DecideActivatePlayer(1, domain.RoleHitter)
+
+		assert.Nil(t, events)
+		assert.ErrorIs(t, err, domain.ErrLineupMoveLimitReached)
+
+		_, err = rv.DecideActivatePlayer(2, domain.RoleHitter)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("zero rules enforce nothing", func(t *testing.T) {
+		rv := viewWith(domain.TransactionLimits{}, testkit.TodayLock(), added(1, calendar.SeasonStart))
+		rv.Rules = domain.TransactionRules{}
+
+		_, err := rv.DecideAddPlayer(99, domain.MustRoleSet(domain.RoleHitter))
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestRosterViewUsage(t *testing.T) {
+	calendar := weeklyCalendar()
+	rv := testkit.NewRosterView(testkit.TeamA(), 0, testkit.TomorrowLock())
+	rv.Rules = domain.TransactionRules{Calendar: calendar}
+
+	for i, at := range []time.Time{calendar.SeasonStart.AddDate(0, 0, -1), testkit.TodayLock(), testkit.TomorrowLock()} {
+		rv.Apply(domain.AddedPlayerToRoster{
+			TeamID:      testkit.TeamA(),
+			PlayerID:    domain.PlayerID(i + 1),
+			Eligibility: domain.MustRoleSet(domain.RoleHitter),
+			EffectiveAt: at,
+		})
+	}
+
+	assert.Equal(t, rv.Usage(), domain.TransactionUsage{AddsThisPeriod: 1, AddsThisSeason: 2})
+}
+
+func TestRosterViewUsageExemptions(t *testing.T) {
+	calendar := weeklyCalendar()
+	today := testkit.TodayLock()
+
+	added := func(id domain.PlayerID) domain.RosterEvent {
+		return domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: id, Eligibility: domain.MustRoleSet(domain.RoleHitter), EffectiveAt: today}
+	}
+	removed := func(id domain.PlayerID) domain.RosterEvent {
+		return domain.RemovedPlayerFromRoster{TeamID: testkit.TeamA(), PlayerID: id, EffectiveAt: today}
+	}
+	override := func(kind domain.OverrideKind, reverses eventlog.Sequence) domain.RosterEvent {
+		return domain.RecordedRosterOverride{TeamID: testkit.TeamA(), Actor: testkit.Commissioner(), Kind: kind, Reason: "correction", Reverses: reverses, EffectiveAt: today}
+	}
+
+	testCases := []struct {
+		name   string
+		events []domain.RosterEvent
+		want   int
+	}{
+		{
+			name:   "adds count",
+			events: []domain.RosterEvent{added(1), added(2)},
+			want:   2,
+		},
+		{
+			name:   "forced adds do not count",
+			events: []domain.RosterEvent{added(1), added(2), override(domain.OverrideForcedAdd, 0)},
+			want:   1,
+		},
+		{
+			name: "players received in a trade do not count",
+			events: []domain.RosterEvent{
+				added(1),
+				added(2),
+				domain.ReceivedInTrade{TeamID: testkit.TeamA(), PlayerID: 2, TradeID: 5, EffectiveAt: today},
+			},
+			want: 1,
+		},
+		{
+			name:   "reversed adds do not count",
+			events: []domain.RosterEvent{added(1), added(2), removed(1), override(domain.OverrideReversal, 1)},
+			want:   1,
+		},
+		{
+			name:   "the add restoring a reversed removal does not count",
+			events: []domain.RosterEvent{added(1), removed(1), added(1), override(domain.OverrideReversal, 2)},
+			want:   1,
+		},
+		{
+			name:   "forced removals exempt no add",
+			events: []domain.RosterEvent{added(1), added(2), removed(2), override(domain.OverrideForcedRemove, 0)},
+			want:   2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rv := testkit.NewRosterView(testkit.TeamA(), 0, today)
+			rv.Rules = domain.TransactionRules{Calendar: calendar}
+
+			for i, ev := range tc.events {
+				err := rv.TryApplyRecorded(eventlog.Recorded[domain.RosterEvent]{Sequence: eventlog.Sequence(i + 1), Event: ev})
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, rv.Usage(), domain.TransactionUsage{AddsThisPeriod: tc.want, AddsThisSeason: tc.want})
+		})
+	}
+}
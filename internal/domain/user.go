@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	MinPasswordLength = 12
+	MaxPasswordLength = 72 // bcrypt rejects anything longer
+)
+
+// User is an account that can sign in. Its password hash is kept by the user
+// repository and never leaves the account use cases.
+type User struct {
+	ID          UserID
+	Email       string
+	DisplayName string
+}
+
+// NormalizeEmail trims and lowercases an address so that sign-in is not case
+// sensitive. It does not validate.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func (u User) Validate() error {
+	addr, err := mail.ParseAddress(u.Email)
+	if err != nil || addr.Address != u.Email {
+		return fmt.Errorf("%w: %q", ErrInvalidEmail, u.Email)
+	}
+
+	if strings.TrimSpace(u.DisplayName) == "" {
+		return fmt.Errorf("%w: display name is required", ErrInvalidUser)
+	}
+
+	return nil
+}
+
+// ValidatePassword checks a new password against the length policy. Counting
+// bytes for the upper bound matches what the hasher actually uses.
+func ValidatePassword(password string) error {
+	if utf8.RuneCountInString(password) < MinPasswordLength {
+		return fmt.Errorf("%w: use at least %d characters", ErrWeakPassword, MinPasswordLength)
+	}
+
+	if len(password) > MaxPasswordLength {
+		return fmt.Errorf("%w: use at most %d bytes", ErrWeakPassword, MaxPasswordLength)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+)
+
+func TestUser_Validate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		user    domain.User
+		wantErr error
+	}{
+		{
+			name: "valid user",
+			user: domain.User{Email: "skipper@example.com", DisplayName: "Skipper"},
+		},
+		{
+			name:    "email without a domain",
+			user:    domain.User{Email: "skipper", DisplayName: "Skipper"},
+			wantErr: domain.ErrInvalidEmail,
+		},
+		{
+			name:    "email with a display name",
+			user:    domain.User{Email: "Skipper <skipper@example.com>", DisplayName: "Skipper"},
+			wantErr: domain.ErrInvalidEmail,
+		},
+		{
+			name:    "blank display name",
+			user:    domain.User{Email: "skipper@example.com", DisplayName: "  "},
+			wantErr: domain.ErrInvalidUser,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.user.Validate()
+
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidatePassword(t *testing.T) {
+	testCases := []struct {
+		name     string
+		password string
+		wantErr  error
+	}{
+		{
+			name:     "long enough",
+			password: "correct horse battery",
+		},
+		{
+			name:     "too short",
+			password: "hunter2",
+			wantErr:  domain.ErrWeakPassword,
+		},
+		{
+			name:     "too long for the hasher",
+			password: strings.Repeat("a", domain.MaxPasswordLength+1),
+			wantErr:  domain.ErrWeakPassword,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := domain.ValidatePassword(tc.password)
+
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tc.wantErr)
+			}
+		})
+	}
+}
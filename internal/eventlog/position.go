@@ -0,0 +1,12 @@
+package eventlog
+
+// Position orders events across every stream of a log. Positions only increase,
+// but they may skip values, so a reader keeps the last one it saw rather than
+// counting.
+type Position int64
+
+// Entry is a recorded event together with its position in the log.
+type Entry[E any] struct {
+	Position Position
+	Recorded[E]
+}
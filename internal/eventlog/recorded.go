@@ -5,6 +5,8 @@ import "errors"
 var (
 	ErrUnrecognizedRecordedEvent      = errors.New("unrecognized recorded event")
 	ErrDuplicateRecordedEventSequence = errors.New("duplicate recorded event sequence")
+	ErrRecordedEventNotFound          = errors.New("recorded event not found")
+	ErrUnreplayableEvent              = errors.New("recorded event cannot be replayed")
 )
 
 type Sequence int64
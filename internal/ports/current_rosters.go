@@ -0,0 +1,26 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+)
+
+// CurrentRosters reads every team's roster once all of its recorded moves have
+// taken effect, from a read model kept by a projection rather than by replaying
+// streams. It trails the log by however far the projection has yet to catch up.
+type CurrentRosters interface {
+	// ListLeague returns the entries of every roster in the league, by team and
+	// then in roster order.
+	ListLeague(ctx context.Context, league domain.LeagueID) ([]domain.RosterEntry, error)
+	// ListTeam returns the team's entries in roster order.
+	ListTeam(ctx context.Context, team domain.TeamID) ([]domain.RosterEntry, error)
+	// Owner returns the team in the league that rosters the player, or
+	// ErrPlayerNotRostered.
+	Owner(ctx context.Context, league domain.LeagueID, player domain.PlayerID) (domain.TeamID, error)
+	// Counts returns the counts of every team in the league with a player on
+	// its roster.
+	Counts(ctx context.Context, league domain.LeagueID) (map[domain.TeamID]domain.RosterCounts, error)
+	// Teams returns every team with a player on its roster, in TeamID order.
+	Teams(ctx context.Context) ([]domain.TeamID, error)
+}
@@ -1,5 +1,37 @@
 package ports
 
-import "errors"
+import (
+	"errors"
+	"fmt"
 
-var ErrVersionConflict = errors.New("version conflict detected")
+	"github.com/spcameron/dugout/internal/domain"
+)
+
+var (
+	ErrDuplicateStreamAppend = errors.New("stream appears more than once in batch")
+	ErrEmailTaken            = errors.New("email is already registered")
+	ErrLeagueNotFound        = errors.New("league not found")
+	ErrPlayerNotFound        = errors.New("player not found")
+	ErrPlayerNotRostered     = errors.New("player is not on a roster")
+	ErrSessionNotFound       = errors.New("session not found")
+	ErrTeamNotFound          = errors.New("team not found")
+	ErrUserNotFound          = errors.New("user not found")
+	ErrVersionConflict       = errors.New("version conflict detected")
+)
+
+// VersionConflictError identifies the stream whose expected version was stale.
+//
+// It matches ErrVersionConflict with errors.Is.
+type VersionConflictError struct {
+	TeamID   domain.TeamID
+	Current  Version
+	Expected Version
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("%v: team %v, current - %v, expected - %v", ErrVersionConflict, e.TeamID, e.Current, e.Expected)
+}
+
+func (e *VersionConflictError) Unwrap() error {
+	return ErrVersionConflict
+}
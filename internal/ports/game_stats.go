@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+)
+
+// GameStatsRepository serves the MLB stat lines ingested for each player.
+type GameStatsRepository interface {
+	// ListForPlayers returns every game recorded for the given players, in the
+	// order they were played.
+	ListForPlayers(ctx context.Context, ids []domain.PlayerID) ([]domain.GameStats, error)
+}
@@ -1,8 +1,13 @@
 package ports
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 type LeagueLock interface {
-	LastLock() time.Time
-	NextLock() time.Time
+	LastLock(ctx context.Context) time.Time
+	NextLock(ctx context.Context) time.Time
+	// LockAtOrAfter returns the first lock at or after t.
+	LockAtOrAfter(ctx context.Context, t time.Time) time.Time
 }
@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+)
+
+// LeagueLog reads the events of every league stream as one log.
+type LeagueLog interface {
+	// ReadAfter returns up to limit events positioned after after, in position
+	// order, as RosterLog.ReadAfter does.
+	ReadAfter(ctx context.Context, after eventlog.Position, limit int) ([]eventlog.Entry[domain.LeagueEvent], error)
+}
@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+)
+
+// LeagueStore keeps each league's event stream, which also holds the teams that
+// joined it. IDs for new leagues and teams are handed out by the store so they
+// are unique across leagues.
+type LeagueStore interface {
+	Load(ctx context.Context, id domain.LeagueID) ([]eventlog.Recorded[domain.LeagueEvent], Version, error)
+	Append(ctx context.Context, id domain.LeagueID, newEvents []domain.LeagueEvent, expected Version) (Version, error)
+	NextLeagueID(ctx context.Context) (domain.LeagueID, error)
+	NextTeamID(ctx context.Context) (domain.TeamID, error)
+}
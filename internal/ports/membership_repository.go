@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+)
+
+type MembershipRepository interface {
+	Grant(ctx context.Context, m domain.Membership) error
+	ListForUser(ctx context.Context, id domain.UserID) (domain.Memberships, error)
+	// LeagueOf returns the league a team plays in, or ErrTeamNotFound.
+	LeagueOf(ctx context.Context, team domain.TeamID) (domain.LeagueID, error)
+	// ManagersOf returns the managers and co-managers of team, in UserID order.
+	ManagersOf(ctx context.Context, team domain.TeamID) ([]domain.UserID, error)
+}
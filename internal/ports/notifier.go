@@ -0,0 +1,46 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+)
+
+// Notification is a rendered message for one user. Key is the same every time
+// the same thing is notified, so a notifier can recognize a repeat.
+type Notification struct {
+	Key       string
+	UserID    domain.UserID
+	Kind      domain.NotificationKind
+	Subject   string
+	Body      string
+	CreatedAt time.Time
+}
+
+// Notifier delivers notifications over one channel.
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+// NotificationInbox is the in-app channel: it keeps notifications for users to
+// read in dugout. Notify ignores a notification whose key the user already has.
+type NotificationInbox interface {
+	Notifier
+	// List returns up to limit of user's notifications, newest first.
+	List(ctx context.Context, user domain.UserID, limit int) ([]InboxEntry, error)
+	MarkRead(ctx context.Context, user domain.UserID, key string, at time.Time) error
+}
+
+// InboxEntry is a notification in the inbox. ReadAt is zero until it is read.
+type InboxEntry struct {
+	Notification
+	ReadAt time.Time
+}
+
+// NotificationPreferences records the channels each user has turned off. Every
+// channel is on until the user turns it off.
+type NotificationPreferences interface {
+	Enabled(ctx context.Context, user domain.UserID, channel domain.NotificationChannel) (bool, error)
+	SetEnabled(ctx context.Context, user domain.UserID, channel domain.NotificationChannel, enabled bool) error
+}
@@ -0,0 +1,33 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+)
+
+type PlayerRepository interface {
+	Get(ctx context.Context, id domain.PlayerID) (domain.Player, error)
+	Upsert(ctx context.Context, players []domain.Player) ([]domain.Player, error)
+	Search(ctx context.Context, query PlayerQuery) ([]domain.Player, error)
+}
+
+// PlayerQuery filters a player search. Empty fields match every player, and a
+// Limit that is not positive returns DefaultPlayerSearchLimit results.
+type PlayerQuery struct {
+	NamePrefix string
+	Position   domain.Position
+	MLBTeam    string
+	Limit      int
+}
+
+const DefaultPlayerSearchLimit = 50
+
+// MaxResults is the number of players the query may return.
+func (q PlayerQuery) MaxResults() int {
+	if q.Limit <= 0 {
+		return DefaultPlayerSearchLimit
+	}
+
+	return q.Limit
+}
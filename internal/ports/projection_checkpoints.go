@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/eventlog"
+)
+
+// ProjectionCheckpoints records the last log position each projection applied.
+type ProjectionCheckpoints interface {
+	// Checkpoint returns zero for a projection that has not saved one.
+	Checkpoint(ctx context.Context, projection string) (eventlog.Position, error)
+	SaveCheckpoint(ctx context.Context, projection string, pos eventlog.Position) error
+}
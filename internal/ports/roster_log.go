@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+)
+
+// RosterLog reads the events of every roster stream as one log.
+type RosterLog interface {
+	// ReadAfter returns up to limit events positioned after after, in position
+	// order. Events are positioned in commit order, so a reader that resumes from
+	// the last position it saw misses nothing.
+	ReadAfter(ctx context.Context, after eventlog.Position, limit int) ([]eventlog.Entry[domain.RosterEvent], error)
+	// LastPosition returns the position of the newest event, or zero for an
+	// empty log.
+	LastPosition(ctx context.Context) (eventlog.Position, error)
+}
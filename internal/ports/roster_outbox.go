@@ -0,0 +1,81 @@
+package ports
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+)
+
+// OutboxID orders the messages in one outbox.
+type OutboxID int64
+
+// OutboxEntry is a committed event awaiting delivery to one subscriber, as a
+// relay sees it.
+type OutboxEntry interface {
+	OutboxID() OutboxID
+	// FailedAttempts counts the earlier deliveries to the subscriber that failed.
+	FailedAttempts() int
+	// IdempotencyKey is the same every time the event is delivered, so
+	// subscribers can discard redeliveries.
+	IdempotencyKey() string
+}
+
+// OutboxMessage is a committed roster event awaiting delivery to one subscriber.
+// Attempts counts the earlier deliveries to that subscriber that failed.
+type OutboxMessage struct {
+	ID       OutboxID
+	TeamID   domain.TeamID
+	Sequence eventlog.Sequence
+	Event    domain.RosterEvent
+	Attempts int
+}
+
+func (m OutboxMessage) OutboxID() OutboxID {
+	return m.ID
+}
+
+func (m OutboxMessage) FailedAttempts() int {
+	return m.Attempts
+}
+
+func (m OutboxMessage) IdempotencyKey() string {
+	return fmt.Sprintf("roster/%d/%d", m.TeamID, m.Sequence)
+}
+
+// Outbox holds a message for every event a store commits, written in the same
+// transaction as the event, and tracks its delivery to each subscriber
+// separately.
+type Outbox[M OutboxEntry] interface {
+	// Pending returns up to limit messages subscriber has not received, oldest
+	// first, leaving out dead letters and failures not due for retry until after now.
+	Pending(ctx context.Context, subscriber string, now time.Time, limit int) ([]M, error)
+	MarkDelivered(ctx context.Context, subscriber string, id OutboxID, at time.Time) error
+	// Retry records a failed delivery to try again at retryAt.
+	Retry(ctx context.Context, subscriber string, id OutboxID, retryAt time.Time, cause string) error
+	// DeadLetter records a failed delivery that will not be tried again.
+	DeadLetter(ctx context.Context, subscriber string, id OutboxID, at time.Time, cause string) error
+}
+
+// Subscriber reacts to committed events. Delivery is at least once: a message is
+// offered again if recording its delivery fails, so Deliver must tolerate seeing
+// a message again. key is the message's IdempotencyKey, the same on every
+// delivery.
+type Subscriber[M OutboxEntry] interface {
+	Deliver(ctx context.Context, key string, msg M) error
+}
+
+// RosterOutbox holds a message for every roster event RosterStore commits.
+type RosterOutbox = Outbox[OutboxMessage]
+
+// RosterSubscriber reacts to committed roster events.
+type RosterSubscriber = Subscriber[OutboxMessage]
+
+// HandledMessages remembers the keys of the messages each subscriber has finished
+// acting on, so a subscriber can acknowledge a redelivery without acting again.
+type HandledMessages interface {
+	Handled(ctx context.Context, subscriber, key string) (bool, error)
+	MarkHandled(ctx context.Context, subscriber, key string, at time.Time) error
+}
@@ -1,6 +1,9 @@
 package ports
 
 import (
+	"context"
+	"time"
+
 	"github.com/spcameron/dugout/internal/domain"
 	"github.com/spcameron/dugout/internal/eventlog"
 )
@@ -8,6 +11,22 @@ import (
 type Version eventlog.Sequence
 
 type RosterStore interface {
-	Load(id domain.TeamID) ([]eventlog.Recorded[domain.RosterEvent], Version, error)
-	Append(id domain.TeamID, newEvents []domain.RosterEvent, expected Version) (Version, error)
+	Load(ctx context.Context, id domain.TeamID) ([]eventlog.Recorded[domain.RosterEvent], Version, error)
+	Append(ctx context.Context, id domain.TeamID, newEvents []domain.RosterEvent, expected Version) (Version, error)
+	AppendMany(ctx context.Context, appends []StreamAppend) ([]Version, error)
+}
+
+// ProjectionObserver is implemented by RosterStore decorators that want to know
+// how long callers spent projecting the streams they loaded, which the store
+// cannot see for itself.
+type ProjectionObserver interface {
+	ObserveProjection(ctx context.Context, took time.Duration)
+}
+
+// StreamAppend describes the events to append to a single team's roster stream,
+// guarded by the version the caller expects that stream to be at.
+type StreamAppend struct {
+	TeamID   domain.TeamID
+	Events   []domain.RosterEvent
+	Expected Version
 }
@@ -0,0 +1,23 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+)
+
+// StreamVersion is the version the store records for a team's roster stream.
+// It should match the stream's last sequence.
+type StreamVersion struct {
+	TeamID  domain.TeamID
+	Version Version
+}
+
+// RosterStreams lists roster streams for maintenance tasks.
+type RosterStreams interface {
+	// Streams returns every roster stream, in TeamID order.
+	Streams(ctx context.Context) ([]StreamVersion, error)
+	// ResetVersion records version for the team's stream without touching its
+	// events. It exists to repair a version that has drifted from the events.
+	ResetVersion(ctx context.Context, team domain.TeamID, version Version) error
+}
@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+)
+
+// SessionStore keeps signed-in sessions keyed by a hash of their token, so a
+// leaked table cannot be replayed as cookies.
+type SessionStore interface {
+	Create(ctx context.Context, session Session) error
+	Get(ctx context.Context, tokenHash []byte) (Session, error)
+	Delete(ctx context.Context, tokenHash []byte) error
+}
+
+type Session struct {
+	TokenHash []byte
+	UserID    domain.UserID
+	ExpiresAt time.Time
+}
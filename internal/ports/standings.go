@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+)
+
+// Standings ranks a league's teams at the end of the regular season, from the
+// matchup results recorded in the league log.
+type Standings interface {
+	// Standings returns every team in the league, from first place down.
+	Standings(ctx context.Context, id domain.LeagueID) ([]domain.TeamID, error)
+}
@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+)
+
+// TradeLog reads the events of every trade stream as one log. Trade events share
+// the roster log's positions, so a reader can follow both with one cursor.
+type TradeLog interface {
+	// ReadAfter returns up to limit events positioned after after, in position
+	// order, as RosterLog.ReadAfter does.
+	ReadAfter(ctx context.Context, after eventlog.Position, limit int) ([]eventlog.Entry[domain.TradeEvent], error)
+	// LastPosition returns the position of the newest trade event, or zero when
+	// there is none.
+	LastPosition(ctx context.Context) (eventlog.Position, error)
+}
@@ -0,0 +1,37 @@
+package ports
+
+import (
+	"fmt"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+)
+
+// TradeOutboxMessage is a committed trade event awaiting delivery to one
+// subscriber. Attempts counts the earlier deliveries to that subscriber that
+// failed.
+type TradeOutboxMessage struct {
+	ID       OutboxID
+	TradeID  domain.TradeID
+	Sequence eventlog.Sequence
+	Event    domain.TradeEvent
+	Attempts int
+}
+
+func (m TradeOutboxMessage) OutboxID() OutboxID {
+	return m.ID
+}
+
+func (m TradeOutboxMessage) FailedAttempts() int {
+	return m.Attempts
+}
+
+func (m TradeOutboxMessage) IdempotencyKey() string {
+	return fmt.Sprintf("trade/%d/%d", m.TradeID, m.Sequence)
+}
+
+// TradeOutbox holds a message for every trade event TradeStore commits.
+type TradeOutbox = Outbox[TradeOutboxMessage]
+
+// TradeSubscriber reacts to committed trade events.
+type TradeSubscriber = Subscriber[TradeOutboxMessage]
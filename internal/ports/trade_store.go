@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+)
+
+type TradeStore interface {
+	Load(ctx context.Context, id domain.TradeID) ([]eventlog.Recorded[domain.TradeEvent], Version, error)
+	Append(ctx context.Context, id domain.TradeID, newEvents []domain.TradeEvent, expected Version) (Version, error)
+	ListUnderReview(ctx context.Context) ([]domain.TradeID, error)
+}
@@ -0,0 +1,26 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+)
+
+// UserRepository stores accounts. Emails are unique after domain.NormalizeEmail;
+// Create returns ErrEmailTaken for a duplicate.
+type UserRepository interface {
+	Create(ctx context.Context, user domain.User, passwordHash []byte) (domain.User, error)
+	Get(ctx context.Context, id domain.UserID) (domain.User, error)
+	GetCredentials(ctx context.Context, email string) (Credentials, error)
+}
+
+// Credentials pair a user with the hash their password is checked against.
+type Credentials struct {
+	User         domain.User
+	PasswordHash []byte
+}
+
+type PasswordHasher interface {
+	Hash(password string) ([]byte, error)
+	Matches(hash []byte, password string) (bool, error)
+}
@@ -0,0 +1,38 @@
+package projection
+
+import (
+	"context"
+	"sync"
+
+	"github.com/spcameron/dugout/internal/eventlog"
+)
+
+// MemoryCheckpoints keeps checkpoints for the life of the process. Projections
+// that hold their state in memory use it, so that on start they apply the log
+// from the beginning rather than resuming from a position their state has lost.
+type MemoryCheckpoints struct {
+	mu        sync.Mutex
+	positions map[string]eventlog.Position
+}
+
+func (c *MemoryCheckpoints) Checkpoint(ctx context.Context, projection string) (eventlog.Position, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.positions[projection], nil
+}
+
+func (c *MemoryCheckpoints) SaveCheckpoint(ctx context.Context, projection string, pos eventlog.Position) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.positions[projection] = pos
+
+	return nil
+}
+
+func NewMemoryCheckpoints() *MemoryCheckpoints {
+	return &MemoryCheckpoints{
+		positions: make(map[string]eventlog.Position),
+	}
+}
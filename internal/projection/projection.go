@@ -0,0 +1,73 @@
+// Package projection builds read models by applying the events of a log in
+// position order.
+//
+// A Projection routes each event to the handler registered for its type and
+// ignores the rest. A Runner feeds projections from a log and saves a checkpoint
+// after each batch, so a projection resumes where it stopped. Delivery is at
+// least once: a batch that fails part way is applied again from its start, so
+// handlers must tolerate seeing an event twice, and a projection that keeps
+// state outside the process should save its checkpoint with that state.
+package projection
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/spcameron/dugout/internal/eventlog"
+)
+
+// Handler applies one event of type T to a projection.
+type Handler[E, T any] func(ctx context.Context, event T, entry eventlog.Entry[E]) error
+
+// Projection is a named set of handlers over a log of E events.
+type Projection[E any] struct {
+	name     string
+	handlers map[reflect.Type]func(context.Context, eventlog.Entry[E]) error
+	reset    func(ctx context.Context) error
+}
+
+func (p *Projection[E]) Name() string {
+	return p.name
+}
+
+// OnReset registers the function that discards everything the projection has
+// built, for Runner.Rebuild to call before applying the log from the start.
+func (p *Projection[E]) OnReset(reset func(ctx context.Context) error) {
+	p.reset = reset
+}
+
+// Apply passes entry to the handler registered for its event's type, if any.
+func (p *Projection[E]) Apply(ctx context.Context, entry eventlog.Entry[E]) error {
+	handle, ok := p.handlers[reflect.TypeOf(any(entry.Event))]
+	if !ok {
+		return nil
+	}
+
+	return handle(ctx, entry)
+}
+
+// Reset discards what the projection has built. Projections without a reset
+// function have nothing to discard.
+func (p *Projection[E]) Reset(ctx context.Context) error {
+	if p.reset == nil {
+		return nil
+	}
+
+	return p.reset(ctx)
+}
+
+// On registers handle for events of type T, replacing any handler already
+// registered for T. T is the concrete type stored in E, such as
+// domain.AddedPlayerToRoster for a log of domain.RosterEvent.
+func On[T, E any](p *Projection[E], handle Handler[E, T]) {
+	p.handlers[reflect.TypeFor[T]()] = func(ctx context.Context, entry eventlog.Entry[E]) error {
+		return handle(ctx, any(entry.Event).(T), entry)
+	}
+}
+
+func New[E any](name string) *Projection[E] {
+	return &Projection[E]{
+		name:     name,
+		handlers: make(map[reflect.Type]func(context.Context, eventlog.Entry[E]) error),
+	}
+}
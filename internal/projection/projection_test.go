@@ -0,0 +1,198 @@
+package projection_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/projection"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+var errProjectionFailed = errors.New("projection failed")
+
+// addCounter counts the adds it applies and can be made to fail on one player.
+type addCounter struct {
+	added  []domain.PlayerID
+	failOn domain.PlayerID
+	resets int
+}
+
+func (c *addCounter) projection(name string) *projection.Projection[domain.RosterEvent] {
+	p := projection.New[domain.RosterEvent](name)
+
+	projection.On(p, func(ctx context.Context, e domain.AddedPlayerToRoster, _ eventlog.Entry[domain.RosterEvent]) error {
+		if e.PlayerID == c.failOn {
+			return errProjectionFailed
+		}
+
+		c.added = append(c.added, e.PlayerID)
+
+		return nil
+	})
+
+	p.OnReset(func(ctx context.Context) error {
+		c.resets++
+		c.added = nil
+
+		return nil
+	})
+
+	return p
+}
+
+func seedAdds(store *testkit.FakeRosterStore, players ...domain.PlayerID) {
+	events := make([]domain.RosterEvent, 0, 2*len(players))
+	for _, id := range players {
+		events = append(events,
+			domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: id, EffectiveAt: testkit.TodayLock()},
+			domain.ActivatedPlayerOnRoster{TeamID: testkit.TeamA(), PlayerID: id, EffectiveAt: testkit.TodayLock()},
+		)
+	}
+
+	store.SeedEvents(testkit.TeamA(), events)
+}
+
+func TestProjection_Apply(t *testing.T) {
+	counter := &addCounter{}
+	p := counter.projection("adds")
+
+	testCases := []struct {
+		name  string
+		event domain.RosterEvent
+		want  []domain.PlayerID
+	}{
+		{
+			name:  "passes a handled event to its handler",
+			event: domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 7},
+			want:  []domain.PlayerID{7},
+		},
+		{
+			name:  "ignores an event with no handler",
+			event: domain.RemovedPlayerFromRoster{TeamID: testkit.TeamA(), PlayerID: 7},
+			want:  nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			counter.added = nil
+
+			err := p.Apply(t.Context(), eventlog.Entry[domain.RosterEvent]{
+				Position: 1,
+				Recorded: eventlog.Recorded[domain.RosterEvent]{Sequence: 1, Event: tc.event},
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, len(counter.added), len(tc.want))
+			for i := range tc.want {
+				assert.Equal(t, counter.added[i], tc.want[i])
+			}
+		})
+	}
+}
+
+func TestRunner_CatchUp(t *testing.T) {
+	t.Run("applies the log across batches and resumes from the checkpoint", func(t *testing.T) {
+		store := testkit.NewFakeRosterStore()
+		seedAdds(store, 1, 2, 3)
+		counter := &addCounter{}
+		runner := testkit.NewRosterRunner(store, counter.projection("adds"))
+
+		require.NoError(t, runner.CatchUp(t.Context()))
+		require.Equal(t, len(counter.added), 3)
+
+		_, err := store.Append(t.Context(), testkit.TeamA(), []domain.RosterEvent{
+			domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 4, EffectiveAt: testkit.TodayLock()},
+		}, 6)
+		require.NoError(t, err)
+
+		require.NoError(t, runner.CatchUp(t.Context()))
+		require.Equal(t, len(counter.added), 4)
+		assert.Equal(t, counter.added[3], domain.PlayerID(4))
+
+		pos, err := runner.Checkpoints.Checkpoint(t.Context(), "adds")
+		require.NoError(t, err)
+		assert.Equal(t, pos, eventlog.Position(7))
+	})
+
+	t.Run("holds back only the projection that fails", func(t *testing.T) {
+		store := testkit.NewFakeRosterStore()
+		seedAdds(store, 1, 2, 3)
+		failing, healthy := &addCounter{failOn: 2}, &addCounter{}
+		runner := testkit.NewRosterRunner(store, failing.projection("failing"), healthy.projection("healthy"))
+
+		err := runner.CatchUp(t.Context())
+
+		assert.ErrorIs(t, err, errProjectionFailed)
+		assert.Equal(t, len(healthy.added), 3)
+
+		pos, err := runner.Checkpoints.Checkpoint(t.Context(), "failing")
+		require.NoError(t, err)
+		assert.Equal(t, pos, eventlog.Position(2))
+	})
+
+	t.Run("applies a failed batch again from its start", func(t *testing.T) {
+		store := testkit.NewFakeRosterStore()
+		seedAdds(store, 1, 2)
+		counter := &addCounter{failOn: 2}
+		runner := testkit.NewRosterRunner(store, counter.projection("adds"))
+		runner.BatchSize = 4
+
+		assert.ErrorIs(t, runner.CatchUp(t.Context()), errProjectionFailed)
+		counter.failOn = 0
+		require.NoError(t, runner.CatchUp(t.Context()))
+
+		assert.Equal(t, len(counter.added), 3)
+		assert.Equal(t, counter.added[0], domain.PlayerID(1))
+		assert.Equal(t, counter.added[1], domain.PlayerID(1))
+	})
+}
+
+func TestRunner_Rebuild(t *testing.T) {
+	t.Run("resets the projection and applies the log from the start", func(t *testing.T) {
+		store := testkit.NewFakeRosterStore()
+		seedAdds(store, 1, 2)
+		counter := &addCounter{}
+		runner := testkit.NewRosterRunner(store, counter.projection("adds"))
+		require.NoError(t, runner.CatchUp(t.Context()))
+
+		require.NoError(t, runner.Rebuild(t.Context(), "adds"))
+
+		assert.Equal(t, counter.resets, 1)
+		assert.Equal(t, len(counter.added), 2)
+	})
+
+	t.Run("rejects a projection the runner does not have", func(t *testing.T) {
+		runner := testkit.NewRosterRunner(testkit.NewFakeRosterStore())
+
+		err := runner.Rebuild(t.Context(), "missing")
+
+		assert.ErrorIs(t, err, projection.ErrUnknownProjection)
+	})
+}
+
+func TestRunner_Run(t *testing.T) {
+	store := testkit.NewFakeRosterStore()
+	seedAdds(store, 1)
+	ctx, cancel := context.WithCancel(t.Context())
+	counter := &addCounter{}
+	p := counter.projection("adds")
+	projection.On(p, func(ctx context.Context, e domain.ActivatedPlayerOnRoster, _ eventlog.Entry[domain.RosterEvent]) error {
+		cancel()
+		return nil
+	})
+	runner := testkit.NewRosterRunner(store, p)
+
+	var reported []error
+	err := runner.Run(ctx, time.Hour, func(err error) { reported = append(reported, err) })
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, len(counter.added), 1)
+	assert.Equal(t, len(reported), 0)
+}
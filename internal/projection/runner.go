@@ -0,0 +1,139 @@
+package projection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// DefaultBatchSize bounds how many events one read of the log returns.
+const DefaultBatchSize = 500
+
+var ErrUnknownProjection = errors.New("unknown projection")
+
+// Log is read in position order. ports.RosterLog is a Log of roster events.
+type Log[E any] interface {
+	ReadAfter(ctx context.Context, after eventlog.Position, limit int) ([]eventlog.Entry[E], error)
+}
+
+// Runner keeps projections up to date with a log. Each projection follows the
+// log from its own checkpoint, so one that fails holds back only itself.
+type Runner[E any] struct {
+	Log         Log[E]
+	Checkpoints ports.ProjectionCheckpoints
+	Projections []*Projection[E]
+	BatchSize   int
+}
+
+// CatchUp applies everything logged since each projection's checkpoint and
+// returns the joined errors of the projections that failed.
+func (r Runner[E]) CatchUp(ctx context.Context) error {
+	var errs []error
+	for _, p := range r.Projections {
+		err := r.catchUp(ctx, p)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("projection %s: %w", p.Name(), err))
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Rebuild resets the named projection and applies the log to it from the start.
+func (r Runner[E]) Rebuild(ctx context.Context, name string) error {
+	for _, p := range r.Projections {
+		if p.Name() != name {
+			continue
+		}
+
+		err := p.Reset(ctx)
+		if err != nil {
+			return fmt.Errorf("projection %s: reset: %w", name, err)
+		}
+
+		err = r.Checkpoints.SaveCheckpoint(ctx, name, 0)
+		if err != nil {
+			return fmt.Errorf("projection %s: %w", name, err)
+		}
+
+		err = r.catchUp(ctx, p)
+		if err != nil {
+			return fmt.Errorf("projection %s: %w", name, err)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s", ErrUnknownProjection, name)
+}
+
+// Run catches up, then again every interval, until ctx is done. Errors go to
+// report, if set, and the failing projection is retried on the next tick.
+func (r Runner[E]) Run(ctx context.Context, interval time.Duration, report func(error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		err := r.CatchUp(ctx)
+		if err != nil && ctx.Err() == nil && report != nil {
+			report(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r Runner[E]) catchUp(ctx context.Context, p *Projection[E]) error {
+	pos, err := r.Checkpoints.Checkpoint(ctx, p.Name())
+	if err != nil {
+		return err
+	}
+
+	for {
+		entries, err := r.Log.ReadAfter(ctx, pos, r.BatchSize)
+		if err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			return nil
+		}
+
+		for _, entry := range entries {
+			err := p.Apply(ctx, entry)
+			if err != nil {
+				return fmt.Errorf("position %v: %w", entry.Position, err)
+			}
+		}
+
+		pos = entries[len(entries)-1].Position
+		err = r.Checkpoints.SaveCheckpoint(ctx, p.Name(), pos)
+		if err != nil {
+			return err
+		}
+
+		if len(entries) < r.BatchSize {
+			return nil
+		}
+	}
+}
+
+func NewRunner[E any](log Log[E], checkpoints ports.ProjectionCheckpoints, projections ...*Projection[E]) Runner[E] {
+	return Runner[E]{
+		Log:         log,
+		Checkpoints: checkpoints,
+		Projections: projections,
+		BatchSize:   DefaultBatchSize,
+	}
+}
@@ -0,0 +1,32 @@
+package telemetry
+
+import "context"
+
+// CommandHandler is a use case handler that takes a command and reports only
+// whether it succeeded.
+type CommandHandler[C any] interface {
+	Handle(ctx context.Context, cmd C) error
+}
+
+// CountedCommand counts each command Next handles under Name, by outcome, so
+// that handlers do not count themselves.
+type CountedCommand[C any] struct {
+	Name    string
+	Next    CommandHandler[C]
+	Metrics *Metrics
+}
+
+func NewCountedCommand[C any](name string, next CommandHandler[C], metrics *Metrics) CountedCommand[C] {
+	return CountedCommand[C]{
+		Name:    name,
+		Next:    next,
+		Metrics: metrics,
+	}
+}
+
+func (c CountedCommand[C]) Handle(ctx context.Context, cmd C) error {
+	err := c.Next.Handle(ctx, cmd)
+	c.Metrics.CountCommand(ctx, c.Name, err)
+
+	return err
+}
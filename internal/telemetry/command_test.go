@@ -0,0 +1,48 @@
+package telemetry_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/telemetry"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+type commandFunc func(ctx context.Context, cmd string) error
+
+func (f commandFunc) Handle(ctx context.Context, cmd string) error {
+	return f(ctx, cmd)
+}
+
+func TestCountedCommand(t *testing.T) {
+	metrics, reader := testkit.RecordMetrics(t)
+	rejected := fmt.Errorf("%w: player 1", domain.ErrPlayerAlreadyOnRoster)
+	handler := telemetry.NewCountedCommand[string]("AddPlayer", commandFunc(func(_ context.Context, cmd string) error {
+		if cmd == "again" {
+			return rejected
+		}
+		return nil
+	}), metrics)
+
+	require.NoError(t, handler.Handle(t.Context(), "first"))
+	err := handler.Handle(t.Context(), "again")
+	assert.ErrorIs(t, err, domain.ErrPlayerAlreadyOnRoster)
+
+	sum, ok := testkit.CollectMetric(t, reader, telemetry.MetricCommands).(metricdata.Sum[int64])
+	require.True(t, ok)
+
+	counts := map[string]int64{}
+	for _, dp := range sum.DataPoints {
+		outcome, _ := dp.Attributes.Value(telemetry.Outcome)
+		counts[outcome.AsString()] = dp.Value
+	}
+
+	assert.Equal(t, counts[telemetry.OutcomeSuccess], 1)
+	assert.Equal(t, counts[telemetry.OutcomeRejected], 1)
+}
@@ -0,0 +1,37 @@
+package telemetry_test
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/spcameron/dugout/internal/telemetry"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+func TestLogHandler(t *testing.T) {
+	t.Run("adds the trace and span of the record's context", func(t *testing.T) {
+		testkit.RecordSpans(t)
+
+		var buf bytes.Buffer
+		logger := slog.New(telemetry.NewLogHandler(slog.NewTextHandler(&buf, nil))).With("component", "test")
+
+		ctx, span := telemetry.Start(t.Context(), "work")
+		logger.InfoContext(ctx, "working")
+		span.End()
+
+		assert.Contains(t, buf.String(), "trace_id="+span.SpanContext().TraceID().String())
+		assert.Contains(t, buf.String(), "span_id="+span.SpanContext().SpanID().String())
+		assert.Contains(t, buf.String(), "component=test")
+	})
+
+	t.Run("leaves records without a span alone", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := slog.New(telemetry.NewLogHandler(slog.NewTextHandler(&buf, nil)))
+
+		logger.InfoContext(t.Context(), "working")
+
+		assert.False(t, bytes.Contains(buf.Bytes(), []byte("trace_id")))
+	})
+}
@@ -0,0 +1,133 @@
+package telemetry
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/spcameron/dugout/internal/domain"
+)
+
+// Metric names. cmd/dugout exports them in Prometheus format, where dots become
+// underscores and counters and units gain the usual suffixes.
+const (
+	MetricCommands        = "dugout.roster.commands"
+	MetricLoadDuration    = "dugout.roster.store.load.duration"
+	MetricAppendDuration  = "dugout.roster.store.append.duration"
+	MetricProjectDuration = "dugout.roster.project.duration"
+	MetricStreamEvents    = "dugout.roster.stream.events"
+)
+
+// Outcome is the attribute commands are counted by.
+const Outcome = attribute.Key("dugout.outcome")
+
+// Outcomes a roster command is counted under.
+const (
+	OutcomeSuccess         = "success"
+	OutcomeRejected        = "rejected"
+	OutcomeVersionConflict = "version_conflict"
+	OutcomeError           = "error"
+)
+
+// durationBuckets suit calls that take a few hundred microseconds to a few
+// seconds, rather than the SDK's default buckets in milliseconds.
+var durationBuckets = []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5}
+
+// Metrics holds the instruments dugout records to. Build it once with
+// NewMetrics and share it between the decorators that record to it.
+type Metrics struct {
+	commands        metric.Int64Counter
+	loadDuration    metric.Float64Histogram
+	appendDuration  metric.Float64Histogram
+	projectDuration metric.Float64Histogram
+	streamEvents    metric.Int64Gauge
+}
+
+// NewMetrics creates the instruments on provider. Stream length is a gauge per
+// team, set to the stream's version each time it is loaded or appended to.
+func NewMetrics(provider metric.MeterProvider) (*Metrics, error) {
+	meter := provider.Meter(instrumentationName)
+
+	var m Metrics
+	var err error
+
+	m.commands, err = meter.Int64Counter(MetricCommands,
+		metric.WithDescription("Roster commands handled, by outcome."),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.loadDuration, err = durationHistogram(meter, MetricLoadDuration, "Time to load a roster stream.")
+	if err != nil {
+		return nil, err
+	}
+
+	m.appendDuration, err = durationHistogram(meter, MetricAppendDuration, "Time to append to a roster stream.")
+	if err != nil {
+		return nil, err
+	}
+
+	m.projectDuration, err = durationHistogram(meter, MetricProjectDuration, "Time to project a roster stream.")
+	if err != nil {
+		return nil, err
+	}
+
+	m.streamEvents, err = meter.Int64Gauge(MetricStreamEvents,
+		metric.WithDescription("Events in each team's roster stream, as of its last load or append."),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func durationHistogram(meter metric.Meter, name, description string) (metric.Float64Histogram, error) {
+	return meter.Float64Histogram(name,
+		metric.WithDescription(description),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(durationBuckets...),
+	)
+}
+
+// OutcomeOf groups err by who has to act on it: the caller for a rejection, the
+// caller retrying for a version conflict, and an operator for anything else.
+func OutcomeOf(err error) string {
+	if err == nil {
+		return OutcomeSuccess
+	}
+
+	switch Classify(err) {
+	case ClassRejected, ClassNotAuthorized, ClassNotFound:
+		return OutcomeRejected
+	case ClassVersionConflict:
+		return OutcomeVersionConflict
+	default:
+		return OutcomeError
+	}
+}
+
+// CountCommand counts a handled roster command by its outcome.
+func (m *Metrics) CountCommand(ctx context.Context, command string, err error) {
+	m.commands.Add(ctx, 1, metric.WithAttributes(Command.String(command), Outcome.String(OutcomeOf(err))))
+}
+
+// RecordProjection records how long projecting a roster stream took.
+func (m *Metrics) RecordProjection(ctx context.Context, took time.Duration) {
+	m.projectDuration.Record(ctx, took.Seconds())
+}
+
+func (m *Metrics) recordLoad(ctx context.Context, start time.Time) {
+	m.loadDuration.Record(ctx, time.Since(start).Seconds())
+}
+
+func (m *Metrics) recordAppend(ctx context.Context, start time.Time) {
+	m.appendDuration.Record(ctx, time.Since(start).Seconds())
+}
+
+func (m *Metrics) recordStreamEvents(ctx context.Context, id domain.TeamID, version int64) {
+	m.streamEvents.Record(ctx, version, metric.WithAttributes(TeamID.Int(int(id))))
+}
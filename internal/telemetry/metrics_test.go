@@ -0,0 +1,97 @@
+package telemetry_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/telemetry"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+func TestOutcomeOf(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "no error is a success",
+			err:  nil,
+			want: telemetry.OutcomeSuccess,
+		},
+		{
+			name: "domain rule is a rejection",
+			err:  domain.ErrRosterFull,
+			want: telemetry.OutcomeRejected,
+		},
+		{
+			name: "unauthorized actor is a rejection",
+			err:  domain.ErrNotAuthorized,
+			want: telemetry.OutcomeRejected,
+		},
+		{
+			name: "unknown player is a rejection",
+			err:  ports.ErrPlayerNotFound,
+			want: telemetry.OutcomeRejected,
+		},
+		{
+			name: "stale version is a version conflict",
+			err:  ports.ErrVersionConflict,
+			want: telemetry.OutcomeVersionConflict,
+		},
+		{
+			name: "anything else is an error",
+			err:  errors.New("connection reset"),
+			want: telemetry.OutcomeError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, telemetry.OutcomeOf(tc.err), tc.want)
+		})
+	}
+}
+
+func TestCountCommand(t *testing.T) {
+	metrics, reader := testkit.RecordMetrics(t)
+
+	metrics.CountCommand(t.Context(), "AddPlayer", nil)
+	metrics.CountCommand(t.Context(), "AddPlayer", nil)
+	metrics.CountCommand(t.Context(), "AddPlayer", ports.ErrVersionConflict)
+
+	sum, ok := testkit.CollectMetric(t, reader, telemetry.MetricCommands).(metricdata.Sum[int64])
+	require.True(t, ok)
+
+	counts := map[string]int64{}
+	for _, dp := range sum.DataPoints {
+		command, _ := dp.Attributes.Value(telemetry.Command)
+		assert.Equal(t, command, attribute.StringValue("AddPlayer"))
+
+		outcome, _ := dp.Attributes.Value(telemetry.Outcome)
+		counts[outcome.AsString()] = dp.Value
+	}
+
+	assert.Equal(t, counts[telemetry.OutcomeSuccess], 2)
+	assert.Equal(t, counts[telemetry.OutcomeVersionConflict], 1)
+}
+
+func TestRecordProjection(t *testing.T) {
+	metrics, reader := testkit.RecordMetrics(t)
+
+	metrics.RecordProjection(t.Context(), 30*time.Millisecond)
+
+	histogram, ok := testkit.CollectMetric(t, reader, telemetry.MetricProjectDuration).(metricdata.Histogram[float64])
+	require.True(t, ok)
+	require.Equal(t, len(histogram.DataPoints), 1)
+	assert.Equal(t, histogram.DataPoints[0].Count, 1)
+	assert.True(t, histogram.DataPoints[0].Sum >= 0.03)
+}
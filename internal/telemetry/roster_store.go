@@ -0,0 +1,84 @@
+package telemetry
+
+import (
+	"context"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// RosterStore wraps a store with a span around each call. Load spans carry the
+// version loaded and Append spans the number of events appended. It also times
+// each call, records the length of every stream it sees, and records the
+// projection times callers report to it as a ports.ProjectionObserver.
+type RosterStore struct {
+	Next    ports.RosterStore
+	Metrics *Metrics
+}
+
+func NewRosterStore(next ports.RosterStore, metrics *Metrics) RosterStore {
+	return RosterStore{
+		Next:    next,
+		Metrics: metrics,
+	}
+}
+
+func (s RosterStore) Load(ctx context.Context, id domain.TeamID) ([]eventlog.Recorded[domain.RosterEvent], ports.Version, error) {
+	ctx, span := Start(ctx, "RosterStore.Load", TeamID.Int(int(id)))
+	start := time.Now()
+
+	history, version, err := s.Next.Load(ctx, id)
+	s.Metrics.recordLoad(ctx, start)
+	if err == nil {
+		span.SetAttributes(LoadedVersion.Int64(int64(version)))
+		s.Metrics.recordStreamEvents(ctx, id, int64(version))
+	}
+	End(span, err)
+
+	return history, version, err
+}
+
+func (s RosterStore) Append(ctx context.Context, id domain.TeamID, newEvents []domain.RosterEvent, expected ports.Version) (ports.Version, error) {
+	ctx, span := Start(ctx, "RosterStore.Append",
+		TeamID.Int(int(id)),
+		LoadedVersion.Int64(int64(expected)),
+		EventsAppended.Int(len(newEvents)),
+	)
+	start := time.Now()
+
+	version, err := s.Next.Append(ctx, id, newEvents, expected)
+	s.Metrics.recordAppend(ctx, start)
+	if err == nil {
+		s.Metrics.recordStreamEvents(ctx, id, int64(version))
+	}
+	End(span, err)
+
+	return version, err
+}
+
+func (s RosterStore) AppendMany(ctx context.Context, appends []ports.StreamAppend) ([]ports.Version, error) {
+	total := 0
+	for _, a := range appends {
+		total += len(a.Events)
+	}
+
+	ctx, span := Start(ctx, "RosterStore.AppendMany", EventsAppended.Int(total))
+	start := time.Now()
+
+	versions, err := s.Next.AppendMany(ctx, appends)
+	s.Metrics.recordAppend(ctx, start)
+	if err == nil {
+		for i, v := range versions {
+			s.Metrics.recordStreamEvents(ctx, appends[i].TeamID, int64(v))
+		}
+	}
+	End(span, err)
+
+	return versions, err
+}
+
+func (s RosterStore) ObserveProjection(ctx context.Context, took time.Duration) {
+	s.Metrics.RecordProjection(ctx, took)
+}
@@ -0,0 +1,148 @@
+package telemetry_test
+
+import (
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/telemetry"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+func TestRosterStore(t *testing.T) {
+	added := func(player domain.PlayerID) domain.RosterEvent {
+		return domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: player, EffectiveAt: testkit.TodayLock()}
+	}
+
+	t.Run("load span carries the team and the version loaded", func(t *testing.T) {
+		spans := testkit.RecordSpans(t)
+		metrics, _ := testkit.RecordMetrics(t)
+
+		fake := testkit.NewFakeRosterStore()
+		fake.SeedEvents(testkit.TeamA(), []domain.RosterEvent{added(1), added(2)})
+
+		_, _, err := telemetry.NewRosterStore(fake, metrics).Load(t.Context(), testkit.TeamA())
+		require.NoError(t, err)
+
+		span := testkit.SpanNamed(t, spans, "RosterStore.Load")
+		team, _ := testkit.SpanAttr(span, telemetry.TeamID)
+		assert.Equal(t, team.AsInt64(), int64(testkit.TeamA()))
+		version, _ := testkit.SpanAttr(span, telemetry.LoadedVersion)
+		assert.Equal(t, version.AsInt64(), 2)
+		assert.Equal(t, span.Status.Code, codes.Unset)
+	})
+
+	t.Run("append span carries the number of events appended", func(t *testing.T) {
+		spans := testkit.RecordSpans(t)
+		metrics, _ := testkit.RecordMetrics(t)
+
+		_, err := telemetry.NewRosterStore(testkit.NewFakeRosterStore(), metrics).Append(t.Context(), testkit.TeamA(), []domain.RosterEvent{added(1), added(2)}, 0)
+		require.NoError(t, err)
+
+		span := testkit.SpanNamed(t, spans, "RosterStore.Append")
+		appended, _ := testkit.SpanAttr(span, telemetry.EventsAppended)
+		assert.Equal(t, appended.AsInt64(), 2)
+	})
+
+	t.Run("stale append is recorded as a version conflict", func(t *testing.T) {
+		spans := testkit.RecordSpans(t)
+		metrics, _ := testkit.RecordMetrics(t)
+
+		_, err := telemetry.NewRosterStore(testkit.NewFakeRosterStore(), metrics).Append(t.Context(), testkit.TeamA(), []domain.RosterEvent{added(1)}, 3)
+		require.ErrorIs(t, err, ports.ErrVersionConflict)
+
+		span := testkit.SpanNamed(t, spans, "RosterStore.Append")
+		class, ok := testkit.SpanAttr(span, telemetry.ErrorClass)
+		require.True(t, ok)
+		assert.Equal(t, class.AsString(), telemetry.ClassVersionConflict)
+		assert.Equal(t, span.Status.Code, codes.Error)
+		assert.Equal(t, len(span.Events), 1)
+	})
+
+	t.Run("append many span counts events across streams", func(t *testing.T) {
+		spans := testkit.RecordSpans(t)
+		metrics, _ := testkit.RecordMetrics(t)
+
+		_, err := telemetry.NewRosterStore(testkit.NewFakeRosterStore(), metrics).AppendMany(t.Context(), []ports.StreamAppend{
+			{TeamID: testkit.TeamA(), Events: []domain.RosterEvent{added(1)}},
+			{TeamID: testkit.TeamB(), Events: []domain.RosterEvent{
+				domain.AddedPlayerToRoster{TeamID: testkit.TeamB(), PlayerID: 2, EffectiveAt: testkit.TodayLock()},
+				domain.AddedPlayerToRoster{TeamID: testkit.TeamB(), PlayerID: 3, EffectiveAt: testkit.TodayLock()},
+			}},
+		})
+		require.NoError(t, err)
+
+		span := testkit.SpanNamed(t, spans, "RosterStore.AppendMany")
+		appended, _ := testkit.SpanAttr(span, telemetry.EventsAppended)
+		assert.Equal(t, appended.AsInt64(), 3)
+	})
+
+	t.Run("times calls and records the length of the stream", func(t *testing.T) {
+		metrics, reader := testkit.RecordMetrics(t)
+		store := telemetry.NewRosterStore(testkit.NewFakeRosterStore(), metrics)
+
+		_, err := store.Append(t.Context(), testkit.TeamA(), []domain.RosterEvent{added(1), added(2)}, 0)
+		require.NoError(t, err)
+		_, _, err = store.Load(t.Context(), testkit.TeamA())
+		require.NoError(t, err)
+
+		for _, name := range []string{telemetry.MetricLoadDuration, telemetry.MetricAppendDuration} {
+			histogram, ok := testkit.CollectMetric(t, reader, name).(metricdata.Histogram[float64])
+			require.True(t, ok)
+			require.Equal(t, len(histogram.DataPoints), 1)
+			assert.Equal(t, histogram.DataPoints[0].Count, 1)
+		}
+
+		gauge, ok := testkit.CollectMetric(t, reader, telemetry.MetricStreamEvents).(metricdata.Gauge[int64])
+		require.True(t, ok)
+		require.Equal(t, len(gauge.DataPoints), 1)
+		assert.Equal(t, gauge.DataPoints[0].Value, 2)
+		team, ok := gauge.DataPoints[0].Attributes.Value(telemetry.TeamID)
+		require.True(t, ok)
+		assert.Equal(t, team.AsInt64(), int64(testkit.TeamA()))
+	})
+
+	t.Run("keeps the length of each stream appended to together", func(t *testing.T) {
+		metrics, reader := testkit.RecordMetrics(t)
+		store := telemetry.NewRosterStore(testkit.NewFakeRosterStore(), metrics)
+
+		_, err := store.AppendMany(t.Context(), []ports.StreamAppend{
+			{TeamID: testkit.TeamA(), Events: []domain.RosterEvent{added(1)}},
+			{TeamID: testkit.TeamB(), Events: []domain.RosterEvent{
+				domain.AddedPlayerToRoster{TeamID: testkit.TeamB(), PlayerID: 2, EffectiveAt: testkit.TodayLock()},
+				domain.AddedPlayerToRoster{TeamID: testkit.TeamB(), PlayerID: 3, EffectiveAt: testkit.TodayLock()},
+			}},
+		})
+		require.NoError(t, err)
+
+		gauge, ok := testkit.CollectMetric(t, reader, telemetry.MetricStreamEvents).(metricdata.Gauge[int64])
+		require.True(t, ok)
+		require.Equal(t, len(gauge.DataPoints), 2)
+
+		lengths := make(map[int64]int64)
+		for _, dp := range gauge.DataPoints {
+			team, _ := dp.Attributes.Value(telemetry.TeamID)
+			lengths[team.AsInt64()] = dp.Value
+		}
+		assert.Equal(t, lengths[int64(testkit.TeamA())], 1)
+		assert.Equal(t, lengths[int64(testkit.TeamB())], 2)
+	})
+
+	t.Run("records the projection times callers report", func(t *testing.T) {
+		metrics, reader := testkit.RecordMetrics(t)
+		var observer ports.ProjectionObserver = telemetry.NewRosterStore(testkit.NewFakeRosterStore(), metrics)
+
+		observer.ObserveProjection(t.Context(), 20*time.Millisecond)
+
+		histogram, ok := testkit.CollectMetric(t, reader, telemetry.MetricProjectDuration).(metricdata.Histogram[float64])
+		require.True(t, ok)
+		require.Equal(t, len(histogram.DataPoints), 1)
+		assert.Equal(t, histogram.DataPoints[0].Count, 1)
+	})
+}
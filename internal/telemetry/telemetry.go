@@ -0,0 +1,90 @@
+// Package telemetry names the spans and attributes dugout records. Spans go to
+// the global tracer provider, which is a no-op until cmd/dugout installs an
+// exporter, so instrumented code costs little when tracing is off.
+package telemetry
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+const instrumentationName = "github.com/spcameron/dugout"
+
+// Span attributes. ErrorClass follows the OpenTelemetry error.type convention.
+// Command and store spans set it to one of the Class constants; request spans set
+// it to the code of the problem reported to the client.
+const (
+	Command        = attribute.Key("dugout.command")
+	TeamID         = attribute.Key("dugout.team.id")
+	PlayerID       = attribute.Key("dugout.player.id")
+	LoadedVersion  = attribute.Key("dugout.roster.loaded_version")
+	EventsAppended = attribute.Key("dugout.roster.events_appended")
+	ErrorClass     = attribute.Key("error.type")
+)
+
+// Error classes are coarse on purpose: they group failures on a dashboard, and
+// the recorded error carries the detail.
+const (
+	ClassCanceled        = "canceled"
+	ClassInternal        = "internal"
+	ClassNotAuthorized   = "not_authorized"
+	ClassNotFound        = "not_found"
+	ClassRejected        = "rejected"
+	ClassVersionConflict = "version_conflict"
+)
+
+// Tracer looks up the global provider on each call, so spans follow whichever
+// provider was installed most recently.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Start begins a span named name as a child of any span in ctx.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records err on span, if there is one, and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		span.SetAttributes(ErrorClass.String(Classify(err)))
+	}
+
+	span.End()
+}
+
+// Classify returns the error class for err. Errors nothing has claimed are
+// internal, as is a replay error even when the event it stopped at broke a
+// domain rule: the stream is at fault there, not the request.
+func Classify(err error) string {
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return ClassCanceled
+	case errors.Is(err, ports.ErrVersionConflict):
+		return ClassVersionConflict
+	case errors.Is(err, domain.ErrNotAuthorized):
+		return ClassNotAuthorized
+	case errors.Is(err, eventlog.ErrUnreplayableEvent):
+		return ClassInternal
+	case errors.As(err, new(*domain.Rejection)):
+		return ClassRejected
+	case errors.Is(err, ports.ErrTeamNotFound),
+		errors.Is(err, ports.ErrPlayerNotFound),
+		errors.Is(err, ports.ErrLeagueNotFound),
+		errors.Is(err, eventlog.ErrRecordedEventNotFound):
+		return ClassNotFound
+	default:
+		return ClassInternal
+	}
+}
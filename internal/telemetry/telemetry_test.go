@@ -0,0 +1,79 @@
+package telemetry_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/telemetry"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+)
+
+func TestClassify(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "cancelled context",
+			err:  fmt.Errorf("loading roster: %w", context.Canceled),
+			want: telemetry.ClassCanceled,
+		},
+		{
+			name: "deadline exceeded",
+			err:  context.DeadlineExceeded,
+			want: telemetry.ClassCanceled,
+		},
+		{
+			name: "version conflict",
+			err:  &ports.VersionConflictError{TeamID: 1, Current: 2, Expected: 1},
+			want: telemetry.ClassVersionConflict,
+		},
+		{
+			name: "not authorized",
+			err:  fmt.Errorf("%w: user 1, team 2", domain.ErrNotAuthorized),
+			want: telemetry.ClassNotAuthorized,
+		},
+		{
+			name: "rejected by a domain rule",
+			err:  fmt.Errorf("%w: player 1", domain.ErrRosterFull),
+			want: telemetry.ClassRejected,
+		},
+		{
+			name: "missing team",
+			err:  ports.ErrTeamNotFound,
+			want: telemetry.ClassNotFound,
+		},
+		{
+			name: "missing recorded event",
+			err:  eventlog.ErrRecordedEventNotFound,
+			want: telemetry.ClassNotFound,
+		},
+		{
+			name: "stream that broke a rule on replay",
+			err:  fmt.Errorf("%w: sequence 3: %w", eventlog.ErrUnreplayableEvent, domain.ErrRosterFull),
+			want: telemetry.ClassInternal,
+		},
+		{
+			name: "domain error that is not a rule",
+			err:  domain.ErrWrongTeamID,
+			want: telemetry.ClassInternal,
+		},
+		{
+			name: "unrecognized error",
+			err:  errors.New("connection reset"),
+			want: telemetry.ClassInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, telemetry.Classify(tc.err), tc.want)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package testkit
+
+import "time"
+
+type StubClock struct {
+	At time.Time
+}
+
+func (c *StubClock) Now() time.Time {
+	return c.At
+}
+
+// Advance moves the clock forward by d.
+func (c *StubClock) Advance(d time.Duration) {
+	c.At = c.At.Add(d)
+}
+
+func NewStubClock(at time.Time) *StubClock {
+	return &StubClock{
+		At: at,
+	}
+}
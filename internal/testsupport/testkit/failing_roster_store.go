@@ -1,8 +1,8 @@
 package testkit
 
 import (
+	"context"
 	"errors"
-	"fmt"
 
 	"github.com/spcameron/dugout/internal/domain"
 	"github.com/spcameron/dugout/internal/eventlog"
@@ -16,35 +16,54 @@ var (
 
 type FailingLoadRosterStore struct{}
 
-func (s *FailingLoadRosterStore) Load(id domain.TeamID) ([]eventlog.Recorded[domain.RosterEvent], ports.Version, error) {
+func (s *FailingLoadRosterStore) Load(ctx context.Context, id domain.TeamID) ([]eventlog.Recorded[domain.RosterEvent], ports.Version, error) {
 	return nil, 0, ErrFailingLoad
 }
 
-func (s *FailingLoadRosterStore) Append(id domain.TeamID, newEvents []domain.RosterEvent, expected ports.Version) (ports.Version, error) {
+func (s *FailingLoadRosterStore) Append(ctx context.Context, id domain.TeamID, newEvents []domain.RosterEvent, expected ports.Version) (ports.Version, error) {
 	panic("stub: FailingLoadRosterStore.Append() always panics")
 }
 
+func (s *FailingLoadRosterStore) AppendMany(ctx context.Context, appends []ports.StreamAppend) ([]ports.Version, error) {
+	panic("stub: FailingLoadRosterStore.AppendMany() always panics")
+}
+
 type FailingAppendRosterStore struct{}
 
-func (s *FailingAppendRosterStore) Load(id domain.TeamID) ([]eventlog.Recorded[domain.RosterEvent], ports.Version, error) {
+func (s *FailingAppendRosterStore) Load(ctx context.Context, id domain.TeamID) ([]eventlog.Recorded[domain.RosterEvent], ports.Version, error) {
 	return nil, 0, nil
 }
 
-func (s *FailingAppendRosterStore) Append(id domain.TeamID, newEvents []domain.RosterEvent, expected ports.Version) (ports.Version, error) {
+func (s *FailingAppendRosterStore) Append(ctx context.Context, id domain.TeamID, newEvents []domain.RosterEvent, expected ports.Version) (ports.Version, error) {
 	return 0, ErrFailingAppend
 }
 
+func (s *FailingAppendRosterStore) AppendMany(ctx context.Context, appends []ports.StreamAppend) ([]ports.Version, error) {
+	return nil, ErrFailingAppend
+}
+
 type VersionConflictRosterStore struct{}
 
-func (s *VersionConflictRosterStore) Load(id domain.TeamID) ([]eventlog.Recorded[domain.RosterEvent], ports.Version, error) {
+func (s *VersionConflictRosterStore) Load(ctx context.Context, id domain.TeamID) ([]eventlog.Recorded[domain.RosterEvent], ports.Version, error) {
 	return nil, 1, nil
 }
 
-func (s *VersionConflictRosterStore) Append(id domain.TeamID, newEvents []domain.RosterEvent, expected ports.Version) (ports.Version, error) {
+func (s *VersionConflictRosterStore) Append(ctx context.Context, id domain.TeamID, newEvents []domain.RosterEvent, expected ports.Version) (ports.Version, error) {
 	current := expected + 1
 	if current != expected {
-		return 0, fmt.Errorf("%w: current - %v, expected - %v", ports.ErrVersionConflict, current, expected)
+		return 0, &ports.VersionConflictError{TeamID: id, Current: current, Expected: expected}
 	}
 
 	return 0, nil
 }
+
+func (s *VersionConflictRosterStore) AppendMany(ctx context.Context, appends []ports.StreamAppend) ([]ports.Version, error) {
+	for _, a := range appends {
+		current := a.Expected + 1
+		if current != a.Expected {
+			return nil, &ports.VersionConflictError{TeamID: a.TeamID, Current: current, Expected: a.Expected}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,191 @@
+package testkit
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/projection"
+)
+
+type currentRosterRow struct {
+	entry domain.RosterEntry
+	added eventlog.Position
+}
+
+// FakeCurrentRosters keeps the current-roster read model in memory, maintained
+// by its Projection as the Postgres read model is. Put and Delete edit rows
+// directly, to stand in for a read model that has drifted.
+type FakeCurrentRosters struct {
+	Members *FakeMembershipRepository
+
+	rows []currentRosterRow
+}
+
+func (r *FakeCurrentRosters) ListLeague(ctx context.Context, league domain.LeagueID) ([]domain.RosterEntry, error) {
+	var entries []domain.RosterEntry
+	for _, row := range r.sorted() {
+		if r.inLeague(ctx, row.entry.TeamID, league) {
+			entries = append(entries, row.entry)
+		}
+	}
+
+	return entries, nil
+}
+
+func (r *FakeCurrentRosters) ListTeam(ctx context.Context, team domain.TeamID) ([]domain.RosterEntry, error) {
+	var entries []domain.RosterEntry
+	for _, row := range r.sorted() {
+		if row.entry.TeamID == team {
+			entries = append(entries, row.entry)
+		}
+	}
+
+	return entries, nil
+}
+
+func (r *FakeCurrentRosters) Owner(ctx context.Context, league domain.LeagueID, player domain.PlayerID) (domain.TeamID, error) {
+	for _, row := range r.sorted() {
+		if row.entry.PlayerID == player && r.inLeague(ctx, row.entry.TeamID, league) {
+			return row.entry.TeamID, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: player %v in league %v", ports.ErrPlayerNotRostered, player, league)
+}
+
+func (r *FakeCurrentRosters) Counts(ctx context.Context, league domain.LeagueID) (map[domain.TeamID]domain.RosterCounts, error) {
+	entries, err := r.ListLeague(ctx, league)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[domain.TeamID]domain.RosterCounts)
+	for _, e := range entries {
+		c := counts[e.TeamID]
+		switch e.RosterStatus {
+		case domain.StatusActiveHitter:
+			c.ActiveHitters++
+		case domain.StatusActivePitcher:
+			c.ActivePitchers++
+		default:
+			c.Inactive++
+		}
+		c.Total++
+		counts[e.TeamID] = c
+	}
+
+	return counts, nil
+}
+
+func (r *FakeCurrentRosters) Teams(ctx context.Context) ([]domain.TeamID, error) {
+	var teams []domain.TeamID
+	for _, row := range r.rows {
+		teams = append(teams, row.entry.TeamID)
+	}
+	slices.Sort(teams)
+
+	return slices.Compact(teams), nil
+}
+
+func (r *FakeCurrentRosters) Projection() *projection.Projection[domain.RosterEvent] {
+	p := projection.New[domain.RosterEvent]("current_rosters")
+
+	projection.On(p, func(ctx context.Context, e domain.AddedPlayerToRoster, entry eventlog.Entry[domain.RosterEvent]) error {
+		r.put(domain.RosterEntry{
+			TeamID:       e.TeamID,
+			PlayerID:     e.PlayerID,
+			RosterStatus: domain.StatusInactive,
+			Eligibility:  e.EligibleRoles(),
+		}, entry.Position)
+
+		return nil
+	})
+
+	projection.On(p, func(ctx context.Context, e domain.RemovedPlayerFromRoster, entry eventlog.Entry[domain.RosterEvent]) error {
+		r.Delete(e.TeamID, e.PlayerID)
+
+		return nil
+	})
+
+	projection.On(p, func(ctx context.Context, e domain.ActivatedPlayerOnRoster, entry eventlog.Entry[domain.RosterEvent]) error {
+		status := domain.StatusActiveHitter
+		if e.PlayerRole == domain.RolePitcher {
+			status = domain.StatusActivePitcher
+		}
+
+		return r.setStatus(e.TeamID, e.PlayerID, status)
+	})
+
+	projection.On(p, func(ctx context.Context, e domain.InactivatedPlayerOnRoster, entry eventlog.Entry[domain.RosterEvent]) error {
+		return r.setStatus(e.TeamID, e.PlayerID, domain.StatusInactive)
+	})
+
+	p.OnReset(func(ctx context.Context) error {
+		r.rows = nil
+
+		return nil
+	})
+
+	return p
+}
+
+// Put stores e after every other entry on its roster, replacing any entry for
+// the same player.
+func (r *FakeCurrentRosters) Put(e domain.RosterEntry) {
+	var last eventlog.Position
+	for _, row := range r.rows {
+		last = max(last, row.added)
+	}
+
+	r.put(e, last+1)
+}
+
+func (r *FakeCurrentRosters) Delete(team domain.TeamID, player domain.PlayerID) {
+	r.rows = slices.DeleteFunc(r.rows, func(row currentRosterRow) bool {
+		return row.entry.TeamID == team && row.entry.PlayerID == player
+	})
+}
+
+func (r *FakeCurrentRosters) put(e domain.RosterEntry, added eventlog.Position) {
+	r.Delete(e.TeamID, e.PlayerID)
+	r.rows = append(r.rows, currentRosterRow{entry: e, added: added})
+}
+
+func (r *FakeCurrentRosters) setStatus(team domain.TeamID, player domain.PlayerID, status domain.RosterStatus) error {
+	for i, row := range r.rows {
+		if row.entry.TeamID == team && row.entry.PlayerID == player {
+			r.rows[i].entry.RosterStatus = status
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: team %v, player %v", domain.ErrPlayerNotOnRoster, team, player)
+}
+
+// inLeague treats a team without memberships as in no league.
+func (r *FakeCurrentRosters) inLeague(ctx context.Context, team domain.TeamID, league domain.LeagueID) bool {
+	got, err := r.Members.LeagueOf(ctx, team)
+
+	return err == nil && got == league
+}
+
+// sorted orders rows by team and then by add, as the Postgres queries do.
+func (r *FakeCurrentRosters) sorted() []currentRosterRow {
+	rows := slices.Clone(r.rows)
+	slices.SortFunc(rows, func(a, b currentRosterRow) int {
+		return cmp.Or(cmp.Compare(a.entry.TeamID, b.entry.TeamID), cmp.Compare(a.added, b.added))
+	})
+
+	return rows
+}
+
+func NewFakeCurrentRosters(members *FakeMembershipRepository) *FakeCurrentRosters {
+	return &FakeCurrentRosters{
+		Members: members,
+	}
+}
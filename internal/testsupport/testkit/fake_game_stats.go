@@ -0,0 +1,32 @@
+package testkit
+
+import (
+	"context"
+	"slices"
+
+	"github.com/spcameron/dugout/internal/domain"
+)
+
+type FakeGameStats struct {
+	Games []domain.GameStats
+}
+
+func (s *FakeGameStats) ListForPlayers(ctx context.Context, ids []domain.PlayerID) ([]domain.GameStats, error) {
+	var games []domain.GameStats
+	for _, g := range s.Games {
+		if slices.Contains(ids, g.PlayerID) {
+			games = append(games, g)
+		}
+	}
+
+	return games, nil
+}
+
+// Seed records games in the order given.
+func (s *FakeGameStats) Seed(games ...domain.GameStats) {
+	s.Games = append(s.Games, games...)
+}
+
+func NewFakeGameStats() *FakeGameStats {
+	return &FakeGameStats{}
+}
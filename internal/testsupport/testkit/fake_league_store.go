@@ -0,0 +1,171 @@
+package testkit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// FakeLeagueStore hands out IDs counting up from 1001 so they never collide
+// with the fixed IDs used elsewhere in testkit. It also keeps every committed
+// event in one log, in commit order, for ReadAfter.
+//
+// Like the Postgres store, FakeLeagueStore fails with the context's error once
+// ctx is done, so handlers see cancellation where they would in production.
+type FakeLeagueStore struct {
+	committed  map[domain.LeagueID][]eventlog.Recorded[domain.LeagueEvent]
+	log        []eventlog.Entry[domain.LeagueEvent]
+	positions  LogPositions
+	lastLeague domain.LeagueID
+	lastTeam   domain.TeamID
+}
+
+func (s *FakeLeagueStore) Load(ctx context.Context, id domain.LeagueID) ([]eventlog.Recorded[domain.LeagueEvent], ports.Version, error) {
+	err := ctx.Err()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	history := s.committed[id]
+	history = append([]eventlog.Recorded[domain.LeagueEvent](nil), history...)
+
+	return history, s.currentVersion(id), nil
+}
+
+func (s *FakeLeagueStore) Append(ctx context.Context, id domain.LeagueID, newEvents []domain.LeagueEvent, expected ports.Version) (ports.Version, error) {
+	err := ctx.Err()
+	if err != nil {
+		return 0, err
+	}
+
+	current := s.currentVersion(id)
+	if current != expected {
+		return 0, fmt.Errorf("%w: league %v, current - %v, expected - %v", ports.ErrVersionConflict, id, current, expected)
+	}
+
+	history := append([]eventlog.Recorded[domain.LeagueEvent](nil), s.committed[id]...)
+	nextSeq := eventlog.Sequence(current)
+	for _, ev := range newEvents {
+		nextSeq++
+		history = append(history, eventlog.Recorded[domain.LeagueEvent]{
+			Sequence: nextSeq,
+			Event:    ev,
+		})
+	}
+
+	s.committed[id] = history
+	s.appendLog(history[current:])
+
+	return ports.Version(nextSeq), nil
+}
+
+func (s *FakeLeagueStore) ReadAfter(ctx context.Context, after eventlog.Position, limit int) ([]eventlog.Entry[domain.LeagueEvent], error) {
+	err := ctx.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []eventlog.Entry[domain.LeagueEvent]
+	for _, entry := range s.log {
+		if len(entries) == limit {
+			break
+		}
+		if entry.Position > after {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, nil
+}
+
+func (s *FakeLeagueStore) NextLeagueID(ctx context.Context) (domain.LeagueID, error) {
+	s.lastLeague++
+	return s.lastLeague, nil
+}
+
+func (s *FakeLeagueStore) NextTeamID(ctx context.Context) (domain.TeamID, error) {
+	s.lastTeam++
+	return s.lastTeam, nil
+}
+
+// SeedEvents overwrites the entire event stream for the given league,
+// then assigns contiguous 1-based sequence numbers to events in the order provided.
+// The seeded events are added to the end of the log.
+func (s *FakeLeagueStore) SeedEvents(id domain.LeagueID, events []domain.LeagueEvent) {
+	s.committed[id] = make([]eventlog.Recorded[domain.LeagueEvent], len(events))
+	for i, ev := range events {
+		s.committed[id][i] = eventlog.Recorded[domain.LeagueEvent]{
+			Sequence: eventlog.Sequence(i + 1),
+			Event:    ev,
+		}
+	}
+	s.appendLog(s.committed[id])
+}
+
+func (s *FakeLeagueStore) appendLog(records []eventlog.Recorded[domain.LeagueEvent]) {
+	for _, re := range records {
+		s.log = append(s.log, eventlog.Entry[domain.LeagueEvent]{Position: s.positions.next(), Recorded: re})
+	}
+}
+
+func (s *FakeLeagueStore) currentVersion(id domain.LeagueID) ports.Version {
+	history := s.committed[id]
+	if len(history) == 0 {
+		return 0
+	}
+
+	return ports.Version(history[len(history)-1].Sequence)
+}
+
+func NewFakeLeagueStore() *FakeLeagueStore {
+	return &FakeLeagueStore{
+		committed:  make(map[domain.LeagueID][]eventlog.Recorded[domain.LeagueEvent]),
+		lastLeague: 1000,
+		lastTeam:   1000,
+	}
+}
+
+// NewLeagueStoreInPhase returns a store holding LeagueA, run by Commissioner,
+// with TeamA and TeamB joined by their managers and the league advanced to phase.
+// It matches the memberships in NewLeagueMemberships.
+func NewLeagueStoreInPhase(phase domain.LeaguePhase) *FakeLeagueStore {
+	s := NewFakeLeagueStore()
+	s.SeedEvents(LeagueA(), LeagueHistory(phase))
+
+	return s
+}
+
+// LeagueSettings returns the default settings with a playoff bracket small
+// enough for LeagueA's two teams.
+func LeagueSettings() domain.LeagueSettings {
+	settings := domain.DefaultLeagueSettings()
+	settings.Playoffs = domain.PlayoffSettings{Teams: 2}
+
+	return settings
+}
+
+// LeagueHistory returns the events that create LeagueA with LeagueSettings, join
+// TeamA and TeamB, and advance the league to phase.
+func LeagueHistory(phase domain.LeaguePhase) []domain.LeagueEvent {
+	at := TodayLock()
+	events := []domain.LeagueEvent{
+		domain.CreatedLeague{
+			LeagueID:     LeagueA(),
+			Name:         "Flushing Meadows",
+			Commissioner: Commissioner(),
+			Settings:     LeagueSettings(),
+			CreatedAt:    at,
+		},
+		domain.JoinedLeague{LeagueID: LeagueA(), TeamID: TeamA(), TeamName: "Team A", Manager: ManagerA(), JoinedAt: at},
+		domain.JoinedLeague{LeagueID: LeagueA(), TeamID: TeamB(), TeamName: "Team B", Manager: ManagerB(), JoinedAt: at},
+	}
+
+	for p := domain.PhasePreDraft + 1; p <= phase; p++ {
+		events = append(events, domain.AdvancedLeaguePhase{LeagueID: LeagueA(), Phase: p, AdvancedAt: at})
+	}
+
+	return events
+}
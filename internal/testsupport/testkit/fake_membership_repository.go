@@ -0,0 +1,86 @@
+package testkit
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// FakeMembershipRepository learns which league a team plays in from the team
+// memberships granted to it, as the Postgres repository does.
+type FakeMembershipRepository struct {
+	memberships []domain.Membership
+}
+
+func (r *FakeMembershipRepository) Grant(ctx context.Context, m domain.Membership) error {
+	err := m.Validate()
+	if err != nil {
+		return err
+	}
+
+	if m.TeamID != 0 {
+		league, err := r.LeagueOf(ctx, m.TeamID)
+		if err == nil && league != m.LeagueID {
+			return fmt.Errorf("%w: team %v plays in league %v", domain.ErrInvalidMembership, m.TeamID, league)
+		}
+	}
+
+	if !slices.Contains(r.memberships, m) {
+		r.memberships = append(r.memberships, m)
+	}
+
+	return nil
+}
+
+func (r *FakeMembershipRepository) ListForUser(ctx context.Context, id domain.UserID) (domain.Memberships, error) {
+	var ms domain.Memberships
+	for _, m := range r.memberships {
+		if m.UserID == id {
+			ms = append(ms, m)
+		}
+	}
+
+	return ms, nil
+}
+
+func (r *FakeMembershipRepository) LeagueOf(ctx context.Context, team domain.TeamID) (domain.LeagueID, error) {
+	for _, m := range r.memberships {
+		if m.TeamID == team {
+			return m.LeagueID, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %v", ports.ErrTeamNotFound, team)
+}
+
+func (r *FakeMembershipRepository) ManagersOf(ctx context.Context, team domain.TeamID) ([]domain.UserID, error) {
+	var managers []domain.UserID
+	for _, m := range r.memberships {
+		if m.TeamID == team && (m.Role == domain.MembershipManager || m.Role == domain.MembershipCoManager) {
+			managers = append(managers, m.UserID)
+		}
+	}
+	slices.Sort(managers)
+
+	return slices.Compact(managers), nil
+}
+
+func NewFakeMembershipRepository() *FakeMembershipRepository {
+	return &FakeMembershipRepository{}
+}
+
+// NewLeagueMemberships returns memberships for LeagueA, in which ManagerA
+// manages TeamA, ManagerB manages TeamB, and Commissioner runs the league.
+func NewLeagueMemberships() *FakeMembershipRepository {
+	r := NewFakeMembershipRepository()
+	r.memberships = []domain.Membership{
+		{UserID: ManagerA(), LeagueID: LeagueA(), TeamID: TeamA(), Role: domain.MembershipManager},
+		{UserID: ManagerB(), LeagueID: LeagueA(), TeamID: TeamB(), Role: domain.MembershipManager},
+		{UserID: Commissioner(), LeagueID: LeagueA(), Role: domain.MembershipCommissioner},
+	}
+
+	return r
+}
@@ -0,0 +1,96 @@
+package testkit
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// FakeNotificationInbox keeps each user's notifications in the order notified,
+// ignoring a key the user already has, as the Postgres inbox does.
+type FakeNotificationInbox struct {
+	entries map[domain.UserID][]ports.InboxEntry
+}
+
+func (i *FakeNotificationInbox) Notify(ctx context.Context, n ports.Notification) error {
+	err := ctx.Err()
+	if err != nil {
+		return err
+	}
+
+	for _, e := range i.entries[n.UserID] {
+		if e.Key == n.Key {
+			return nil
+		}
+	}
+
+	i.entries[n.UserID] = append(i.entries[n.UserID], ports.InboxEntry{Notification: n})
+
+	return nil
+}
+
+func (i *FakeNotificationInbox) List(ctx context.Context, user domain.UserID, limit int) ([]ports.InboxEntry, error) {
+	entries := slices.Clone(i.entries[user])
+	slices.Reverse(entries)
+
+	return entries[:min(limit, len(entries))], nil
+}
+
+func (i *FakeNotificationInbox) MarkRead(ctx context.Context, user domain.UserID, key string, at time.Time) error {
+	for j, e := range i.entries[user] {
+		if e.Key == key && e.ReadAt.IsZero() {
+			i.entries[user][j].ReadAt = at
+		}
+	}
+
+	return nil
+}
+
+func NewFakeNotificationInbox() *FakeNotificationInbox {
+	return &FakeNotificationInbox{
+		entries: make(map[domain.UserID][]ports.InboxEntry),
+	}
+}
+
+// FakeNotificationPreferences has every channel on until SetEnabled turns it off.
+type FakeNotificationPreferences struct {
+	disabled map[domain.UserID]map[domain.NotificationChannel]bool
+}
+
+func (p *FakeNotificationPreferences) Enabled(ctx context.Context, user domain.UserID, channel domain.NotificationChannel) (bool, error) {
+	return !p.disabled[user][channel], nil
+}
+
+func (p *FakeNotificationPreferences) SetEnabled(ctx context.Context, user domain.UserID, channel domain.NotificationChannel, enabled bool) error {
+	if p.disabled[user] == nil {
+		p.disabled[user] = make(map[domain.NotificationChannel]bool)
+	}
+	p.disabled[user][channel] = !enabled
+
+	return nil
+}
+
+func NewFakeNotificationPreferences() *FakeNotificationPreferences {
+	return &FakeNotificationPreferences{
+		disabled: make(map[domain.UserID]map[domain.NotificationChannel]bool),
+	}
+}
+
+// RecordingNotifier keeps what it is asked to send, or fails with Err when set.
+type RecordingNotifier struct {
+	Sent []ports.Notification
+	Err  error
+}
+
+func (n *RecordingNotifier) Notify(ctx context.Context, notification ports.Notification) error {
+	if n.Err != nil {
+		return n.Err
+	}
+
+	n.Sent = append(n.Sent, notification)
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package testkit
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+type FakePlayerRepository struct {
+	players map[domain.PlayerID]domain.Player
+	nextID  domain.PlayerID
+}
+
+func (r *FakePlayerRepository) Get(ctx context.Context, id domain.PlayerID) (domain.Player, error) {
+	p, ok := r.players[id]
+	if !ok {
+		return domain.Player{}, fmt.Errorf("%w: %v", ports.ErrPlayerNotFound, id)
+	}
+
+	return p, nil
+}
+
+// Upsert matches existing players by MLBID, keeping their PlayerID, and assigns
+// the next free PlayerID to new ones.
+func (r *FakePlayerRepository) Upsert(ctx context.Context, players []domain.Player) ([]domain.Player, error) {
+	stored := make([]domain.Player, len(players))
+	for i, p := range players {
+		p.ID = 0
+		for id, existing := range r.players {
+			if existing.MLBID == p.MLBID {
+				p.ID = id
+				break
+			}
+		}
+
+		if p.ID == 0 {
+			r.nextID++
+			p.ID = r.nextID
+		}
+
+		r.players[p.ID] = p
+		stored[i] = p
+	}
+
+	return stored, nil
+}
+
+func (r *FakePlayerRepository) Search(ctx context.Context, query ports.PlayerQuery) ([]domain.Player, error) {
+	var matches []domain.Player
+	for _, p := range r.players {
+		if !strings.HasPrefix(strings.ToLower(p.Name), strings.ToLower(query.NamePrefix)) {
+			continue
+		}
+		if query.Position != "" && p.Position != query.Position {
+			continue
+		}
+		if query.MLBTeam != "" && p.MLBTeam != query.MLBTeam {
+			continue
+		}
+
+		matches = append(matches, p)
+	}
+
+	slices.SortFunc(matches, func(a, b domain.Player) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.ID, b.ID))
+	})
+
+	return matches[:min(query.MaxResults(), len(matches))], nil
+}
+
+// SeedPlayers stores players under their given PlayerIDs.
+func (r *FakePlayerRepository) SeedPlayers(players ...domain.Player) {
+	for _, p := range players {
+		r.players[p.ID] = p
+		r.nextID = max(r.nextID, p.ID)
+	}
+}
+
+// SeedPlayerIDs stores a placeholder hitter for each PlayerID.
+func (r *FakePlayerRepository) SeedPlayerIDs(ids ...domain.PlayerID) {
+	for _, id := range ids {
+		r.SeedPlayers(domain.Player{
+			ID:    id,
+			MLBID: domain.MLBPlayerID(id),
+			Name:  fmt.Sprintf("Player %d", id),
+			Roles: domain.MustRoleSet(domain.RoleHitter),
+		})
+	}
+}
+
+func NewFakePlayerRepository() *FakePlayerRepository {
+	return &FakePlayerRepository{
+		players: make(map[domain.PlayerID]domain.Player),
+	}
+}
@@ -0,0 +1,182 @@
+package testkit
+
+import (
+	"context"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// FakeRosterOutbox keeps messages and their delivery state in memory. Publish
+// stands in for the store writing outbox rows as it appends. MarkDelivered fails
+// with the next error from MarkFailures, recording nothing, until they run out.
+type FakeRosterOutbox struct {
+	MarkFailures []error
+
+	messages   []ports.OutboxMessage
+	deliveries map[string]map[ports.OutboxID]*FakeDelivery
+}
+
+// FakeDelivery is the delivery state of one message to one subscriber.
+type FakeDelivery struct {
+	Attempts      int
+	NextAttemptAt time.Time
+	Delivered     bool
+	DeadLettered  bool
+	LastError     string
+}
+
+// Publish adds a message for each event in the order given.
+func (o *FakeRosterOutbox) Publish(team domain.TeamID, events ...eventlog.Recorded[domain.RosterEvent]) {
+	for _, re := range events {
+		o.messages = append(o.messages, ports.OutboxMessage{
+			ID:       ports.OutboxID(len(o.messages) + 1),
+			TeamID:   team,
+			Sequence: re.Sequence,
+			Event:    re.Event,
+		})
+	}
+}
+
+func (o *FakeRosterOutbox) Pending(ctx context.Context, subscriber string, now time.Time, limit int) ([]ports.OutboxMessage, error) {
+	err := ctx.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []ports.OutboxMessage
+	for _, msg := range o.messages {
+		if len(pending) == limit {
+			break
+		}
+
+		d, ok := o.deliveries[subscriber][msg.ID]
+		if ok && (d.Delivered || d.DeadLettered || d.NextAttemptAt.After(now)) {
+			continue
+		}
+
+		if ok {
+			msg.Attempts = d.Attempts
+		}
+		pending = append(pending, msg)
+	}
+
+	return pending, nil
+}
+
+func (o *FakeRosterOutbox) MarkDelivered(ctx context.Context, subscriber string, id ports.OutboxID, at time.Time) error {
+	if len(o.MarkFailures) > 0 {
+		err := o.MarkFailures[0]
+		o.MarkFailures = o.MarkFailures[1:]
+		return err
+	}
+
+	d := o.delivery(subscriber, id)
+	d.Attempts++
+	d.Delivered = true
+	d.LastError = ""
+
+	return nil
+}
+
+func (o *FakeRosterOutbox) Retry(ctx context.Context, subscriber string, id ports.OutboxID, retryAt time.Time, cause string) error {
+	d := o.delivery(subscriber, id)
+	d.Attempts++
+	d.NextAttemptAt = retryAt
+	d.LastError = cause
+
+	return nil
+}
+
+func (o *FakeRosterOutbox) DeadLetter(ctx context.Context, subscriber string, id ports.OutboxID, at time.Time, cause string) error {
+	d := o.delivery(subscriber, id)
+	d.Attempts++
+	d.DeadLettered = true
+	d.LastError = cause
+
+	return nil
+}
+
+// Delivery returns the delivery state of message id to subscriber, which is the
+// zero FakeDelivery until the relay records an attempt.
+func (o *FakeRosterOutbox) Delivery(subscriber string, id ports.OutboxID) FakeDelivery {
+	d, ok := o.deliveries[subscriber][id]
+	if !ok {
+		return FakeDelivery{}
+	}
+
+	return *d
+}
+
+func (o *FakeRosterOutbox) delivery(subscriber string, id ports.OutboxID) *FakeDelivery {
+	if o.deliveries[subscriber] == nil {
+		o.deliveries[subscriber] = make(map[ports.OutboxID]*FakeDelivery)
+	}
+
+	d, ok := o.deliveries[subscriber][id]
+	if !ok {
+		d = &FakeDelivery{}
+		o.deliveries[subscriber][id] = d
+	}
+
+	return d
+}
+
+func NewFakeRosterOutbox() *FakeRosterOutbox {
+	return &FakeRosterOutbox{
+		deliveries: make(map[string]map[ports.OutboxID]*FakeDelivery),
+	}
+}
+
+// RecordingSubscriber records the messages delivered to it, and the key each
+// came with, failing each one with the next error from Failures until they run
+// out.
+type RecordingSubscriber struct {
+	Received []ports.OutboxMessage
+	Keys     []string
+	Failures []error
+}
+
+func (s *RecordingSubscriber) Deliver(ctx context.Context, key string, msg ports.OutboxMessage) error {
+	if len(s.Failures) > 0 {
+		err := s.Failures[0]
+		s.Failures = s.Failures[1:]
+		return err
+	}
+
+	s.Received = append(s.Received, msg)
+	s.Keys = append(s.Keys, key)
+
+	return nil
+}
+
+// FakeHandledMessages keeps handled message keys in memory.
+type FakeHandledMessages struct {
+	handled map[string]map[string]time.Time
+}
+
+func (m *FakeHandledMessages) Handled(ctx context.Context, subscriber, key string) (bool, error) {
+	_, ok := m.handled[subscriber][key]
+	return ok, nil
+}
+
+func (m *FakeHandledMessages) MarkHandled(ctx context.Context, subscriber, key string, at time.Time) error {
+	if m.handled[subscriber] == nil {
+		m.handled[subscriber] = make(map[string]time.Time)
+	}
+
+	_, ok := m.handled[subscriber][key]
+	if !ok {
+		m.handled[subscriber][key] = at
+	}
+
+	return nil
+}
+
+func NewFakeHandledMessages() *FakeHandledMessages {
+	return &FakeHandledMessages{
+		handled: make(map[string]map[string]time.Time),
+	}
+}
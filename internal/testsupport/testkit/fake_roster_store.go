@@ -1,6 +1,7 @@
 package testkit
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/spcameron/dugout/internal/domain"
@@ -8,54 +9,118 @@ import (
 	"github.com/spcameron/dugout/internal/ports"
 )
 
+// Like the Postgres store, FakeRosterStore fails with the context's error once ctx is
+// done, so handlers see cancellation where they would in production. It also keeps
+// every committed event in one log, in commit order, for ReadAfter, positioned by
+// Positions.
 type FakeRosterStore struct {
+	Positions *LogPositions
+
 	committed map[domain.TeamID][]eventlog.Recorded[domain.RosterEvent]
+	log       []eventlog.Entry[domain.RosterEvent]
 }
 
-func (s *FakeRosterStore) Load(id domain.TeamID) ([]eventlog.Recorded[domain.RosterEvent], ports.Version, error) {
+func (s *FakeRosterStore) Load(ctx context.Context, id domain.TeamID) ([]eventlog.Recorded[domain.RosterEvent], ports.Version, error) {
+	err := ctx.Err()
+	if err != nil {
+		return nil, 0, err
+	}
+
 	history := s.committed[id]
 	history = append([]eventlog.Recorded[domain.RosterEvent](nil), history...)
 
-	var lastSeq eventlog.Sequence
-	if len(history) > 0 {
-		lastSeq = history[len(history)-1].Sequence
+	return history, s.currentVersion(id), nil
+}
+
+func (s *FakeRosterStore) Append(ctx context.Context, id domain.TeamID, newEvents []domain.RosterEvent, expected ports.Version) (ports.Version, error) {
+	err := ctx.Err()
+	if err != nil {
+		return 0, err
 	}
 
-	return history, ports.Version(lastSeq), nil
+	current := s.currentVersion(id)
+	if current != expected {
+		return 0, &ports.VersionConflictError{TeamID: id, Current: current, Expected: expected}
+	}
+
+	err = checkTeam(id, newEvents)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.commit(id, newEvents), nil
 }
 
-func (s *FakeRosterStore) Append(id domain.TeamID, newEvents []domain.RosterEvent, expected ports.Version) (ports.Version, error) {
-	history := s.committed[id]
-	history = append([]eventlog.Recorded[domain.RosterEvent](nil), history...)
+// AppendMany checks every expected version and event team before committing
+// anything, so either all streams are appended or none are.
+func (s *FakeRosterStore) AppendMany(ctx context.Context, appends []ports.StreamAppend) ([]ports.Version, error) {
+	err := ctx.Err()
+	if err != nil {
+		return nil, err
+	}
 
-	var lastSeq eventlog.Sequence
-	if len(history) > 0 {
-		lastSeq = history[len(history)-1].Sequence
+	seen := make(map[domain.TeamID]struct{}, len(appends))
+	for _, a := range appends {
+		if _, ok := seen[a.TeamID]; ok {
+			return nil, fmt.Errorf("%w: team %v", ports.ErrDuplicateStreamAppend, a.TeamID)
+		}
+		seen[a.TeamID] = struct{}{}
+
+		current := s.currentVersion(a.TeamID)
+		if current != a.Expected {
+			return nil, &ports.VersionConflictError{TeamID: a.TeamID, Current: current, Expected: a.Expected}
+		}
+
+		err := checkTeam(a.TeamID, a.Events)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	current := ports.Version(lastSeq)
-	if current != expected {
-		return 0, fmt.Errorf("%w: current - %v, expected - %v", ports.ErrVersionConflict, current, expected)
+	versions := make([]ports.Version, len(appends))
+	for i, a := range appends {
+		versions[i] = s.commit(a.TeamID, a.Events)
 	}
 
-	appendedHistory := make([]eventlog.Recorded[domain.RosterEvent], len(newEvents))
-	nextSeq := lastSeq
-	for i, ev := range newEvents {
-		nextSeq++
-		appendedHistory[i] = eventlog.Recorded[domain.RosterEvent]{
-			Sequence: nextSeq,
-			Event:    ev,
+	return versions, nil
+}
+
+func (s *FakeRosterStore) ReadAfter(ctx context.Context, after eventlog.Position, limit int) ([]eventlog.Entry[domain.RosterEvent], error) {
+	err := ctx.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []eventlog.Entry[domain.RosterEvent]
+	for _, entry := range s.log {
+		if len(entries) == limit {
+			break
+		}
+		if entry.Position > after {
+			entries = append(entries, entry)
 		}
 	}
 
-	s.committed[id] = append(history, appendedHistory...)
-	newLastSeq := nextSeq
+	return entries, nil
+}
 
-	return ports.Version(newLastSeq), nil
+func (s *FakeRosterStore) LastPosition(ctx context.Context) (eventlog.Position, error) {
+	err := ctx.Err()
+	if err != nil {
+		return 0, err
+	}
+
+	if len(s.log) == 0 {
+		return 0, nil
+	}
+
+	return s.log[len(s.log)-1].Position, nil
 }
 
 // SeedEvents overwrites the entire event stream for the given team,
 // then assigns contiguous 1-based sequence numbers to events in the order provided.
+// The seeded events are added to the end of the log; anything the stream held
+// before stays in the log.
 func (s *FakeRosterStore) SeedEvents(id domain.TeamID, events []domain.RosterEvent) {
 	if s.committed == nil {
 		s.committed = make(map[domain.TeamID][]eventlog.Recorded[domain.RosterEvent])
@@ -67,11 +132,66 @@ func (s *FakeRosterStore) SeedEvents(id domain.TeamID, events []domain.RosterEve
 			Sequence: eventlog.Sequence(i + 1),
 			Event:    ev,
 		}
+		s.appendLog(s.committed[id][i])
 	}
 }
 
+func (s *FakeRosterStore) currentVersion(id domain.TeamID) ports.Version {
+	history := s.committed[id]
+
+	var lastSeq eventlog.Sequence
+	if len(history) > 0 {
+		lastSeq = history[len(history)-1].Sequence
+	}
+
+	return ports.Version(lastSeq)
+}
+
+func (s *FakeRosterStore) commit(id domain.TeamID, newEvents []domain.RosterEvent) ports.Version {
+	history := s.committed[id]
+	history = append([]eventlog.Recorded[domain.RosterEvent](nil), history...)
+
+	nextSeq := eventlog.Sequence(s.currentVersion(id))
+	for _, ev := range newEvents {
+		nextSeq++
+		history = append(history, eventlog.Recorded[domain.RosterEvent]{
+			Sequence: nextSeq,
+			Event:    ev,
+		})
+		s.appendLog(history[len(history)-1])
+	}
+
+	s.committed[id] = history
+
+	return ports.Version(nextSeq)
+}
+
+func (s *FakeRosterStore) appendLog(recorded eventlog.Recorded[domain.RosterEvent]) {
+	if s.Positions == nil {
+		s.Positions = &LogPositions{}
+	}
+
+	s.log = append(s.log, eventlog.Entry[domain.RosterEvent]{
+		Position: s.Positions.next(),
+		Recorded: recorded,
+	})
+}
+
+// checkTeam rejects events recorded for another team's stream, as the Postgres
+// store does.
+func checkTeam(id domain.TeamID, events []domain.RosterEvent) error {
+	for _, ev := range events {
+		if ev.Team() != id {
+			return fmt.Errorf("%w: event team %v, stream team %v", domain.ErrWrongTeamID, ev.Team(), id)
+		}
+	}
+
+	return nil
+}
+
 func NewFakeRosterStore() *FakeRosterStore {
 	return &FakeRosterStore{
+		Positions: &LogPositions{},
 		committed: make(map[domain.TeamID][]eventlog.Recorded[domain.RosterEvent]),
 	}
 }
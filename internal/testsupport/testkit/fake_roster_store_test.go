@@ -0,0 +1,90 @@
+package testkit_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+)
+
+func TestFakeRosterStore_AppendMany(t *testing.T) {
+	added := func(team domain.TeamID, player domain.PlayerID) domain.RosterEvent {
+		return domain.AddedPlayerToRoster{TeamID: team, PlayerID: player, EffectiveAt: testkit.TodayLock()}
+	}
+
+	t.Run("commits every stream and returns versions in input order", func(t *testing.T) {
+		store := testkit.NewFakeRosterStore()
+		store.SeedEvents(testkit.TeamB(), []domain.RosterEvent{added(testkit.TeamB(), 2)})
+
+		versions, err := store.AppendMany(t.Context(), []ports.StreamAppend{
+			{TeamID: testkit.TeamB(), Events: []domain.RosterEvent{added(testkit.TeamB(), 3)}, Expected: 1},
+			{TeamID: testkit.TeamA(), Events: []domain.RosterEvent{added(testkit.TeamA(), 4)}, Expected: 0},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, versions, []ports.Version{2, 1})
+	})
+
+	testCases := []struct {
+		name    string
+		appends []ports.StreamAppend
+		wantErr error
+	}{
+		{
+			name: "stale stream rolls back the whole batch",
+			appends: []ports.StreamAppend{
+				{TeamID: testkit.TeamA(), Events: []domain.RosterEvent{added(testkit.TeamA(), 4)}, Expected: 0},
+				{TeamID: testkit.TeamB(), Events: []domain.RosterEvent{added(testkit.TeamB(), 3)}, Expected: 0},
+			},
+			wantErr: ports.ErrVersionConflict,
+		},
+		{
+			name: "event for another team rolls back the whole batch",
+			appends: []ports.StreamAppend{
+				{TeamID: testkit.TeamA(), Events: []domain.RosterEvent{added(testkit.TeamA(), 4)}, Expected: 0},
+				{TeamID: testkit.TeamB(), Events: []domain.RosterEvent{added(testkit.TeamA(), 3)}, Expected: 1},
+			},
+			wantErr: domain.ErrWrongTeamID,
+		},
+		{
+			name: "naming a stream twice rolls back the whole batch",
+			appends: []ports.StreamAppend{
+				{TeamID: testkit.TeamA(), Events: []domain.RosterEvent{added(testkit.TeamA(), 4)}, Expected: 0},
+				{TeamID: testkit.TeamA(), Events: []domain.RosterEvent{added(testkit.TeamA(), 5)}, Expected: 0},
+			},
+			wantErr: ports.ErrDuplicateStreamAppend,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := testkit.NewFakeRosterStore()
+			store.SeedEvents(testkit.TeamB(), []domain.RosterEvent{added(testkit.TeamB(), 2)})
+
+			_, err := store.AppendMany(t.Context(), tc.appends)
+			require.ErrorIs(t, err, tc.wantErr)
+
+			history, version, err := store.Load(t.Context(), testkit.TeamA())
+			require.NoError(t, err)
+			assert.Equal(t, len(history), 0)
+			assert.Equal(t, version, ports.Version(0))
+
+			last, err := store.LastPosition(t.Context())
+			require.NoError(t, err)
+			assert.Equal(t, last, 1)
+		})
+	}
+}
+
+func TestFakeRosterStore_Append(t *testing.T) {
+	store := testkit.NewFakeRosterStore()
+
+	_, err := store.Append(t.Context(), testkit.TeamA(), []domain.RosterEvent{
+		domain.AddedPlayerToRoster{TeamID: testkit.TeamB(), PlayerID: 1, EffectiveAt: testkit.TodayLock()},
+	}, 0)
+
+	assert.ErrorIs(t, err, domain.ErrWrongTeamID)
+}
@@ -0,0 +1,41 @@
+package testkit
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+type FakeSessionStore struct {
+	sessions map[string]ports.Session
+}
+
+func (s *FakeSessionStore) Create(ctx context.Context, session ports.Session) error {
+	s.sessions[string(session.TokenHash)] = session
+	return nil
+}
+
+func (s *FakeSessionStore) Get(ctx context.Context, tokenHash []byte) (ports.Session, error) {
+	session, ok := s.sessions[string(tokenHash)]
+	if !ok {
+		return ports.Session{}, ports.ErrSessionNotFound
+	}
+
+	return session, nil
+}
+
+func (s *FakeSessionStore) Delete(ctx context.Context, tokenHash []byte) error {
+	delete(s.sessions, string(tokenHash))
+	return nil
+}
+
+// Len returns the number of stored sessions, expired or not.
+func (s *FakeSessionStore) Len() int {
+	return len(s.sessions)
+}
+
+func NewFakeSessionStore() *FakeSessionStore {
+	return &FakeSessionStore{
+		sessions: make(map[string]ports.Session),
+	}
+}
@@ -0,0 +1,172 @@
+package testkit
+
+import (
+	"encoding/base64"
+	"errors"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// FakeSMTPServer speaks enough SMTP on a local port for net/smtp to deliver to
+// it. It offers AUTH PLAIN but not STARTTLS, keeps every message it accepts,
+// and refuses recipients listed in Reject.
+type FakeSMTPServer struct {
+	Addr   string
+	Reject []string
+
+	mu       sync.Mutex
+	messages []FakeMail
+}
+
+// FakeMail is a message as the server received it. Data is the raw message with
+// dot-stuffing undone.
+type FakeMail struct {
+	AuthUser string
+	From     string
+	To       []string
+	Data     string
+}
+
+// Messages returns the messages accepted so far.
+func (s *FakeSMTPServer) Messages() []FakeMail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]FakeMail(nil), s.messages...)
+}
+
+func (s *FakeSMTPServer) serve(conn net.Conn) {
+	defer conn.Close()
+
+	tp := textproto.NewConn(conn)
+	reply := func(line string) bool {
+		return tp.PrintfLine("%s", line) == nil
+	}
+
+	if !reply("220 localhost fake SMTP") {
+		return
+	}
+
+	var mail FakeMail
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			reply("250-localhost")
+			reply("250 AUTH PLAIN")
+		case "AUTH":
+			mail.AuthUser = plainAuthUser(arg)
+			reply("235 authenticated")
+		case "MAIL":
+			mail.From = addressArg(arg)
+			reply("250 ok")
+		case "RCPT":
+			to := addressArg(arg)
+			if s.rejects(to) {
+				reply("550 no such user")
+				continue
+			}
+			mail.To = append(mail.To, to)
+			reply("250 ok")
+		case "DATA":
+			reply("354 go ahead")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			mail.Data = string(data)
+
+			s.mu.Lock()
+			s.messages = append(s.messages, mail)
+			s.mu.Unlock()
+
+			mail = FakeMail{AuthUser: mail.AuthUser}
+			reply("250 queued")
+		case "RSET":
+			mail = FakeMail{AuthUser: mail.AuthUser}
+			reply("250 ok")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+func (s *FakeSMTPServer) rejects(to string) bool {
+	for _, r := range s.Reject {
+		if strings.EqualFold(r, to) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// addressArg takes the address out of "FROM:<a@b>" or "TO:<a@b>".
+func addressArg(arg string) string {
+	_, addr, _ := strings.Cut(arg, ":")
+	addr, _, _ = strings.Cut(strings.TrimSpace(addr), " ")
+
+	return strings.Trim(addr, "<>")
+}
+
+// plainAuthUser returns the username from an initial AUTH PLAIN response.
+func plainAuthUser(arg string) string {
+	_, encoded, _ := strings.Cut(arg, " ")
+
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return ""
+	}
+
+	parts := strings.Split(string(decoded), "\x00")
+	if len(parts) != 3 {
+		return ""
+	}
+
+	return parts[1]
+}
+
+// StartFakeSMTPServer listens on a free local port until the test ends.
+func StartFakeSMTPServer(t *testing.T) *FakeSMTPServer {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listening for fake SMTP: %v", err)
+	}
+
+	s := &FakeSMTPServer{Addr: ln.Addr().String()}
+
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		for {
+			conn, err := ln.Accept()
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			if err != nil {
+				continue
+			}
+
+			wg.Go(func() { s.serve(conn) })
+		}
+	})
+
+	t.Cleanup(func() {
+		ln.Close()
+		wg.Wait()
+	})
+
+	return s
+}
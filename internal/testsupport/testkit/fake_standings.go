@@ -0,0 +1,24 @@
+package testkit
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+)
+
+type FakeStandings struct {
+	ByLeague map[domain.LeagueID][]domain.TeamID
+}
+
+func (s *FakeStandings) Standings(ctx context.Context, id domain.LeagueID) ([]domain.TeamID, error) {
+	return s.ByLeague[id], nil
+}
+
+// NewFakeStandings ranks LeagueA with TeamB ahead of TeamA.
+func NewFakeStandings() *FakeStandings {
+	return &FakeStandings{
+		ByLeague: map[domain.LeagueID][]domain.TeamID{
+			LeagueA(): {TeamB(), TeamA()},
+		},
+	}
+}
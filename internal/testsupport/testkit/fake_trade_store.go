@@ -0,0 +1,151 @@
+package testkit
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// Like the Postgres store, FakeTradeStore fails with the context's error once ctx is
+// done, so handlers see cancellation where they would in production. It also keeps
+// every committed event in one log, in commit order, for ReadAfter, positioned by
+// Positions.
+type FakeTradeStore struct {
+	Positions *LogPositions
+
+	committed map[domain.TradeID][]eventlog.Recorded[domain.TradeEvent]
+	log       []eventlog.Entry[domain.TradeEvent]
+}
+
+func (s *FakeTradeStore) Load(ctx context.Context, id domain.TradeID) ([]eventlog.Recorded[domain.TradeEvent], ports.Version, error) {
+	err := ctx.Err()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	history := s.committed[id]
+	history = append([]eventlog.Recorded[domain.TradeEvent](nil), history...)
+
+	return history, s.currentVersion(id), nil
+}
+
+func (s *FakeTradeStore) Append(ctx context.Context, id domain.TradeID, newEvents []domain.TradeEvent, expected ports.Version) (ports.Version, error) {
+	err := ctx.Err()
+	if err != nil {
+		return 0, err
+	}
+
+	current := s.currentVersion(id)
+	if current != expected {
+		return 0, fmt.Errorf("%w: trade %v, current - %v, expected - %v", ports.ErrVersionConflict, id, current, expected)
+	}
+
+	history := append([]eventlog.Recorded[domain.TradeEvent](nil), s.committed[id]...)
+	nextSeq := eventlog.Sequence(current)
+	for _, ev := range newEvents {
+		nextSeq++
+		history = append(history, eventlog.Recorded[domain.TradeEvent]{
+			Sequence: nextSeq,
+			Event:    ev,
+		})
+		s.appendLog(history[len(history)-1])
+	}
+
+	s.committed[id] = history
+
+	return ports.Version(nextSeq), nil
+}
+
+func (s *FakeTradeStore) ReadAfter(ctx context.Context, after eventlog.Position, limit int) ([]eventlog.Entry[domain.TradeEvent], error) {
+	err := ctx.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []eventlog.Entry[domain.TradeEvent]
+	for _, entry := range s.log {
+		if len(entries) == limit {
+			break
+		}
+		if entry.Position > after {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, nil
+}
+
+func (s *FakeTradeStore) LastPosition(ctx context.Context) (eventlog.Position, error) {
+	err := ctx.Err()
+	if err != nil {
+		return 0, err
+	}
+
+	if len(s.log) == 0 {
+		return 0, nil
+	}
+
+	return s.log[len(s.log)-1].Position, nil
+}
+
+// ListUnderReview projects every stored trade and returns the IDs still under review,
+// in ascending order.
+func (s *FakeTradeStore) ListUnderReview(ctx context.Context) ([]domain.TradeID, error) {
+	var ids []domain.TradeID
+	for id, history := range s.committed {
+		tv := domain.TradeView{TradeID: id}
+		for _, re := range history {
+			tv.Apply(re.Event)
+		}
+
+		if tv.Status == domain.TradeUnderReview {
+			ids = append(ids, id)
+		}
+	}
+
+	slices.Sort(ids)
+
+	return ids, nil
+}
+
+// SeedEvents overwrites the entire event stream for the given trade,
+// then assigns contiguous 1-based sequence numbers to events in the order provided.
+// The seeded events are added to the end of the log; anything the stream held
+// before stays in the log.
+func (s *FakeTradeStore) SeedEvents(id domain.TradeID, events []domain.TradeEvent) {
+	s.committed[id] = make([]eventlog.Recorded[domain.TradeEvent], len(events))
+	for i, ev := range events {
+		s.committed[id][i] = eventlog.Recorded[domain.TradeEvent]{
+			Sequence: eventlog.Sequence(i + 1),
+			Event:    ev,
+		}
+		s.appendLog(s.committed[id][i])
+	}
+}
+
+func (s *FakeTradeStore) currentVersion(id domain.TradeID) ports.Version {
+	history := s.committed[id]
+	if len(history) == 0 {
+		return 0
+	}
+
+	return ports.Version(history[len(history)-1].Sequence)
+}
+
+func (s *FakeTradeStore) appendLog(recorded eventlog.Recorded[domain.TradeEvent]) {
+	s.log = append(s.log, eventlog.Entry[domain.TradeEvent]{
+		Position: s.Positions.next(),
+		Recorded: recorded,
+	})
+}
+
+func NewFakeTradeStore() *FakeTradeStore {
+	return &FakeTradeStore{
+		Positions: &LogPositions{},
+		committed: make(map[domain.TradeID][]eventlog.Recorded[domain.TradeEvent]),
+	}
+}
@@ -0,0 +1,67 @@
+package testkit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+type FakeUserRepository struct {
+	users  map[domain.UserID]ports.Credentials
+	nextID domain.UserID
+}
+
+func (r *FakeUserRepository) Create(ctx context.Context, user domain.User, passwordHash []byte) (domain.User, error) {
+	for _, c := range r.users {
+		if c.User.Email == user.Email {
+			return domain.User{}, fmt.Errorf("%w: %s", ports.ErrEmailTaken, user.Email)
+		}
+	}
+
+	r.nextID++
+	user.ID = r.nextID
+	r.users[user.ID] = ports.Credentials{
+		User:         user,
+		PasswordHash: passwordHash,
+	}
+
+	return user, nil
+}
+
+func (r *FakeUserRepository) Get(ctx context.Context, id domain.UserID) (domain.User, error) {
+	c, ok := r.users[id]
+	if !ok {
+		return domain.User{}, fmt.Errorf("%w: %v", ports.ErrUserNotFound, id)
+	}
+
+	return c.User, nil
+}
+
+func (r *FakeUserRepository) GetCredentials(ctx context.Context, email string) (ports.Credentials, error) {
+	for _, c := range r.users {
+		if c.User.Email == email {
+			return c, nil
+		}
+	}
+
+	return ports.Credentials{}, fmt.Errorf("%w: %s", ports.ErrUserNotFound, email)
+}
+
+func NewFakeUserRepository() *FakeUserRepository {
+	return &FakeUserRepository{
+		users: make(map[domain.UserID]ports.Credentials),
+	}
+}
+
+// FakePasswordHasher "hashes" by prefixing, so tests can read what was stored.
+type FakePasswordHasher struct{}
+
+func (FakePasswordHasher) Hash(password string) ([]byte, error) {
+	return []byte("hashed:" + password), nil
+}
+
+func (FakePasswordHasher) Matches(hash []byte, password string) (bool, error) {
+	return string(hash) == "hashed:"+password, nil
+}
@@ -1,20 +1,36 @@
 package testkit
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 type StubLeagueLock struct {
 	Last time.Time
 	Next time.Time
 }
 
-func (s StubLeagueLock) LastLock() time.Time {
+func (s StubLeagueLock) LastLock(ctx context.Context) time.Time {
 	return s.Last
 }
 
-func (s StubLeagueLock) NextLock() time.Time {
+func (s StubLeagueLock) NextLock(ctx context.Context) time.Time {
 	return s.Next
 }
 
+// LockAtOrAfter snaps t forward to Last or Next when it falls at or before them,
+// and treats any later time as a lock of its own.
+func (s StubLeagueLock) LockAtOrAfter(ctx context.Context, t time.Time) time.Time {
+	switch {
+	case !t.After(s.Last):
+		return s.Last
+	case !t.After(s.Next):
+		return s.Next
+	default:
+		return t
+	}
+}
+
 func NewStubLeagueLock() StubLeagueLock {
 	return StubLeagueLock{
 		Last: TodayLock(),
@@ -0,0 +1,15 @@
+package testkit
+
+import "github.com/spcameron/dugout/internal/eventlog"
+
+// LogPositions hands out log positions in order. Share one between a
+// FakeRosterStore and a FakeTradeStore to interleave their logs as Postgres does.
+type LogPositions struct {
+	last eventlog.Position
+}
+
+func (p *LogPositions) next() eventlog.Position {
+	p.last++
+
+	return p.last
+}
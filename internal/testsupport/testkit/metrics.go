@@ -0,0 +1,54 @@
+package testkit
+
+import (
+	"context"
+	"testing"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/spcameron/dugout/internal/telemetry"
+)
+
+// RecordMetrics returns instruments on a meter provider of the test's own, whose
+// readings the test collects on demand with CollectMetric. It leaves the global
+// provider alone, so tests that use it may run in parallel.
+func RecordMetrics(t *testing.T) (*telemetry.Metrics, *sdkmetric.ManualReader) {
+	t.Helper()
+
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	t.Cleanup(func() {
+		_ = provider.Shutdown(context.Background())
+	})
+
+	metrics, err := telemetry.NewMetrics(provider)
+	if err != nil {
+		t.Fatalf("creating metrics: %v", err)
+	}
+
+	return metrics, reader
+}
+
+// CollectMetric reads the metric called name, failing the test if nothing has
+// recorded it.
+func CollectMetric(t *testing.T, reader *sdkmetric.ManualReader, name string) metricdata.Aggregation {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	err := reader.Collect(t.Context(), &rm)
+	if err != nil {
+		t.Fatalf("collecting metrics: %v", err)
+	}
+
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name == name {
+				return m.Data
+			}
+		}
+	}
+
+	t.Fatalf("no metric named %q", name)
+	return nil
+}
@@ -0,0 +1,25 @@
+package testkit
+
+import (
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/projection"
+)
+
+// NewLeagueRunner runs projections over the fake league store's log as
+// NewRosterRunner does over the roster log.
+func NewLeagueRunner(store *FakeLeagueStore, projections ...*projection.Projection[domain.LeagueEvent]) projection.Runner[domain.LeagueEvent] {
+	runner := projection.NewRunner[domain.LeagueEvent](store, projection.NewMemoryCheckpoints(), projections...)
+	runner.BatchSize = 2
+
+	return runner
+}
+
+// NewRosterRunner runs projections over the fake store's log, keeping
+// checkpoints in memory. The small batch size makes catching up take several
+// reads.
+func NewRosterRunner(store *FakeRosterStore, projections ...*projection.Projection[domain.RosterEvent]) projection.Runner[domain.RosterEvent] {
+	runner := projection.NewRunner[domain.RosterEvent](store, projection.NewMemoryCheckpoints(), projections...)
+	runner.BatchSize = 2
+
+	return runner
+}
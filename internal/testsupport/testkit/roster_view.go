@@ -9,7 +9,8 @@ import (
 
 // NewRosterView returns a RosterView containing a given number of players.
 //
-// Players will be assigned consecutive PlayerIDs beginning from 1, and an inactive RosterStatus.
+// Players will be assigned consecutive PlayerIDs beginning from 1, an inactive RosterStatus,
+// and eligibility for both roles.
 // Panics if the number of players is less than zero, or greater than MaxRosterSize.
 func NewRosterView(teamID domain.TeamID, players int, lock time.Time) domain.RosterView {
 	if players < 0 {
@@ -31,6 +32,7 @@ func NewRosterView(teamID domain.TeamID, players int, lock time.Time) domain.Ros
 			TeamID:       teamID,
 			PlayerID:     domain.PlayerID(i + 1),
 			RosterStatus: domain.StatusInactive,
+			Eligibility:  domain.MustRoleSet(domain.RoleHitter, domain.RolePitcher),
 		}
 	}
 
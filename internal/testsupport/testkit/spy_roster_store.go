@@ -1,6 +1,8 @@
 package testkit
 
 import (
+	"context"
+
 	"github.com/spcameron/dugout/internal/domain"
 	"github.com/spcameron/dugout/internal/eventlog"
 	"github.com/spcameron/dugout/internal/ports"
@@ -15,14 +17,15 @@ type SpyRosterStore struct {
 		Events  []domain.RosterEvent
 		Version ports.Version
 	}
+	AppendManyCalls [][]ports.StreamAppend
 }
 
-func (s *SpyRosterStore) Load(id domain.TeamID) ([]eventlog.Recorded[domain.RosterEvent], ports.Version, error) {
+func (s *SpyRosterStore) Load(ctx context.Context, id domain.TeamID) ([]eventlog.Recorded[domain.RosterEvent], ports.Version, error) {
 	s.LoadCalls = append(s.LoadCalls, id)
-	return s.InnerStore.Load(id)
+	return s.InnerStore.Load(ctx, id)
 }
 
-func (s *SpyRosterStore) Append(id domain.TeamID, newEvents []domain.RosterEvent, expected ports.Version) (ports.Version, error) {
+func (s *SpyRosterStore) Append(ctx context.Context, id domain.TeamID, newEvents []domain.RosterEvent, expected ports.Version) (ports.Version, error) {
 	eventsCopy := append([]domain.RosterEvent(nil), newEvents...)
 	s.AppendCalls = append(s.AppendCalls, struct {
 		TeamID  domain.TeamID
@@ -34,7 +37,21 @@ func (s *SpyRosterStore) Append(id domain.TeamID, newEvents []domain.RosterEvent
 		Version: expected,
 	})
 
-	return s.InnerStore.Append(id, eventsCopy, expected)
+	return s.InnerStore.Append(ctx, id, eventsCopy, expected)
+}
+
+func (s *SpyRosterStore) AppendMany(ctx context.Context, appends []ports.StreamAppend) ([]ports.Version, error) {
+	appendsCopy := make([]ports.StreamAppend, len(appends))
+	for i, a := range appends {
+		appendsCopy[i] = ports.StreamAppend{
+			TeamID:   a.TeamID,
+			Events:   append([]domain.RosterEvent(nil), a.Events...),
+			Expected: a.Expected,
+		}
+	}
+	s.AppendManyCalls = append(s.AppendManyCalls, appendsCopy)
+
+	return s.InnerStore.AppendMany(ctx, appendsCopy)
 }
 
 func NewSpyRosterStore(inner ports.RosterStore) *SpyRosterStore {
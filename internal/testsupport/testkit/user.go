@@ -0,0 +1,24 @@
+package testkit
+
+import "github.com/spcameron/dugout/internal/domain"
+
+// LeagueA returns the fixed LeagueID 1.
+func LeagueA() domain.LeagueID {
+	return domain.LeagueID(1)
+}
+
+// ManagerA returns the fixed UserID 11, TeamA's manager in NewLeagueMemberships.
+func ManagerA() domain.UserID {
+	return domain.UserID(11)
+}
+
+// ManagerB returns the fixed UserID 22, TeamB's manager in NewLeagueMemberships.
+func ManagerB() domain.UserID {
+	return domain.UserID(22)
+}
+
+// Commissioner returns the fixed UserID 99, LeagueA's commissioner in
+// NewLeagueMemberships.
+func Commissioner() domain.UserID {
+	return domain.UserID(99)
+}
@@ -0,0 +1,26 @@
+package token_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/token"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("hash matches the token it was issued with", func(t *testing.T) {
+		tok, hash := token.New()
+
+		assert.True(t, bytes.Equal(hash, token.Hash(tok)))
+		assert.False(t, bytes.Contains(hash, []byte(tok)))
+	})
+
+	t.Run("tokens are not repeated", func(t *testing.T) {
+		a, _ := token.New()
+		b, _ := token.New()
+
+		assert.True(t, a != b)
+		assert.True(t, len(a) >= 26)
+	})
+}
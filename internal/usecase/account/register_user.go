@@ -0,0 +1,58 @@
+package account
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+type RegisterUserHandler struct {
+	Users  ports.UserRepository
+	Hasher ports.PasswordHasher
+}
+
+func (h RegisterUserHandler) Handle(ctx context.Context, cmd RegisterUserCommand) (domain.User, error) {
+	user := domain.User{
+		Email:       domain.NormalizeEmail(cmd.Email),
+		DisplayName: cmd.DisplayName,
+	}
+
+	err := user.Validate()
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	err = domain.ValidatePassword(cmd.Password)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	hash, err := h.Hasher.Hash(cmd.Password)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	return h.Users.Create(ctx, user, hash)
+}
+
+func NewRegisterUserHandler(users ports.UserRepository, hasher ports.PasswordHasher) RegisterUserHandler {
+	return RegisterUserHandler{
+		Users:  users,
+		Hasher: hasher,
+	}
+}
+
+type RegisterUserCommand struct {
+	Email       string
+	DisplayName string
+	Password    string
+}
+
+func NewRegisterUserCommand(email, displayName, password string) RegisterUserCommand {
+	return RegisterUserCommand{
+		Email:       email,
+		DisplayName: displayName,
+		Password:    password,
+	}
+}
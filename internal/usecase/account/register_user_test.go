@@ -0,0 +1,71 @@
+package account_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/account"
+)
+
+func TestRegisterUserHandler_Handle(t *testing.T) {
+	testCases := []struct {
+		name     string
+		email    string
+		password string
+		wantErr  error
+	}{
+		{
+			name:     "registers a user with a normalized email",
+			email:    "  Skipper@Example.com ",
+			password: "correct horse battery",
+		},
+		{
+			name:     "rejects an invalid email",
+			email:    "skipper",
+			password: "correct horse battery",
+			wantErr:  domain.ErrInvalidEmail,
+		},
+		{
+			name:     "rejects a weak password",
+			email:    "skipper@example.com",
+			password: "hunter2",
+			wantErr:  domain.ErrWeakPassword,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			users := testkit.NewFakeUserRepository()
+			handler := account.NewRegisterUserHandler(users, testkit.FakePasswordHasher{})
+
+			user, err := handler.Handle(t.Context(), account.NewRegisterUserCommand(tc.email, "Skipper", tc.password))
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, user.Email, "skipper@example.com")
+
+			creds, err := users.GetCredentials(t.Context(), "skipper@example.com")
+			require.NoError(t, err)
+			assert.Equal(t, creds.User, user)
+			assert.Equal(t, string(creds.PasswordHash), "hashed:"+tc.password)
+		})
+	}
+
+	t.Run("rejects an email that is already registered", func(t *testing.T) {
+		handler := account.NewRegisterUserHandler(testkit.NewFakeUserRepository(), testkit.FakePasswordHasher{})
+
+		_, err := handler.Handle(t.Context(), account.NewRegisterUserCommand("skipper@example.com", "Skipper", "correct horse battery"))
+		require.NoError(t, err)
+
+		_, err = handler.Handle(t.Context(), account.NewRegisterUserCommand("SKIPPER@example.com", "Other", "correct horse battery"))
+		assert.ErrorIs(t, err, ports.ErrEmailTaken)
+	})
+}
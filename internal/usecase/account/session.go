@@ -0,0 +1,57 @@
+package account
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/token"
+)
+
+// AuthenticateHandler resolves a session token to the signed-in user. Unknown
+// and expired tokens both return ErrSessionNotFound; expired sessions are
+// deleted as they are found.
+type AuthenticateHandler struct {
+	Sessions ports.SessionStore
+	Clock    ports.Clock
+}
+
+func (h AuthenticateHandler) Handle(ctx context.Context, tok string) (domain.UserID, error) {
+	hash := token.Hash(tok)
+
+	session, err := h.Sessions.Get(ctx, hash)
+	if err != nil {
+		return 0, err
+	}
+
+	if !h.Clock.Now().Before(session.ExpiresAt) {
+		err = h.Sessions.Delete(ctx, hash)
+		return 0, errors.Join(ports.ErrSessionNotFound, err)
+	}
+
+	return session.UserID, nil
+}
+
+func NewAuthenticateHandler(sessions ports.SessionStore, clock ports.Clock) AuthenticateHandler {
+	return AuthenticateHandler{
+		Sessions: sessions,
+		Clock:    clock,
+	}
+}
+
+// SignOutHandler ends a session. Signing out of a session that no longer
+// exists is not an error.
+type SignOutHandler struct {
+	Sessions ports.SessionStore
+}
+
+func (h SignOutHandler) Handle(ctx context.Context, tok string) error {
+	return h.Sessions.Delete(ctx, token.Hash(tok))
+}
+
+func NewSignOutHandler(sessions ports.SessionStore) SignOutHandler {
+	return SignOutHandler{
+		Sessions: sessions,
+	}
+}
@@ -0,0 +1,95 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/token"
+)
+
+// SignInHandler checks a user's password and opens a session lasting TTL.
+//
+// An unknown email and a wrong password both return ErrInvalidCredentials, so
+// the response does not reveal which accounts exist.
+type SignInHandler struct {
+	Users    ports.UserRepository
+	Hasher   ports.PasswordHasher
+	Sessions ports.SessionStore
+	Clock    ports.Clock
+	TTL      time.Duration
+}
+
+// SignedIn carries the session token to hand to the client. Only its hash is
+// stored.
+type SignedIn struct {
+	User      domain.User
+	Token     string
+	ExpiresAt time.Time
+}
+
+func (h SignInHandler) Handle(ctx context.Context, cmd SignInCommand) (SignedIn, error) {
+	creds, err := h.Users.GetCredentials(ctx, domain.NormalizeEmail(cmd.Email))
+	if errors.Is(err, ports.ErrUserNotFound) {
+		return SignedIn{}, domain.ErrInvalidCredentials
+	}
+	if err != nil {
+		return SignedIn{}, err
+	}
+
+	ok, err := h.Hasher.Matches(creds.PasswordHash, cmd.Password)
+	if err != nil {
+		return SignedIn{}, err
+	}
+	if !ok {
+		return SignedIn{}, domain.ErrInvalidCredentials
+	}
+
+	tok, hash := token.New()
+	session := ports.Session{
+		TokenHash: hash,
+		UserID:    creds.User.ID,
+		ExpiresAt: h.Clock.Now().Add(h.TTL),
+	}
+
+	err = h.Sessions.Create(ctx, session)
+	if err != nil {
+		return SignedIn{}, err
+	}
+
+	return SignedIn{
+		User:      creds.User,
+		Token:     tok,
+		ExpiresAt: session.ExpiresAt,
+	}, nil
+}
+
+func NewSignInHandler(
+	users ports.UserRepository,
+	hasher ports.PasswordHasher,
+	sessions ports.SessionStore,
+	clock ports.Clock,
+	ttl time.Duration,
+) SignInHandler {
+	return SignInHandler{
+		Users:    users,
+		Hasher:   hasher,
+		Sessions: sessions,
+		Clock:    clock,
+		TTL:      ttl,
+	}
+}
+
+type SignInCommand struct {
+	Email    string
+	Password string
+}
+
+func NewSignInCommand(email, password string) SignInCommand {
+	return SignInCommand{
+		Email:    email,
+		Password: password,
+	}
+}
@@ -0,0 +1,133 @@
+package account_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/account"
+)
+
+const (
+	sessionTTL = 24 * time.Hour
+	password   = "correct horse battery"
+)
+
+type accountFixture struct {
+	users    *testkit.FakeUserRepository
+	sessions *testkit.FakeSessionStore
+	clock    *testkit.StubClock
+	user     domain.User
+}
+
+func newAccountFixture(t *testing.T) accountFixture {
+	t.Helper()
+
+	users := testkit.NewFakeUserRepository()
+	user, err := account.NewRegisterUserHandler(users, testkit.FakePasswordHasher{}).
+		Handle(t.Context(), account.NewRegisterUserCommand("skipper@example.com", "Skipper", password))
+	require.NoError(t, err)
+
+	return accountFixture{
+		users:    users,
+		sessions: testkit.NewFakeSessionStore(),
+		clock:    testkit.NewStubClock(testkit.TodayLock()),
+		user:     user,
+	}
+}
+
+func (f accountFixture) signIn() account.SignInHandler {
+	return account.NewSignInHandler(f.users, testkit.FakePasswordHasher{}, f.sessions, f.clock, sessionTTL)
+}
+
+func TestSignInHandler_Handle(t *testing.T) {
+	testCases := []struct {
+		name     string
+		email    string
+		password string
+		wantErr  error
+	}{
+		{
+			name:     "correct password opens a session",
+			email:    "Skipper@example.com",
+			password: password,
+		},
+		{
+			name:     "wrong password is rejected",
+			email:    "skipper@example.com",
+			password: "incorrect horse battery",
+			wantErr:  domain.ErrInvalidCredentials,
+		},
+		{
+			name:     "unknown email is rejected the same way",
+			email:    "nobody@example.com",
+			password: password,
+			wantErr:  domain.ErrInvalidCredentials,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newAccountFixture(t)
+
+			got, err := f.signIn().Handle(t.Context(), account.NewSignInCommand(tc.email, tc.password))
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.False(t, errors.Is(err, ports.ErrUserNotFound))
+				assert.Equal(t, f.sessions.Len(), 0)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, got.User, f.user)
+			assert.True(t, got.Token != "")
+			assert.Equal(t, got.ExpiresAt, f.clock.Now().Add(sessionTTL))
+			assert.Equal(t, f.sessions.Len(), 1)
+		})
+	}
+}
+
+func TestAuthenticateHandler_Handle(t *testing.T) {
+	t.Run("token resolves to its user until the session expires", func(t *testing.T) {
+		f := newAccountFixture(t)
+		signedIn, err := f.signIn().Handle(t.Context(), account.NewSignInCommand("skipper@example.com", password))
+		require.NoError(t, err)
+
+		auth := account.NewAuthenticateHandler(f.sessions, f.clock)
+
+		userID, err := auth.Handle(t.Context(), signedIn.Token)
+		require.NoError(t, err)
+		assert.Equal(t, userID, f.user.ID)
+
+		f.clock.Advance(sessionTTL)
+
+		_, err = auth.Handle(t.Context(), signedIn.Token)
+		assert.ErrorIs(t, err, ports.ErrSessionNotFound)
+		assert.Equal(t, f.sessions.Len(), 0)
+	})
+
+	t.Run("unknown token is rejected", func(t *testing.T) {
+		f := newAccountFixture(t)
+
+		_, err := account.NewAuthenticateHandler(f.sessions, f.clock).Handle(t.Context(), "not-a-token")
+
+		assert.ErrorIs(t, err, ports.ErrSessionNotFound)
+	})
+
+	t.Run("signing out ends the session", func(t *testing.T) {
+		f := newAccountFixture(t)
+		signedIn, err := f.signIn().Handle(t.Context(), account.NewSignInCommand("skipper@example.com", password))
+		require.NoError(t, err)
+
+		require.NoError(t, account.NewSignOutHandler(f.sessions).Handle(t.Context(), signedIn.Token))
+
+		_, err = account.NewAuthenticateHandler(f.sessions, f.clock).Handle(t.Context(), signedIn.Token)
+		assert.ErrorIs(t, err, ports.ErrSessionNotFound)
+	})
+}
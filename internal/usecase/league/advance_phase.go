@@ -0,0 +1,85 @@
+package league
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// AdvancePhaseHandler moves a league to the next phase of its season. Only its
+// commissioner may advance it. Ending the regular season seeds the playoff
+// bracket from the final standings.
+type AdvancePhaseHandler struct {
+	Leagues   ports.LeagueStore
+	Members   ports.MembershipRepository
+	Standings ports.Standings
+	Clock     ports.Clock
+}
+
+func (h AdvancePhaseHandler) Handle(ctx context.Context, cmd AdvancePhaseCommand) error {
+	err := authorizeCommissioner(ctx, h.Members, cmd.Actor, cmd.LeagueID)
+	if err != nil {
+		return err
+	}
+
+	view, version, err := load(ctx, h.Leagues, cmd.LeagueID)
+	if err != nil {
+		return err
+	}
+
+	events, err := h.decide(ctx, view, cmd.Phase)
+	if err != nil {
+		return err
+	}
+
+	_, err = h.Leagues.Append(ctx, cmd.LeagueID, events, version)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (h AdvancePhaseHandler) decide(ctx context.Context, view domain.LeagueView, phase domain.LeaguePhase) ([]domain.LeagueEvent, error) {
+	if phase != domain.PhasePlayoffs {
+		return view.DecideAdvancePhase(phase, h.Clock.Now())
+	}
+
+	standings, err := h.Standings.Standings(ctx, view.LeagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	return view.DecideStartPlayoffs(standings, h.Clock.Now())
+}
+
+func NewAdvancePhaseHandler(
+	leagues ports.LeagueStore,
+	members ports.MembershipRepository,
+	standings ports.Standings,
+	clock ports.Clock,
+) AdvancePhaseHandler {
+	return AdvancePhaseHandler{
+		Leagues:   leagues,
+		Members:   members,
+		Standings: standings,
+		Clock:     clock,
+	}
+}
+
+// AdvancePhaseCommand names the phase the league should move to, so a stale
+// request cannot skip a league past the phase its sender saw.
+type AdvancePhaseCommand struct {
+	LeagueID domain.LeagueID
+	Phase    domain.LeaguePhase
+	Actor    domain.UserID
+}
+
+func NewAdvancePhaseCommand(leagueID domain.LeagueID, phase domain.LeaguePhase, actor domain.UserID) AdvancePhaseCommand {
+	return AdvancePhaseCommand{
+		LeagueID: leagueID,
+		Phase:    phase,
+		Actor:    actor,
+	}
+}
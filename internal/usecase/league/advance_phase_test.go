@@ -0,0 +1,82 @@
+package league_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/league"
+)
+
+func TestAdvancePhaseHandler_Handle(t *testing.T) {
+	testCases := []struct {
+		name     string
+		leagueID domain.LeagueID
+		actor    domain.UserID
+		phase    domain.LeaguePhase
+		wantErr  error
+	}{
+		{
+			name:     "commissioner starts the draft",
+			leagueID: testkit.LeagueA(),
+			actor:    testkit.Commissioner(),
+			phase:    domain.PhaseDrafting,
+		},
+		{
+			name:     "manager may not advance the league",
+			leagueID: testkit.LeagueA(),
+			actor:    testkit.ManagerA(),
+			phase:    domain.PhaseDrafting,
+			wantErr:  domain.ErrNotAuthorized,
+		},
+		{
+			name:     "phases cannot be skipped",
+			leagueID: testkit.LeagueA(),
+			actor:    testkit.Commissioner(),
+			phase:    domain.PhaseInSeason,
+			wantErr:  domain.ErrInvalidPhaseTransition,
+		},
+		{
+			name:     "commissioner of no league is not authorized",
+			leagueID: 2,
+			actor:    testkit.Commissioner(),
+			phase:    domain.PhaseDrafting,
+			wantErr:  domain.ErrNotAuthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			leagues := testkit.NewLeagueStoreInPhase(domain.PhasePreDraft)
+			handler := league.NewAdvancePhaseHandler(leagues, testkit.NewLeagueMemberships(), testkit.NewFakeStandings(), testkit.NewStubClock(testkit.TodayLock()))
+
+			err := handler.Handle(t.Context(), league.NewAdvancePhaseCommand(tc.leagueID, tc.phase, tc.actor))
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+
+			view, err := league.LoadLeague(t.Context(), leagues, tc.leagueID)
+			require.NoError(t, err)
+			assert.Equal(t, view.Phase, tc.phase)
+		})
+	}
+
+	t.Run("ending the regular season seeds the bracket from the standings", func(t *testing.T) {
+		leagues := testkit.NewLeagueStoreInPhase(domain.PhaseInSeason)
+		handler := league.NewAdvancePhaseHandler(leagues, testkit.NewLeagueMemberships(), testkit.NewFakeStandings(), testkit.NewStubClock(testkit.TodayLock()))
+
+		err := handler.Handle(t.Context(), league.NewAdvancePhaseCommand(testkit.LeagueA(), domain.PhasePlayoffs, testkit.Commissioner()))
+		require.NoError(t, err)
+
+		view, err := league.LoadLeague(t.Context(), leagues, testkit.LeagueA())
+		require.NoError(t, err)
+		assert.Equal(t, view.Phase, domain.PhasePlayoffs)
+		assert.Equal(t, view.Bracket.Seeds, []domain.TeamID{testkit.TeamB(), testkit.TeamA()})
+	})
+}
@@ -0,0 +1,62 @@
+package league
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// ChangeSettingsHandler replaces a league's settings. Only its commissioner may
+// change them, and only before the draft.
+type ChangeSettingsHandler struct {
+	Leagues ports.LeagueStore
+	Members ports.MembershipRepository
+	Clock   ports.Clock
+}
+
+func (h ChangeSettingsHandler) Handle(ctx context.Context, cmd ChangeSettingsCommand) error {
+	err := authorizeCommissioner(ctx, h.Members, cmd.Actor, cmd.LeagueID)
+	if err != nil {
+		return err
+	}
+
+	view, version, err := load(ctx, h.Leagues, cmd.LeagueID)
+	if err != nil {
+		return err
+	}
+
+	events, err := view.DecideChangeSettings(cmd.Settings, h.Clock.Now())
+	if err != nil {
+		return err
+	}
+
+	_, err = h.Leagues.Append(ctx, cmd.LeagueID, events, version)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewChangeSettingsHandler(leagues ports.LeagueStore, members ports.MembershipRepository, clock ports.Clock) ChangeSettingsHandler {
+	return ChangeSettingsHandler{
+		Leagues: leagues,
+		Members: members,
+		Clock:   clock,
+	}
+}
+
+type ChangeSettingsCommand struct {
+	LeagueID domain.LeagueID
+	Settings domain.LeagueSettings
+	Actor    domain.UserID
+}
+
+func NewChangeSettingsCommand(leagueID domain.LeagueID, settings domain.LeagueSettings, actor domain.UserID) ChangeSettingsCommand {
+	return ChangeSettingsCommand{
+		LeagueID: leagueID,
+		Settings: settings,
+		Actor:    actor,
+	}
+}
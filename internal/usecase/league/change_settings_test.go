@@ -0,0 +1,61 @@
+package league_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/league"
+)
+
+func TestChangeSettingsHandler_Handle(t *testing.T) {
+	settings := domain.LeagueSettings{MaxTeams: 8}
+
+	testCases := []struct {
+		name    string
+		actor   domain.UserID
+		phase   domain.LeaguePhase
+		wantErr error
+	}{
+		{
+			name:  "commissioner changes settings before the draft",
+			actor: testkit.Commissioner(),
+			phase: domain.PhasePreDraft,
+		},
+		{
+			name:    "manager may not change settings",
+			actor:   testkit.ManagerA(),
+			phase:   domain.PhasePreDraft,
+			wantErr: domain.ErrNotAuthorized,
+		},
+		{
+			name:    "settings are locked once the draft starts",
+			actor:   testkit.Commissioner(),
+			phase:   domain.PhaseDrafting,
+			wantErr: domain.ErrLeagueSettingsLocked,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			leagues := testkit.NewLeagueStoreInPhase(tc.phase)
+			handler := league.NewChangeSettingsHandler(leagues, testkit.NewLeagueMemberships(), testkit.NewStubClock(testkit.TodayLock()))
+
+			err := handler.Handle(t.Context(), league.NewChangeSettingsCommand(testkit.LeagueA(), settings, tc.actor))
+
+			view, loadErr := league.LoadLeague(t.Context(), leagues, testkit.LeagueA())
+			require.NoError(t, loadErr)
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Equal(t, view.Settings, testkit.LeagueSettings())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, view.Settings, settings)
+		})
+	}
+}
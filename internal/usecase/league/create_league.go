@@ -0,0 +1,68 @@
+package league
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// CreateLeagueHandler starts a league in the pre-draft phase and makes the
+// actor its commissioner.
+type CreateLeagueHandler struct {
+	Leagues ports.LeagueStore
+	Members ports.MembershipRepository
+	Clock   ports.Clock
+}
+
+func (h CreateLeagueHandler) Handle(ctx context.Context, cmd CreateLeagueCommand) (domain.LeagueID, error) {
+	id, err := h.Leagues.NextLeagueID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	view := domain.LeagueView{LeagueID: id}
+
+	events, err := view.DecideCreate(cmd.Name, cmd.Actor, cmd.Settings, h.Clock.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = h.Leagues.Append(ctx, id, events, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	err = h.Members.Grant(ctx, domain.Membership{
+		UserID:   cmd.Actor,
+		LeagueID: id,
+		Role:     domain.MembershipCommissioner,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func NewCreateLeagueHandler(leagues ports.LeagueStore, members ports.MembershipRepository, clock ports.Clock) CreateLeagueHandler {
+	return CreateLeagueHandler{
+		Leagues: leagues,
+		Members: members,
+		Clock:   clock,
+	}
+}
+
+type CreateLeagueCommand struct {
+	Name     string
+	Settings domain.LeagueSettings
+	Actor    domain.UserID
+}
+
+func NewCreateLeagueCommand(name string, settings domain.LeagueSettings, actor domain.UserID) CreateLeagueCommand {
+	return CreateLeagueCommand{
+		Name:     name,
+		Settings: settings,
+		Actor:    actor,
+	}
+}
@@ -0,0 +1,44 @@
+package league_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/league"
+)
+
+func TestCreateLeagueHandler_Handle(t *testing.T) {
+	t.Run("creates a pre-draft league run by the actor", func(t *testing.T) {
+		leagues := testkit.NewFakeLeagueStore()
+		members := testkit.NewFakeMembershipRepository()
+		handler := league.NewCreateLeagueHandler(leagues, members, testkit.NewStubClock(testkit.TodayLock()))
+
+		id, err := handler.Handle(t.Context(), league.NewCreateLeagueCommand("Flushing Meadows", domain.DefaultLeagueSettings(), testkit.Commissioner()))
+		require.NoError(t, err)
+
+		view, err := league.LoadLeague(t.Context(), leagues, id)
+		require.NoError(t, err)
+		assert.Equal(t, view.Name, "Flushing Meadows")
+		assert.Equal(t, view.Phase, domain.PhasePreDraft)
+		assert.Equal(t, view.Commissioner, testkit.Commissioner())
+
+		memberships, err := members.ListForUser(t.Context(), testkit.Commissioner())
+		require.NoError(t, err)
+		assert.True(t, memberships.IsCommissioner(id))
+	})
+
+	t.Run("rejects invalid settings without granting a membership", func(t *testing.T) {
+		members := testkit.NewFakeMembershipRepository()
+		handler := league.NewCreateLeagueHandler(testkit.NewFakeLeagueStore(), members, testkit.NewStubClock(testkit.TodayLock()))
+
+		_, err := handler.Handle(t.Context(), league.NewCreateLeagueCommand("Flushing Meadows", domain.LeagueSettings{}, testkit.Commissioner()))
+		assert.ErrorIs(t, err, domain.ErrInvalidLeagueSettings)
+
+		memberships, err := members.ListForUser(t.Context(), testkit.Commissioner())
+		require.NoError(t, err)
+		assert.Equal(t, len(memberships), 0)
+	})
+}
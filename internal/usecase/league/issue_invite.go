@@ -0,0 +1,84 @@
+package league
+
+import (
+	"context"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/token"
+)
+
+// IssueInviteHandler creates an invite link for a league. Only its
+// commissioner may issue invites.
+type IssueInviteHandler struct {
+	Leagues ports.LeagueStore
+	Members ports.MembershipRepository
+	Clock   ports.Clock
+}
+
+// IssuedInvite carries the invite's token, which is never stored and so can
+// only be shared with invitees now.
+type IssuedInvite struct {
+	InviteID  domain.InviteID
+	Token     string
+	ExpiresAt time.Time
+}
+
+func (h IssueInviteHandler) Handle(ctx context.Context, cmd IssueInviteCommand) (IssuedInvite, error) {
+	err := authorizeCommissioner(ctx, h.Members, cmd.Actor, cmd.LeagueID)
+	if err != nil {
+		return IssuedInvite{}, err
+	}
+
+	view, version, err := load(ctx, h.Leagues, cmd.LeagueID)
+	if err != nil {
+		return IssuedInvite{}, err
+	}
+
+	tok, hash := token.New()
+	now := h.Clock.Now()
+	expiresAt := now.Add(cmd.TTL)
+
+	events, err := view.DecideIssueInvite(hash, expiresAt, cmd.SingleUse, cmd.Actor, now)
+	if err != nil {
+		return IssuedInvite{}, err
+	}
+
+	_, err = h.Leagues.Append(ctx, cmd.LeagueID, events, version)
+	if err != nil {
+		return IssuedInvite{}, err
+	}
+
+	issued := IssuedInvite{
+		InviteID:  events[0].(domain.IssuedLeagueInvite).InviteID,
+		Token:     tok,
+		ExpiresAt: expiresAt,
+	}
+
+	return issued, nil
+}
+
+func NewIssueInviteHandler(leagues ports.LeagueStore, members ports.MembershipRepository, clock ports.Clock) IssueInviteHandler {
+	return IssueInviteHandler{
+		Leagues: leagues,
+		Members: members,
+		Clock:   clock,
+	}
+}
+
+type IssueInviteCommand struct {
+	LeagueID  domain.LeagueID
+	TTL       time.Duration
+	SingleUse bool
+	Actor     domain.UserID
+}
+
+func NewIssueInviteCommand(leagueID domain.LeagueID, ttl time.Duration, singleUse bool, actor domain.UserID) IssueInviteCommand {
+	return IssueInviteCommand{
+		LeagueID:  leagueID,
+		TTL:       ttl,
+		SingleUse: singleUse,
+		Actor:     actor,
+	}
+}
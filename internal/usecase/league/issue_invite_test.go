@@ -0,0 +1,75 @@
+package league_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/token"
+	"github.com/spcameron/dugout/internal/usecase/league"
+)
+
+func TestIssueInviteHandler_Handle(t *testing.T) {
+	testCases := []struct {
+		name    string
+		actor   domain.UserID
+		phase   domain.LeaguePhase
+		ttl     time.Duration
+		wantErr error
+	}{
+		{
+			name:  "commissioner issues an invite",
+			actor: testkit.Commissioner(),
+			phase: domain.PhasePreDraft,
+			ttl:   24 * time.Hour,
+		},
+		{
+			name:    "manager may not issue invites",
+			actor:   testkit.ManagerA(),
+			phase:   domain.PhasePreDraft,
+			ttl:     24 * time.Hour,
+			wantErr: domain.ErrNotAuthorized,
+		},
+		{
+			name:    "invites are not issued once the draft starts",
+			actor:   testkit.Commissioner(),
+			phase:   domain.PhaseDrafting,
+			ttl:     24 * time.Hour,
+			wantErr: domain.ErrLeagueClosedToTeams,
+		},
+		{
+			name:    "invites must expire in the future",
+			actor:   testkit.Commissioner(),
+			phase:   domain.PhasePreDraft,
+			wantErr: domain.ErrInvalidInvite,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			leagues := testkit.NewLeagueStoreInPhase(tc.phase)
+			handler := league.NewIssueInviteHandler(leagues, testkit.NewLeagueMemberships(), testkit.NewStubClock(testkit.TodayLock()))
+
+			issued, err := handler.Handle(t.Context(), league.NewIssueInviteCommand(testkit.LeagueA(), tc.ttl, true, tc.actor))
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, issued.ExpiresAt.Equal(testkit.TodayLock().Add(tc.ttl)))
+
+			view, err := league.LoadLeague(t.Context(), leagues, testkit.LeagueA())
+			require.NoError(t, err)
+			inv, ok := view.Invite(issued.InviteID)
+			require.True(t, ok)
+			assert.True(t, inv.SingleUse)
+			assert.True(t, bytes.Equal(inv.TokenHash, token.Hash(issued.Token)))
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package league
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/token"
+)
+
+// JoinLeagueHandler redeems an invite to add a new team managed by the actor to
+// a league.
+type JoinLeagueHandler struct {
+	Leagues ports.LeagueStore
+	Members ports.MembershipRepository
+	Clock   ports.Clock
+}
+
+func (h JoinLeagueHandler) Handle(ctx context.Context, cmd JoinLeagueCommand) (domain.TeamID, error) {
+	view, version, err := load(ctx, h.Leagues, cmd.LeagueID)
+	if err != nil {
+		return 0, err
+	}
+
+	teamID, err := h.Leagues.NextTeamID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	events, err := view.DecideRedeemInvite(token.Hash(cmd.Token), teamID, cmd.TeamName, cmd.Actor, h.Clock.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = h.Leagues.Append(ctx, cmd.LeagueID, events, version)
+	if err != nil {
+		return 0, err
+	}
+
+	err = h.Members.Grant(ctx, domain.Membership{
+		UserID:   cmd.Actor,
+		LeagueID: cmd.LeagueID,
+		TeamID:   teamID,
+		Role:     domain.MembershipManager,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return teamID, nil
+}
+
+func NewJoinLeagueHandler(leagues ports.LeagueStore, members ports.MembershipRepository, clock ports.Clock) JoinLeagueHandler {
+	return JoinLeagueHandler{
+		Leagues: leagues,
+		Members: members,
+		Clock:   clock,
+	}
+}
+
+type JoinLeagueCommand struct {
+	LeagueID domain.LeagueID
+	Token    string
+	TeamName string
+	Actor    domain.UserID
+}
+
+func NewJoinLeagueCommand(leagueID domain.LeagueID, token, teamName string, actor domain.UserID) JoinLeagueCommand {
+	return JoinLeagueCommand{
+		LeagueID: leagueID,
+		Token:    token,
+		TeamName: teamName,
+		Actor:    actor,
+	}
+}
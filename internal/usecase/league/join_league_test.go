@@ -0,0 +1,161 @@
+package league_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/league"
+)
+
+type joinFixture struct {
+	leagues *testkit.FakeLeagueStore
+	members *testkit.FakeMembershipRepository
+	clock   *testkit.StubClock
+	join    league.JoinLeagueHandler
+}
+
+func newJoinFixture() joinFixture {
+	f := joinFixture{
+		leagues: testkit.NewLeagueStoreInPhase(domain.PhasePreDraft),
+		members: testkit.NewLeagueMemberships(),
+		clock:   testkit.NewStubClock(testkit.TodayLock()),
+	}
+	f.join = league.NewJoinLeagueHandler(f.leagues, f.members, f.clock)
+
+	return f
+}
+
+func (f joinFixture) issue(t *testing.T, singleUse bool) league.IssuedInvite {
+	t.Helper()
+
+	handler := league.NewIssueInviteHandler(f.leagues, f.members, f.clock)
+	issued, err := handler.Handle(t.Context(), league.NewIssueInviteCommand(testkit.LeagueA(), 24*time.Hour, singleUse, testkit.Commissioner()))
+	require.NoError(t, err)
+
+	return issued
+}
+
+func TestJoinLeagueHandler_Handle(t *testing.T) {
+	newcomer := domain.UserID(33)
+	latecomer := domain.UserID(44)
+
+	testCases := []struct {
+		name    string
+		prepare func(t *testing.T, f joinFixture) string
+		wantErr error
+	}{
+		{
+			name: "joins a league with an invite",
+			prepare: func(t *testing.T, f joinFixture) string {
+				return f.issue(t, false).Token
+			},
+		},
+		{
+			name: "reusable invite admits more than one team",
+			prepare: func(t *testing.T, f joinFixture) string {
+				tok := f.issue(t, false).Token
+				_, err := f.join.Handle(t.Context(), league.NewJoinLeagueCommand(testkit.LeagueA(), tok, "Team D", latecomer))
+				require.NoError(t, err)
+				return tok
+			},
+		},
+		{
+			name: "rejects a single-use invite the second time",
+			prepare: func(t *testing.T, f joinFixture) string {
+				tok := f.issue(t, true).Token
+				_, err := f.join.Handle(t.Context(), league.NewJoinLeagueCommand(testkit.LeagueA(), tok, "Team D", latecomer))
+				require.NoError(t, err)
+				return tok
+			},
+			wantErr: domain.ErrInviteAlreadyUsed,
+		},
+		{
+			name: "rejects an expired invite",
+			prepare: func(t *testing.T, f joinFixture) string {
+				tok := f.issue(t, false).Token
+				f.clock.Advance(24 * time.Hour)
+				return tok
+			},
+			wantErr: domain.ErrInviteExpired,
+		},
+		{
+			name: "rejects a revoked invite",
+			prepare: func(t *testing.T, f joinFixture) string {
+				issued := f.issue(t, false)
+				revoke := league.NewRevokeInviteHandler(f.leagues, f.members, f.clock)
+				err := revoke.Handle(t.Context(), league.NewRevokeInviteCommand(testkit.LeagueA(), issued.InviteID, testkit.Commissioner()))
+				require.NoError(t, err)
+				return issued.Token
+			},
+			wantErr: domain.ErrInviteRevoked,
+		},
+		{
+			name: "rejects a token that was never issued",
+			prepare: func(t *testing.T, f joinFixture) string {
+				f.issue(t, false)
+				return "NOTAREALTOKEN"
+			},
+			wantErr: domain.ErrInviteNotFound,
+		},
+		{
+			name: "rejects joining once the draft starts",
+			prepare: func(t *testing.T, f joinFixture) string {
+				tok := f.issue(t, false).Token
+				advance := league.NewAdvancePhaseHandler(f.leagues, f.members, testkit.NewFakeStandings(), f.clock)
+				err := advance.Handle(t.Context(), league.NewAdvancePhaseCommand(testkit.LeagueA(), domain.PhaseDrafting, testkit.Commissioner()))
+				require.NoError(t, err)
+				return tok
+			},
+			wantErr: domain.ErrLeagueClosedToTeams,
+		},
+		{
+			name: "rejects joining a full league",
+			prepare: func(t *testing.T, f joinFixture) string {
+				tok := f.issue(t, false).Token
+				settings := league.NewChangeSettingsHandler(f.leagues, f.members, f.clock)
+				err := settings.Handle(t.Context(), league.NewChangeSettingsCommand(testkit.LeagueA(), domain.LeagueSettings{MaxTeams: 2}, testkit.Commissioner()))
+				require.NoError(t, err)
+				return tok
+			},
+			wantErr: domain.ErrLeagueFull,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newJoinFixture()
+			tok := tc.prepare(t, f)
+
+			teamID, err := f.join.Handle(t.Context(), league.NewJoinLeagueCommand(testkit.LeagueA(), tok, "Team C", newcomer))
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+
+				memberships, err := f.members.ListForUser(t.Context(), newcomer)
+				require.NoError(t, err)
+				assert.Equal(t, len(memberships), 0)
+				return
+			}
+
+			require.NoError(t, err)
+
+			view, err := league.LoadLeague(t.Context(), f.leagues, testkit.LeagueA())
+			require.NoError(t, err)
+			team, ok := view.Team(teamID)
+			require.True(t, ok)
+			assert.Equal(t, team, domain.LeagueTeam{TeamID: teamID, Name: "Team C", Manager: newcomer})
+
+			leagueID, err := f.members.LeagueOf(t.Context(), teamID)
+			require.NoError(t, err)
+			assert.Equal(t, leagueID, testkit.LeagueA())
+
+			memberships, err := f.members.ListForUser(t.Context(), newcomer)
+			require.NoError(t, err)
+			assert.True(t, memberships.CanManageRoster(testkit.LeagueA(), teamID))
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package league
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// ProjectLeague replays committed league events in sequence order.
+func ProjectLeague(id domain.LeagueID, committed []eventlog.Recorded[domain.LeagueEvent]) domain.LeagueView {
+	sorted := slices.Clone(committed)
+	slices.SortFunc(sorted, func(a, b eventlog.Recorded[domain.LeagueEvent]) int {
+		return cmp.Compare(a.Sequence, b.Sequence)
+	})
+
+	lv := domain.LeagueView{LeagueID: id}
+	for _, re := range sorted {
+		lv.Apply(re.Event)
+	}
+
+	return lv
+}
+
+// LoadLeague projects the league's current state, returning ErrLeagueNotFound
+// if it has never been created.
+func LoadLeague(ctx context.Context, store ports.LeagueStore, id domain.LeagueID) (domain.LeagueView, error) {
+	view, _, err := load(ctx, store, id)
+	return view, err
+}
+
+func load(ctx context.Context, store ports.LeagueStore, id domain.LeagueID) (domain.LeagueView, ports.Version, error) {
+	committed, version, err := store.Load(ctx, id)
+	if err != nil {
+		return domain.LeagueView{}, 0, err
+	}
+
+	view := ProjectLeague(id, committed)
+	if !view.Exists() {
+		return domain.LeagueView{}, 0, fmt.Errorf("%w: %v", ports.ErrLeagueNotFound, id)
+	}
+
+	return view, version, nil
+}
+
+// authorizeCommissioner returns ErrNotAuthorized unless actor is a commissioner
+// of the league.
+func authorizeCommissioner(ctx context.Context, members ports.MembershipRepository, actor domain.UserID, league domain.LeagueID) error {
+	memberships, err := members.ListForUser(ctx, actor)
+	if err != nil {
+		return err
+	}
+
+	if !memberships.IsCommissioner(league) {
+		return fmt.Errorf("%w: user %v, league %v", domain.ErrNotAuthorized, actor, league)
+	}
+
+	return nil
+}
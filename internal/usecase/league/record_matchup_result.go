@@ -0,0 +1,63 @@
+package league
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// RecordMatchupResultHandler records the head-to-head result of a regular-season
+// matchup, from which the standings that seed the playoffs are ranked. Only the
+// league's commissioner may record results.
+type RecordMatchupResultHandler struct {
+	Leagues ports.LeagueStore
+	Members ports.MembershipRepository
+	Clock   ports.Clock
+}
+
+func (h RecordMatchupResultHandler) Handle(ctx context.Context, cmd RecordMatchupResultCommand) error {
+	err := authorizeCommissioner(ctx, h.Members, cmd.Actor, cmd.LeagueID)
+	if err != nil {
+		return err
+	}
+
+	view, version, err := load(ctx, h.Leagues, cmd.LeagueID)
+	if err != nil {
+		return err
+	}
+
+	events, err := view.DecideRecordMatchupResult(cmd.Result, h.Clock.Now())
+	if err != nil {
+		return err
+	}
+
+	_, err = h.Leagues.Append(ctx, cmd.LeagueID, events, version)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewRecordMatchupResultHandler(leagues ports.LeagueStore, members ports.MembershipRepository, clock ports.Clock) RecordMatchupResultHandler {
+	return RecordMatchupResultHandler{
+		Leagues: leagues,
+		Members: members,
+		Clock:   clock,
+	}
+}
+
+type RecordMatchupResultCommand struct {
+	LeagueID domain.LeagueID
+	Result   domain.MatchupResult
+	Actor    domain.UserID
+}
+
+func NewRecordMatchupResultCommand(leagueID domain.LeagueID, result domain.MatchupResult, actor domain.UserID) RecordMatchupResultCommand {
+	return RecordMatchupResultCommand{
+		LeagueID: leagueID,
+		Result:   result,
+		Actor:    actor,
+	}
+}
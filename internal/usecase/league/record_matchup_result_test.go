@@ -0,0 +1,53 @@
+package league_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/league"
+)
+
+func TestRecordMatchupResultHandler_Handle(t *testing.T) {
+	result := domain.MatchupResult{Period: 1, Home: testkit.TeamA(), Away: testkit.TeamB(), HomeScore: 88, AwayScore: 91.5}
+
+	testCases := []struct {
+		name    string
+		actor   domain.UserID
+		wantErr error
+	}{
+		{
+			name:  "commissioner records a regular-season result",
+			actor: testkit.Commissioner(),
+		},
+		{
+			name:    "manager may not record results",
+			actor:   testkit.ManagerA(),
+			wantErr: domain.ErrNotAuthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			leagues := testkit.NewLeagueStoreInPhase(domain.PhaseInSeason)
+			handler := league.NewRecordMatchupResultHandler(leagues, testkit.NewLeagueMemberships(), testkit.NewStubClock(testkit.TodayLock()))
+
+			err := handler.Handle(t.Context(), league.NewRecordMatchupResultCommand(testkit.LeagueA(), result, tc.actor))
+
+			view, loadErr := league.LoadLeague(t.Context(), leagues, testkit.LeagueA())
+			require.NoError(t, loadErr)
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Equal(t, len(view.Results), 0)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, len(view.Results), 1)
+			assert.Equal(t, view.Results[0], result)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package league
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// RecordPlayoffResultHandler records the head-to-head result of a playoff
+// matchup, advancing its winner. Only the league's commissioner may record
+// results.
+type RecordPlayoffResultHandler struct {
+	Leagues ports.LeagueStore
+	Members ports.MembershipRepository
+	Clock   ports.Clock
+}
+
+func (h RecordPlayoffResultHandler) Handle(ctx context.Context, cmd RecordPlayoffResultCommand) error {
+	err := authorizeCommissioner(ctx, h.Members, cmd.Actor, cmd.LeagueID)
+	if err != nil {
+		return err
+	}
+
+	view, version, err := load(ctx, h.Leagues, cmd.LeagueID)
+	if err != nil {
+		return err
+	}
+
+	events, err := view.DecideRecordPlayoffResult(cmd.Matchup, cmd.HighScore, cmd.LowScore, h.Clock.Now())
+	if err != nil {
+		return err
+	}
+
+	_, err = h.Leagues.Append(ctx, cmd.LeagueID, events, version)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewRecordPlayoffResultHandler(leagues ports.LeagueStore, members ports.MembershipRepository, clock ports.Clock) RecordPlayoffResultHandler {
+	return RecordPlayoffResultHandler{
+		Leagues: leagues,
+		Members: members,
+		Clock:   clock,
+	}
+}
+
+// RecordPlayoffResultCommand scores the matchup's better seed as HighScore.
+type RecordPlayoffResultCommand struct {
+	LeagueID  domain.LeagueID
+	Matchup   domain.MatchupID
+	HighScore float64
+	LowScore  float64
+	Actor     domain.UserID
+}
+
+func NewRecordPlayoffResultCommand(
+	leagueID domain.LeagueID,
+	matchup domain.MatchupID,
+	highScore, lowScore float64,
+	actor domain.UserID,
+) RecordPlayoffResultCommand {
+	return RecordPlayoffResultCommand{
+		LeagueID:  leagueID,
+		Matchup:   matchup,
+		HighScore: highScore,
+		LowScore:  lowScore,
+		Actor:     actor,
+	}
+}
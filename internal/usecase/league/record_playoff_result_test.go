@@ -0,0 +1,61 @@
+package league_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/league"
+)
+
+func TestRecordPlayoffResultHandler_Handle(t *testing.T) {
+	final := domain.MatchupID{Bracket: domain.BracketChampionship, Round: 1, Slot: 0}
+
+	testCases := []struct {
+		name         string
+		actor        domain.UserID
+		wantErr      error
+		wantChampion domain.TeamID
+	}{
+		{
+			name:         "commissioner records the final and crowns the winner",
+			actor:        testkit.Commissioner(),
+			wantChampion: testkit.TeamA(),
+		},
+		{
+			name:    "manager may not record results",
+			actor:   testkit.ManagerA(),
+			wantErr: domain.ErrNotAuthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			leagues := testkit.NewLeagueStoreInPhase(domain.PhaseInSeason)
+			members := testkit.NewLeagueMemberships()
+			clock := testkit.NewStubClock(testkit.TodayLock())
+
+			advance := league.NewAdvancePhaseHandler(leagues, members, testkit.NewFakeStandings(), clock)
+			err := advance.Handle(t.Context(), league.NewAdvancePhaseCommand(testkit.LeagueA(), domain.PhasePlayoffs, testkit.Commissioner()))
+			require.NoError(t, err)
+
+			handler := league.NewRecordPlayoffResultHandler(leagues, members, clock)
+
+			err = handler.Handle(t.Context(), league.NewRecordPlayoffResultCommand(testkit.LeagueA(), final, 81.5, 92, tc.actor))
+
+			view, loadErr := league.LoadLeague(t.Context(), leagues, testkit.LeagueA())
+			require.NoError(t, loadErr)
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Equal(t, view.Bracket.Champion(), domain.TeamID(0))
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, view.Bracket.Champion(), tc.wantChampion)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package league
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// RenameTeamHandler renames a team. The team's managers and the league's
+// commissioner may rename it in any phase.
+type RenameTeamHandler struct {
+	Leagues ports.LeagueStore
+	Members ports.MembershipRepository
+	Clock   ports.Clock
+}
+
+func (h RenameTeamHandler) Handle(ctx context.Context, cmd RenameTeamCommand) error {
+	leagueID, err := h.Members.LeagueOf(ctx, cmd.TeamID)
+	if err != nil {
+		return err
+	}
+
+	memberships, err := h.Members.ListForUser(ctx, cmd.Actor)
+	if err != nil {
+		return err
+	}
+
+	if !memberships.CanManageRoster(leagueID, cmd.TeamID) {
+		return fmt.Errorf("%w: user %v, team %v", domain.ErrNotAuthorized, cmd.Actor, cmd.TeamID)
+	}
+
+	view, version, err := load(ctx, h.Leagues, leagueID)
+	if err != nil {
+		return err
+	}
+
+	events, err := view.DecideRenameTeam(cmd.TeamID, cmd.Name, h.Clock.Now())
+	if err != nil {
+		return err
+	}
+
+	_, err = h.Leagues.Append(ctx, leagueID, events, version)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewRenameTeamHandler(leagues ports.LeagueStore, members ports.MembershipRepository, clock ports.Clock) RenameTeamHandler {
+	return RenameTeamHandler{
+		Leagues: leagues,
+		Members: members,
+		Clock:   clock,
+	}
+}
+
+type RenameTeamCommand struct {
+	TeamID domain.TeamID
+	Name   string
+	Actor  domain.UserID
+}
+
+func NewRenameTeamCommand(teamID domain.TeamID, name string, actor domain.UserID) RenameTeamCommand {
+	return RenameTeamCommand{
+		TeamID: teamID,
+		Name:   name,
+		Actor:  actor,
+	}
+}
@@ -0,0 +1,56 @@
+package league_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/league"
+)
+
+func TestRenameTeamHandler_Handle(t *testing.T) {
+	testCases := []struct {
+		name    string
+		actor   domain.UserID
+		wantErr error
+	}{
+		{
+			name:  "manager renames their team",
+			actor: testkit.ManagerA(),
+		},
+		{
+			name:  "commissioner renames any team",
+			actor: testkit.Commissioner(),
+		},
+		{
+			name:    "another manager may not rename the team",
+			actor:   testkit.ManagerB(),
+			wantErr: domain.ErrNotAuthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			leagues := testkit.NewLeagueStoreInPhase(domain.PhaseInSeason)
+			handler := league.NewRenameTeamHandler(leagues, testkit.NewLeagueMemberships(), testkit.NewStubClock(testkit.TodayLock()))
+
+			err := handler.Handle(t.Context(), league.NewRenameTeamCommand(testkit.TeamA(), "Amazins", tc.actor))
+
+			view, loadErr := league.LoadLeague(t.Context(), leagues, testkit.LeagueA())
+			require.NoError(t, loadErr)
+			team, ok := view.Team(testkit.TeamA())
+			require.True(t, ok)
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Equal(t, team.Name, "Team A")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, team.Name, "Amazins")
+		})
+	}
+}
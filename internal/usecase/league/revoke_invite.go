@@ -0,0 +1,62 @@
+package league
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// RevokeInviteHandler stops an invite from being redeemed. Teams that already
+// joined with it stay in the league.
+type RevokeInviteHandler struct {
+	Leagues ports.LeagueStore
+	Members ports.MembershipRepository
+	Clock   ports.Clock
+}
+
+func (h RevokeInviteHandler) Handle(ctx context.Context, cmd RevokeInviteCommand) error {
+	err := authorizeCommissioner(ctx, h.Members, cmd.Actor, cmd.LeagueID)
+	if err != nil {
+		return err
+	}
+
+	view, version, err := load(ctx, h.Leagues, cmd.LeagueID)
+	if err != nil {
+		return err
+	}
+
+	events, err := view.DecideRevokeInvite(cmd.InviteID, h.Clock.Now())
+	if err != nil {
+		return err
+	}
+
+	_, err = h.Leagues.Append(ctx, cmd.LeagueID, events, version)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewRevokeInviteHandler(leagues ports.LeagueStore, members ports.MembershipRepository, clock ports.Clock) RevokeInviteHandler {
+	return RevokeInviteHandler{
+		Leagues: leagues,
+		Members: members,
+		Clock:   clock,
+	}
+}
+
+type RevokeInviteCommand struct {
+	LeagueID domain.LeagueID
+	InviteID domain.InviteID
+	Actor    domain.UserID
+}
+
+func NewRevokeInviteCommand(leagueID domain.LeagueID, inviteID domain.InviteID, actor domain.UserID) RevokeInviteCommand {
+	return RevokeInviteCommand{
+		LeagueID: leagueID,
+		InviteID: inviteID,
+		Actor:    actor,
+	}
+}
@@ -0,0 +1,487 @@
+// Package live follows the roster and trade logs for pages that update while
+// they are open.
+package live
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+const (
+	// DefaultRetain bounds how many recent updates a feed keeps in memory.
+	DefaultRetain = 1000
+	// DefaultBatchSize bounds how many events one read of the log returns.
+	DefaultBatchSize = 200
+)
+
+// Update is a roster event or a change in a trade's status, together with what a
+// page needs to show it. For a trade, Event is nil, Trade is the trade as of the
+// change and TeamID is the proposing team. PlayerName is empty for trades,
+// overrides and players missing from the catalog.
+type Update struct {
+	Position   eventlog.Position
+	LeagueID   domain.LeagueID
+	TeamID     domain.TeamID
+	PlayerName string
+	Event      domain.RosterEvent
+	Trade      *domain.TradeView
+}
+
+// Feed tails the roster and trade logs and hands each league's updates to its
+// readers. The two logs share positions, so one cursor covers both.
+//
+// One poller reads the logs and keeps the newest Retain updates in memory. Each
+// reader keeps its own cursor and asks for what follows it, so a slow reader
+// falls behind on its own without holding up the poller or other readers. A
+// cursor older than the updates in memory is served from the logs instead.
+type Feed struct {
+	Log       ports.RosterLog
+	TradeLog  ports.TradeLog
+	Trades    ports.TradeStore
+	Members   ports.MembershipRepository
+	Players   ports.PlayerRepository
+	Retain    int
+	BatchSize int
+
+	mu      sync.Mutex
+	started bool
+	head    eventlog.Position
+	floor   eventlog.Position
+	recent  []Update
+	wake    chan struct{}
+	cacheMu sync.Mutex
+	leagues map[domain.TeamID]domain.LeagueID
+	names   map[domain.PlayerID]string
+}
+
+// Start positions the feed at the end of the logs, so that readers see only the
+// events recorded from now on. Poll and Run call it if it has not been called.
+func (f *Feed) Start(ctx context.Context) error {
+	lastRoster, err := f.Log.LastPosition(ctx)
+	if err != nil {
+		return err
+	}
+
+	lastTrade, err := f.TradeLog.LastPosition(ctx)
+	if err != nil {
+		return err
+	}
+
+	last := max(lastRoster, lastTrade)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !f.started {
+		f.started = true
+		f.head = last
+		f.floor = last
+	}
+
+	return nil
+}
+
+// Head returns the position of the newest event the feed has read. A reader
+// that starts from it sees every event recorded after it joined.
+func (f *Feed) Head() eventlog.Position {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.head
+}
+
+// Poll reads the events recorded since the last poll and wakes the readers
+// waiting on them.
+func (f *Feed) Poll(ctx context.Context) error {
+	f.mu.Lock()
+	started := f.started
+	head := f.head
+	f.mu.Unlock()
+
+	if !started {
+		err := f.Start(ctx)
+		if err != nil {
+			return err
+		}
+
+		head = f.Head()
+	}
+
+	for {
+		updates, through, more, err := f.read(ctx, head)
+		if err != nil {
+			return err
+		}
+
+		if through == head {
+			return nil
+		}
+
+		head = through
+		f.publish(head, updates)
+
+		if !more {
+			return nil
+		}
+	}
+}
+
+// Run polls the log, then again every interval, until ctx is done. Errors go
+// to report, if set, and the events involved are read again on the next poll.
+func (f *Feed) Run(ctx context.Context, interval time.Duration, report func(error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		err := f.Poll(ctx)
+		if err != nil && ctx.Err() == nil && report != nil {
+			report(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Since returns league's updates positioned after after, in position order,
+// and the cursor to pass next time. When there is nothing new yet, it returns
+// a channel that is closed once the feed has read more of the log; otherwise
+// the channel is nil and the caller should ask again straight away.
+//
+// A cursor ahead of the feed, such as one handed out before a restart against
+// a different log, is moved back to the feed's head.
+func (f *Feed) Since(ctx context.Context, league domain.LeagueID, after eventlog.Position) ([]Update, eventlog.Position, <-chan struct{}, error) {
+	f.mu.Lock()
+
+	if !f.started || after >= f.head {
+		next := min(after, f.head)
+		wait := f.waiter()
+		f.mu.Unlock()
+
+		return nil, next, wait, nil
+	}
+
+	if after >= f.floor {
+		i := sort.Search(len(f.recent), func(i int) bool { return f.recent[i].Position > after })
+
+		var updates []Update
+		for _, u := range f.recent[i:] {
+			if u.LeagueID == league {
+				updates = append(updates, u)
+			}
+		}
+
+		next := f.head
+		f.mu.Unlock()
+
+		return updates, next, nil, nil
+	}
+
+	f.mu.Unlock()
+
+	resolved, through, _, err := f.read(ctx, after)
+	if err != nil {
+		return nil, after, nil, err
+	}
+
+	var updates []Update
+	for _, u := range resolved {
+		if u.LeagueID == league {
+			updates = append(updates, u)
+		}
+	}
+
+	return updates, through, nil, nil
+}
+
+// Authorize returns domain.ErrNotAuthorized unless user belongs to league.
+func (f *Feed) Authorize(ctx context.Context, user domain.UserID, league domain.LeagueID) error {
+	memberships, err := f.Members.ListForUser(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	if !memberships.InLeague(league) {
+		return domain.ErrNotAuthorized
+	}
+
+	return nil
+}
+
+// waiter returns the channel the next publish closes. f.mu must be held.
+func (f *Feed) waiter() chan struct{} {
+	if f.wake == nil {
+		f.wake = make(chan struct{})
+	}
+
+	return f.wake
+}
+
+func (f *Feed) publish(head eventlog.Position, updates []Update) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.head = head
+	f.recent = append(f.recent, updates...)
+
+	extra := len(f.recent) - f.Retain
+	if extra > 0 {
+		f.floor = f.recent[extra-1].Position
+		f.recent = append([]Update(nil), f.recent[extra:]...)
+	}
+
+	if f.wake != nil {
+		close(f.wake)
+		f.wake = nil
+	}
+}
+
+// read returns the updates positioned after after, in position order, and the
+// position it read through. It reads up to BatchSize events from each log. When
+// either log has more, it reads only through that log's last event, so that no
+// event of the other log is skipped, and reports that there is more.
+func (f *Feed) read(ctx context.Context, after eventlog.Position) ([]Update, eventlog.Position, bool, error) {
+	rosterEntries, err := f.Log.ReadAfter(ctx, after, f.BatchSize)
+	if err != nil {
+		return nil, after, false, err
+	}
+
+	tradeEntries, err := f.TradeLog.ReadAfter(ctx, after, f.BatchSize)
+	if err != nil {
+		return nil, after, false, err
+	}
+
+	through, more := readThrough(after, rosterEntries, f.BatchSize)
+	tradesThrough, tradesMore := readThrough(after, tradeEntries, f.BatchSize)
+
+	switch {
+	case more && tradesMore:
+		through = min(through, tradesThrough)
+	case tradesMore:
+		through = tradesThrough
+	case !more:
+		through = max(through, tradesThrough)
+	}
+
+	rosterEntries = slices.DeleteFunc(rosterEntries, func(e eventlog.Entry[domain.RosterEvent]) bool { return e.Position > through })
+	tradeEntries = slices.DeleteFunc(tradeEntries, func(e eventlog.Entry[domain.TradeEvent]) bool { return e.Position > through })
+
+	updates, err := f.resolve(ctx, rosterEntries)
+	if err != nil {
+		return nil, after, false, err
+	}
+
+	trades, err := f.resolveTrades(ctx, tradeEntries)
+	if err != nil {
+		return nil, after, false, err
+	}
+
+	updates = append(updates, trades...)
+	slices.SortFunc(updates, func(a, b Update) int { return cmp.Compare(a.Position, b.Position) })
+
+	return updates, through, more || tradesMore, nil
+}
+
+// readThrough returns the position of the last of entries, or after when there
+// are none, and whether the read was cut short at limit.
+func readThrough[E any](after eventlog.Position, entries []eventlog.Entry[E], limit int) (eventlog.Position, bool) {
+	if len(entries) == 0 {
+		return after, false
+	}
+
+	return entries[len(entries)-1].Position, len(entries) == limit
+}
+
+// resolve attaches a league and player name to each entry. Events on teams
+// that no longer exist belong to no league and are left out.
+func (f *Feed) resolve(ctx context.Context, entries []eventlog.Entry[domain.RosterEvent]) ([]Update, error) {
+	updates := make([]Update, 0, len(entries))
+	for _, entry := range entries {
+		team := entry.Event.Team()
+
+		league, err := f.leagueOf(ctx, team)
+		if errors.Is(err, ports.ErrTeamNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var name string
+		player, ok := playerOf(entry.Event)
+		if ok {
+			name, err = f.nameOf(ctx, player)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		updates = append(updates, Update{
+			Position:   entry.Position,
+			LeagueID:   league,
+			TeamID:     team,
+			PlayerName: name,
+			Event:      entry.Event,
+		})
+	}
+
+	return updates, nil
+}
+
+// resolveTrades turns each change in a trade's status into an update carrying the
+// trade as it stood after the change. Veto votes leave the status alone and are
+// left out, as are trades between teams that no longer exist.
+func (f *Feed) resolveTrades(ctx context.Context, entries []eventlog.Entry[domain.TradeEvent]) ([]Update, error) {
+	histories := make(map[domain.TradeID][]eventlog.Recorded[domain.TradeEvent])
+
+	var updates []Update
+	for _, entry := range entries {
+		if !changesStatus(entry.Event) {
+			continue
+		}
+
+		id := entry.Event.Trade()
+		history, ok := histories[id]
+		if !ok {
+			var err error
+			history, _, err = f.Trades.Load(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			histories[id] = history
+		}
+
+		trade := domain.TradeView{TradeID: id}
+		for _, re := range history {
+			if re.Sequence > entry.Sequence {
+				break
+			}
+			trade.Apply(re.Event)
+		}
+
+		league, err := f.leagueOf(ctx, trade.Terms.Proposer)
+		if errors.Is(err, ports.ErrTeamNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		updates = append(updates, Update{
+			Position: entry.Position,
+			LeagueID: league,
+			TeamID:   trade.Terms.Proposer,
+			Trade:    &trade,
+		})
+	}
+
+	return updates, nil
+}
+
+// leagueOf caches lookups; a team never moves between leagues.
+func (f *Feed) leagueOf(ctx context.Context, team domain.TeamID) (domain.LeagueID, error) {
+	f.cacheMu.Lock()
+	league, ok := f.leagues[team]
+	f.cacheMu.Unlock()
+
+	if ok {
+		return league, nil
+	}
+
+	league, err := f.Members.LeagueOf(ctx, team)
+	if err != nil {
+		return 0, err
+	}
+
+	f.cacheMu.Lock()
+	defer f.cacheMu.Unlock()
+
+	if f.leagues == nil {
+		f.leagues = make(map[domain.TeamID]domain.LeagueID)
+	}
+	f.leagues[team] = league
+
+	return league, nil
+}
+
+func (f *Feed) nameOf(ctx context.Context, id domain.PlayerID) (string, error) {
+	f.cacheMu.Lock()
+	name, ok := f.names[id]
+	f.cacheMu.Unlock()
+
+	if ok {
+		return name, nil
+	}
+
+	player, err := f.Players.Get(ctx, id)
+	if errors.Is(err, ports.ErrPlayerNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	f.cacheMu.Lock()
+	defer f.cacheMu.Unlock()
+
+	if f.names == nil {
+		f.names = make(map[domain.PlayerID]string)
+	}
+	f.names[id] = player.Name
+
+	return player.Name, nil
+}
+
+func playerOf(e domain.RosterEvent) (domain.PlayerID, bool) {
+	switch e := e.(type) {
+	case domain.AddedPlayerToRoster:
+		return e.PlayerID, true
+	case domain.RemovedPlayerFromRoster:
+		return e.PlayerID, true
+	case domain.ActivatedPlayerOnRoster:
+		return e.PlayerID, true
+	case domain.InactivatedPlayerOnRoster:
+		return e.PlayerID, true
+	default:
+		return 0, false
+	}
+}
+
+func changesStatus(e domain.TradeEvent) bool {
+	switch e.(type) {
+	case domain.CastTradeVetoVote:
+		return false
+	default:
+		return true
+	}
+}
+
+func NewFeed(
+	log ports.RosterLog,
+	tradeLog ports.TradeLog,
+	trades ports.TradeStore,
+	members ports.MembershipRepository,
+	players ports.PlayerRepository,
+) *Feed {
+	return &Feed{
+		Log:       log,
+		TradeLog:  tradeLog,
+		Trades:    trades,
+		Members:   members,
+		Players:   players,
+		Retain:    DefaultRetain,
+		BatchSize: DefaultBatchSize,
+	}
+}
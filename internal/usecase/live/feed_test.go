@@ -0,0 +1,254 @@
+package live_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/live"
+)
+
+const otherLeague = domain.LeagueID(2)
+
+type feedFixture struct {
+	store  *testkit.FakeRosterStore
+	trades *testkit.FakeTradeStore
+	feed   *live.Feed
+}
+
+func newFeedFixture(t *testing.T) feedFixture {
+	t.Helper()
+
+	members := testkit.NewLeagueMemberships()
+	err := members.Grant(t.Context(), domain.Membership{UserID: 33, LeagueID: otherLeague, TeamID: testkit.TeamC(), Role: domain.MembershipManager})
+	require.NoError(t, err)
+
+	players := testkit.NewFakePlayerRepository()
+	players.SeedPlayers(domain.Player{ID: 7, Name: "Ronald Acuña Jr.", Roles: domain.MustRoleSet(domain.RoleHitter)})
+
+	f := feedFixture{store: testkit.NewFakeRosterStore(), trades: testkit.NewFakeTradeStore()}
+	f.trades.Positions = f.store.Positions
+	f.feed = live.NewFeed(f.store, f.trades, f.trades, members, players)
+
+	return f
+}
+
+func added(team domain.TeamID, player domain.PlayerID) domain.RosterEvent {
+	return domain.AddedPlayerToRoster{TeamID: team, PlayerID: player, EffectiveAt: testkit.TodayLock()}
+}
+
+func proposed(id domain.TradeID, proposer, receiver domain.TeamID) domain.TradeEvent {
+	return domain.ProposedTrade{
+		TradeID: id,
+		Terms: domain.TradeTerms{
+			Proposer:      proposer,
+			Receiver:      receiver,
+			ProposerSends: []domain.PlayerID{1},
+			ReceiverSends: []domain.PlayerID{2},
+		},
+		ProposedAt: testkit.TodayLock(),
+	}
+}
+
+func positions(updates []live.Update) []eventlog.Position {
+	var out []eventlog.Position
+	for _, u := range updates {
+		out = append(out, u.Position)
+	}
+
+	return out
+}
+
+func TestFeed_Since(t *testing.T) {
+	t.Run("starts at the end of the log", func(t *testing.T) {
+		f := newFeedFixture(t)
+		f.store.SeedEvents(testkit.TeamA(), []domain.RosterEvent{added(testkit.TeamA(), 7)})
+
+		require.NoError(t, f.feed.Poll(t.Context()))
+
+		assert.Equal(t, f.feed.Head(), eventlog.Position(1))
+
+		updates, next, wait, err := f.feed.Since(t.Context(), testkit.LeagueA(), f.feed.Head())
+		require.NoError(t, err)
+		assert.Equal(t, len(updates), 0)
+		assert.Equal(t, next, eventlog.Position(1))
+		assert.NotNil(t, wait)
+	})
+
+	t.Run("returns the league's updates after the cursor", func(t *testing.T) {
+		f := newFeedFixture(t)
+		require.NoError(t, f.feed.Start(t.Context()))
+
+		f.store.SeedEvents(testkit.TeamA(), []domain.RosterEvent{added(testkit.TeamA(), 7)})
+		f.store.SeedEvents(testkit.TeamC(), []domain.RosterEvent{added(testkit.TeamC(), 8)})
+		f.store.SeedEvents(testkit.TeamB(), []domain.RosterEvent{added(testkit.TeamB(), 9)})
+		require.NoError(t, f.feed.Poll(t.Context()))
+
+		updates, next, wait, err := f.feed.Since(t.Context(), testkit.LeagueA(), 0)
+		require.NoError(t, err)
+		assert.Equal(t, positions(updates), []eventlog.Position{1, 3})
+		assert.Equal(t, updates[0].PlayerName, "Ronald Acuña Jr.")
+		assert.Equal(t, updates[1].TeamID, testkit.TeamB())
+		assert.Equal(t, updates[1].PlayerName, "")
+		assert.Equal(t, next, eventlog.Position(3))
+		assert.Nil(t, wait)
+
+		updates, _, _, err = f.feed.Since(t.Context(), testkit.LeagueA(), 1)
+		require.NoError(t, err)
+		assert.Equal(t, positions(updates), []eventlog.Position{3})
+	})
+
+	t.Run("skips events on teams without a league", func(t *testing.T) {
+		f := newFeedFixture(t)
+		require.NoError(t, f.feed.Start(t.Context()))
+
+		f.store.SeedEvents(domain.TeamID(444), []domain.RosterEvent{added(domain.TeamID(444), 7)})
+		f.store.SeedEvents(testkit.TeamA(), []domain.RosterEvent{added(testkit.TeamA(), 7)})
+		require.NoError(t, f.feed.Poll(t.Context()))
+
+		updates, _, _, err := f.feed.Since(t.Context(), testkit.LeagueA(), 0)
+		require.NoError(t, err)
+		assert.Equal(t, positions(updates), []eventlog.Position{2})
+	})
+
+	t.Run("reads the log for cursors older than memory", func(t *testing.T) {
+		f := newFeedFixture(t)
+		f.feed.Retain = 1
+		f.feed.BatchSize = 2
+		require.NoError(t, f.feed.Start(t.Context()))
+
+		for player := domain.PlayerID(1); player <= 3; player++ {
+			f.store.SeedEvents(testkit.TeamA(), []domain.RosterEvent{added(testkit.TeamA(), player)})
+		}
+		require.NoError(t, f.feed.Poll(t.Context()))
+
+		var got []eventlog.Position
+		after := eventlog.Position(0)
+		for after < f.feed.Head() {
+			updates, next, _, err := f.feed.Since(t.Context(), testkit.LeagueA(), after)
+			require.NoError(t, err)
+			got = append(got, positions(updates)...)
+			after = next
+		}
+
+		assert.Equal(t, got, []eventlog.Position{1, 2, 3})
+	})
+
+	t.Run("interleaves trade status changes with roster events", func(t *testing.T) {
+		f := newFeedFixture(t)
+		require.NoError(t, f.feed.Start(t.Context()))
+
+		f.store.SeedEvents(testkit.TeamA(), []domain.RosterEvent{added(testkit.TeamA(), 7)})
+		f.trades.SeedEvents(5, []domain.TradeEvent{
+			proposed(5, testkit.TeamA(), testkit.TeamB()),
+			domain.CastTradeVetoVote{TradeID: 5, Voter: testkit.TeamC(), CastAt: testkit.TodayLock()},
+		})
+		f.trades.SeedEvents(6, []domain.TradeEvent{proposed(6, testkit.TeamC(), testkit.TeamC())})
+		f.store.SeedEvents(testkit.TeamB(), []domain.RosterEvent{added(testkit.TeamB(), 9)})
+		require.NoError(t, f.feed.Poll(t.Context()))
+
+		updates, next, _, err := f.feed.Since(t.Context(), testkit.LeagueA(), 0)
+		require.NoError(t, err)
+		assert.Equal(t, positions(updates), []eventlog.Position{1, 2, 5})
+		assert.Equal(t, next, eventlog.Position(5))
+		assert.Nil(t, updates[1].Event)
+		require.NotNil(t, updates[1].Trade)
+		assert.Equal(t, updates[1].Trade.Status, domain.TradeOffered)
+		assert.Equal(t, updates[1].TeamID, testkit.TeamA())
+	})
+
+	t.Run("shows a trade as it stood at each change", func(t *testing.T) {
+		f := newFeedFixture(t)
+		require.NoError(t, f.feed.Start(t.Context()))
+
+		f.trades.SeedEvents(5, []domain.TradeEvent{
+			proposed(5, testkit.TeamA(), testkit.TeamB()),
+			domain.VetoedTrade{TradeID: 5, VetoedAt: testkit.TodayLock()},
+		})
+		require.NoError(t, f.feed.Poll(t.Context()))
+
+		updates, _, _, err := f.feed.Since(t.Context(), testkit.LeagueA(), 0)
+		require.NoError(t, err)
+		require.Equal(t, len(updates), 2)
+		assert.Equal(t, updates[0].Trade.Status, domain.TradeOffered)
+		assert.Equal(t, updates[1].Trade.Status, domain.TradeVetoed)
+	})
+
+	t.Run("reads both logs in order when one fills a batch", func(t *testing.T) {
+		f := newFeedFixture(t)
+		f.feed.Retain = 1
+		f.feed.BatchSize = 2
+		require.NoError(t, f.feed.Start(t.Context()))
+
+		f.trades.SeedEvents(5, []domain.TradeEvent{proposed(5, testkit.TeamA(), testkit.TeamB())})
+		for player := domain.PlayerID(1); player <= 3; player++ {
+			f.store.SeedEvents(testkit.TeamA(), []domain.RosterEvent{added(testkit.TeamA(), player)})
+		}
+		f.trades.SeedEvents(6, []domain.TradeEvent{proposed(6, testkit.TeamB(), testkit.TeamA())})
+		require.NoError(t, f.feed.Poll(t.Context()))
+		assert.Equal(t, f.feed.Head(), eventlog.Position(5))
+
+		var got []eventlog.Position
+		after := eventlog.Position(0)
+		for after < f.feed.Head() {
+			updates, next, _, err := f.feed.Since(t.Context(), testkit.LeagueA(), after)
+			require.NoError(t, err)
+			got = append(got, positions(updates)...)
+			after = next
+		}
+
+		assert.Equal(t, got, []eventlog.Position{1, 2, 3, 4, 5})
+	})
+
+	t.Run("moves a cursor ahead of the feed back to its head", func(t *testing.T) {
+		f := newFeedFixture(t)
+		require.NoError(t, f.feed.Start(t.Context()))
+
+		_, next, _, err := f.feed.Since(t.Context(), testkit.LeagueA(), 50)
+		require.NoError(t, err)
+		assert.Equal(t, next, eventlog.Position(0))
+	})
+
+	t.Run("wakes waiting readers when new events are read", func(t *testing.T) {
+		f := newFeedFixture(t)
+		require.NoError(t, f.feed.Start(t.Context()))
+
+		_, _, wait, err := f.feed.Since(t.Context(), testkit.LeagueA(), 0)
+		require.NoError(t, err)
+
+		f.store.SeedEvents(testkit.TeamA(), []domain.RosterEvent{added(testkit.TeamA(), 7)})
+		require.NoError(t, f.feed.Poll(t.Context()))
+
+		select {
+		case <-wait:
+		case <-time.After(time.Second):
+			t.Fatal("reader was not woken")
+		}
+	})
+}
+
+func TestFeed_Authorize(t *testing.T) {
+	testCases := []struct {
+		name string
+		user domain.UserID
+		want error
+	}{
+		{name: "manager in the league", user: testkit.ManagerA()},
+		{name: "commissioner of the league", user: testkit.Commissioner()},
+		{name: "manager in another league", user: 33, want: domain.ErrNotAuthorized},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFeedFixture(t)
+
+			err := f.feed.Authorize(t.Context(), tc.user, testkit.LeagueA())
+
+			assert.ErrorIs(t, err, tc.want)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// Package notify tells managers about roster changes made to their teams, on
+// each channel they have left on.
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// Channel pairs a channel with the notifier that delivers over it.
+type Channel struct {
+	Channel  domain.NotificationChannel
+	Notifier ports.Notifier
+}
+
+// Dispatcher sends a notification on every channel its user has left on.
+type Dispatcher struct {
+	Preferences ports.NotificationPreferences
+	Channels    []Channel
+}
+
+// Send tries every channel and returns the joined errors of those that failed.
+// A channel that fails does not stop the others, so a retry may notify again on
+// a channel that succeeded; notifiers use Notification.Key to recognize repeats.
+func (d Dispatcher) Send(ctx context.Context, n ports.Notification) error {
+	var errs []error
+	for _, c := range d.Channels {
+		enabled, err := d.Preferences.Enabled(ctx, n.UserID, c.Channel)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%v: %w", c.Channel, err))
+			continue
+		}
+		if !enabled {
+			continue
+		}
+
+		err = c.Notifier.Notify(ctx, n)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%v: %w", c.Channel, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// notifyManagers sends n to each manager of team.
+func notifyManagers(ctx context.Context, members ports.MembershipRepository, d Dispatcher, team domain.TeamID, n ports.Notification) error {
+	managers, err := members.ManagersOf(ctx, team)
+	if err != nil {
+		return err
+	}
+
+	for _, user := range managers {
+		n.UserID = user
+		err := d.Send(ctx, n)
+		if err != nil {
+			return fmt.Errorf("user %v: %w", user, err)
+		}
+	}
+
+	return nil
+}
+
+// handleOnce runs notify unless subscriber has already handled key, and records
+// key as handled once notify succeeds.
+func handleOnce(ctx context.Context, handled ports.HandledMessages, clock ports.Clock, subscriber, key string, notify func() error) error {
+	done, err := handled.Handled(ctx, subscriber, key)
+	if err != nil {
+		return err
+	}
+	if done {
+		return nil
+	}
+
+	err = notify()
+	if err != nil {
+		return err
+	}
+
+	return handled.MarkHandled(ctx, subscriber, key, clock.Now())
+}
+
+func NewDispatcher(preferences ports.NotificationPreferences, channels ...Channel) Dispatcher {
+	return Dispatcher{
+		Preferences: preferences,
+		Channels:    channels,
+	}
+}
@@ -0,0 +1,71 @@
+package notify_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/notify"
+)
+
+var errMailDown = errors.New("mail relay down")
+
+func TestDispatcher_Send(t *testing.T) {
+	testCases := []struct {
+		name      string
+		disabled  []domain.NotificationChannel
+		emailErr  error
+		wantInApp int
+		wantEmail int
+		wantErr   error
+	}{
+		{
+			name:      "sends on every channel by default",
+			wantInApp: 1,
+			wantEmail: 1,
+		},
+		{
+			name:      "skips a channel the user turned off",
+			disabled:  []domain.NotificationChannel{domain.ChannelEmail},
+			wantInApp: 1,
+			wantEmail: 0,
+		},
+		{
+			name:      "a failing channel does not stop the others",
+			emailErr:  errMailDown,
+			wantInApp: 1,
+			wantEmail: 0,
+			wantErr:   errMailDown,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			preferences := testkit.NewFakeNotificationPreferences()
+			for _, c := range tc.disabled {
+				require.NoError(t, preferences.SetEnabled(t.Context(), testkit.ManagerA(), c, false))
+			}
+			email := &testkit.RecordingNotifier{Err: tc.emailErr}
+			inApp := &testkit.RecordingNotifier{}
+
+			dispatcher := notify.NewDispatcher(preferences,
+				notify.Channel{Channel: domain.ChannelEmail, Notifier: email},
+				notify.Channel{Channel: domain.ChannelInApp, Notifier: inApp},
+			)
+
+			err := dispatcher.Send(t.Context(), ports.Notification{Key: "k", UserID: testkit.ManagerA()})
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, len(inApp.Sent), tc.wantInApp)
+			assert.Equal(t, len(email.Sent), tc.wantEmail)
+		})
+	}
+}
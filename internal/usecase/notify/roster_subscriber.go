@@ -0,0 +1,152 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/usecase/roster"
+)
+
+// SubscriberName is the outbox subscription RosterSubscriber is registered
+// under, and the name it records handled messages by.
+const SubscriberName = "notifications"
+
+// RosterSubscriber turns roster events from the outbox into notifications for
+// the managers of the team concerned: commissioner drops and waiver claims won.
+// Events no one is told about are acknowledged and dropped. Times in messages are shown in Location, the zone
+// the league locks in.
+//
+// A message is marked handled once every notification for it has gone out, so
+// a redelivery after the relay failed to record the delivery sends nothing.
+type RosterSubscriber struct {
+	Store      ports.RosterStore
+	Members    ports.MembershipRepository
+	Players    ports.PlayerRepository
+	Handled    ports.HandledMessages
+	Clock      ports.Clock
+	Location   *time.Location
+	Dispatcher Dispatcher
+}
+
+func (s RosterSubscriber) Deliver(ctx context.Context, key string, msg ports.OutboxMessage) error {
+	switch ev := msg.Event.(type) {
+	case domain.RecordedRosterOverride:
+		if ev.Kind != domain.OverrideForcedRemove {
+			return nil
+		}
+
+		return handleOnce(ctx, s.Handled, s.Clock, SubscriberName, key, func() error {
+			return s.commissionerDrop(ctx, key, msg, ev)
+		})
+	case domain.AwardedWaiverClaim:
+		return handleOnce(ctx, s.Handled, s.Clock, SubscriberName, key, func() error {
+			return s.waiverClaimWon(ctx, key, ev)
+		})
+	default:
+		return nil
+	}
+}
+
+// waiverClaimWon notifies the team's managers that the player is theirs.
+func (s RosterSubscriber) waiverClaimWon(ctx context.Context, key string, award domain.AwardedWaiverClaim) error {
+	player, err := s.Players.Get(ctx, award.PlayerID)
+	if err != nil {
+		return err
+	}
+
+	subject, body, err := Render(domain.NotifyWaiverClaimWon, WaiverClaimWonData{
+		PlayerName:  player.Name,
+		EffectiveAt: award.EffectiveAt.In(s.Location),
+	})
+	if err != nil {
+		return err
+	}
+
+	return notifyManagers(ctx, s.Members, s.Dispatcher, award.TeamID, ports.Notification{
+		Key:       key + "/waiver",
+		Kind:      domain.NotifyWaiverClaimWon,
+		Subject:   subject,
+		Body:      body,
+		CreatedAt: s.Clock.Now(),
+	})
+}
+
+// commissionerDrop notifies the team's managers of each player a forced removal
+// dropped. The override is appended right after the events it annotates, which
+// take effect at the same lock, so those are the removals at the sequences
+// immediately before it.
+func (s RosterSubscriber) commissionerDrop(ctx context.Context, key string, msg ports.OutboxMessage, override domain.RecordedRosterOverride) error {
+	history, _, err := s.Store.Load(ctx, msg.TeamID)
+	if err != nil {
+		return err
+	}
+	stream := roster.NewRosterStream(msg.TeamID, history)
+
+	var dropped []domain.PlayerID
+	for seq := msg.Sequence - 1; seq > 0; seq-- {
+		re, ok := stream.Find(seq)
+		if !ok {
+			break
+		}
+
+		ev := re.Event
+		if _, ok := ev.(domain.RecordedRosterOverride); ok || !ev.OccurredAt().Equal(override.EffectiveAt) {
+			break
+		}
+		if removed, ok := ev.(domain.RemovedPlayerFromRoster); ok {
+			dropped = append(dropped, removed.PlayerID)
+		}
+	}
+
+	for _, id := range dropped {
+		player, err := s.Players.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		subject, body, err := Render(domain.NotifyCommissionerDrop, CommissionerDropData{
+			PlayerName:  player.Name,
+			Reason:      override.Reason,
+			EffectiveAt: override.EffectiveAt.In(s.Location),
+		})
+		if err != nil {
+			return err
+		}
+
+		err = notifyManagers(ctx, s.Members, s.Dispatcher, msg.TeamID, ports.Notification{
+			Key:       fmt.Sprintf("%s/drop/%d", key, id),
+			Kind:      domain.NotifyCommissionerDrop,
+			Subject:   subject,
+			Body:      body,
+			CreatedAt: s.Clock.Now(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewRosterSubscriber(
+	store ports.RosterStore,
+	members ports.MembershipRepository,
+	players ports.PlayerRepository,
+	handled ports.HandledMessages,
+	clock ports.Clock,
+	location *time.Location,
+	dispatcher Dispatcher,
+) RosterSubscriber {
+	return RosterSubscriber{
+		Store:      store,
+		Members:    members,
+		Players:    players,
+		Handled:    handled,
+		Clock:      clock,
+		Location:   location,
+		Dispatcher: dispatcher,
+	}
+}
@@ -0,0 +1,265 @@
+package notify_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/notify"
+	"github.com/spcameron/dugout/internal/usecase/outbox"
+)
+
+type subscriberFixture struct {
+	store *testkit.FakeRosterStore
+	inbox *testkit.FakeNotificationInbox
+	email *testkit.RecordingNotifier
+	sub   notify.RosterSubscriber
+}
+
+func newSubscriberFixture(t *testing.T) subscriberFixture {
+	t.Helper()
+
+	location, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	players := testkit.NewFakePlayerRepository()
+	players.SeedPlayers(domain.Player{ID: 7, Name: "Ronald Acuña Jr.", Roles: domain.MustRoleSet(domain.RoleHitter)})
+
+	f := subscriberFixture{
+		store: testkit.NewFakeRosterStore(),
+		inbox: testkit.NewFakeNotificationInbox(),
+		email: &testkit.RecordingNotifier{},
+	}
+
+	dispatcher := notify.NewDispatcher(testkit.NewFakeNotificationPreferences(),
+		notify.Channel{Channel: domain.ChannelInApp, Notifier: f.inbox},
+		notify.Channel{Channel: domain.ChannelEmail, Notifier: f.email},
+	)
+	f.sub = notify.NewRosterSubscriber(f.store, testkit.NewLeagueMemberships(), players, testkit.NewFakeHandledMessages(),
+		testkit.NewStubClock(testkit.TodayLock()), location, dispatcher)
+
+	return f
+}
+
+// deliverLast delivers the last event of TeamA's stream, as the relay would.
+func (f subscriberFixture) deliverLast(t *testing.T) error {
+	t.Helper()
+
+	history, _, err := f.store.Load(t.Context(), testkit.TeamA())
+	require.NoError(t, err)
+	last := history[len(history)-1]
+
+	msg := ports.OutboxMessage{
+		ID:       1,
+		TeamID:   testkit.TeamA(),
+		Sequence: last.Sequence,
+		Event:    last.Event,
+	}
+
+	return f.sub.Deliver(t.Context(), msg.IdempotencyKey(), msg)
+}
+
+func TestRosterSubscriber_Deliver(t *testing.T) {
+	lastLock := testkit.TodayLock()
+	forcedRemoval := []domain.RosterEvent{
+		domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 7, EffectiveAt: lastLock.Add(-24 * time.Hour)},
+		domain.RemovedPlayerFromRoster{TeamID: testkit.TeamA(), PlayerID: 7, EffectiveAt: lastLock},
+		domain.RecordedRosterOverride{
+			TeamID:      testkit.TeamA(),
+			Actor:       testkit.Commissioner(),
+			Kind:        domain.OverrideForcedRemove,
+			Reason:      "added in error",
+			EffectiveAt: lastLock,
+		},
+	}
+
+	t.Run("tells the team's managers about a commissioner drop", func(t *testing.T) {
+		f := newSubscriberFixture(t)
+		f.store.SeedEvents(testkit.TeamA(), forcedRemoval)
+
+		require.NoError(t, f.deliverLast(t))
+
+		entries, err := f.inbox.List(t.Context(), testkit.ManagerA(), 10)
+		require.NoError(t, err)
+		require.Equal(t, len(entries), 1)
+		assert.Equal(t, entries[0].Kind, domain.NotifyCommissionerDrop)
+		assert.Equal(t, entries[0].Key, "roster/111/3/drop/7")
+		assert.Equal(t, entries[0].Subject, "Ronald Acuña Jr. was dropped from your roster")
+		assert.Contains(t, entries[0].Body, "Reason: added in error")
+		assert.Contains(t, entries[0].Body, "EDT")
+
+		others, err := f.inbox.List(t.Context(), testkit.ManagerB(), 10)
+		require.NoError(t, err)
+		assert.Equal(t, len(others), 0)
+	})
+
+	t.Run("finds the dropped players by sequence however the history is ordered", func(t *testing.T) {
+		f := newSubscriberFixture(t)
+		earlier := domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 8, EffectiveAt: lastLock.Add(-48 * time.Hour)}
+		f.store.SeedEvents(testkit.TeamA(), append([]domain.RosterEvent{earlier}, forcedRemoval...))
+		f.sub.Store = reversedRosterStore{f.store}
+
+		msg := ports.OutboxMessage{ID: 1, TeamID: testkit.TeamA(), Sequence: 4, Event: forcedRemoval[2]}
+		require.NoError(t, f.sub.Deliver(t.Context(), msg.IdempotencyKey(), msg))
+
+		entries, err := f.inbox.List(t.Context(), testkit.ManagerA(), 10)
+		require.NoError(t, err)
+		require.Equal(t, len(entries), 1)
+		assert.Equal(t, entries[0].Key, "roster/111/4/drop/7")
+	})
+
+	t.Run("tells the team's managers about a waiver claim won", func(t *testing.T) {
+		f := newSubscriberFixture(t)
+		f.store.SeedEvents(testkit.TeamA(), []domain.RosterEvent{
+			domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 7, EffectiveAt: lastLock},
+			domain.AwardedWaiverClaim{TeamID: testkit.TeamA(), PlayerID: 7, EffectiveAt: lastLock},
+		})
+
+		require.NoError(t, f.deliverLast(t))
+		require.NoError(t, f.deliverLast(t))
+
+		entries, err := f.inbox.List(t.Context(), testkit.ManagerA(), 10)
+		require.NoError(t, err)
+		require.Equal(t, len(entries), 1)
+		assert.Equal(t, entries[0].Kind, domain.NotifyWaiverClaimWon)
+		assert.Equal(t, entries[0].Key, "roster/111/2/waiver")
+		assert.Equal(t, entries[0].Subject, "You won the waiver claim for Ronald Acuña Jr.")
+		assert.Equal(t, len(f.email.Sent), 1)
+	})
+
+	t.Run("a redelivery notifies once", func(t *testing.T) {
+		f := newSubscriberFixture(t)
+		f.store.SeedEvents(testkit.TeamA(), forcedRemoval)
+
+		require.NoError(t, f.deliverLast(t))
+		require.NoError(t, f.deliverLast(t))
+
+		entries, err := f.inbox.List(t.Context(), testkit.ManagerA(), 10)
+		require.NoError(t, err)
+		assert.Equal(t, len(entries), 1)
+		assert.Equal(t, len(f.email.Sent), 1)
+	})
+
+	t.Run("a delivery the relay fails to record notifies once", func(t *testing.T) {
+		f := newSubscriberFixture(t)
+		f.store.SeedEvents(testkit.TeamA(), forcedRemoval)
+		history, _, err := f.store.Load(t.Context(), testkit.TeamA())
+		require.NoError(t, err)
+
+		messages := testkit.NewFakeRosterOutbox()
+		messages.Publish(testkit.TeamA(), history[len(history)-1])
+		errReset := errors.New("connection reset")
+		messages.MarkFailures = []error{errReset}
+		relay := outbox.NewRelay(messages, testkit.NewStubClock(testkit.TodayLock()),
+			outbox.Subscription[ports.OutboxMessage]{Name: notify.SubscriberName, Subscriber: f.sub},
+		)
+
+		require.ErrorIs(t, relay.RunDue(t.Context()), errReset)
+		require.NoError(t, relay.RunDue(t.Context()))
+
+		assert.True(t, messages.Delivery(notify.SubscriberName, 1).Delivered)
+		assert.Equal(t, len(f.email.Sent), 1)
+	})
+
+	t.Run("ignores other roster events", func(t *testing.T) {
+		testCases := []struct {
+			name   string
+			events []domain.RosterEvent
+		}{
+			{
+				name:   "a manager's own drop",
+				events: forcedRemoval[:2],
+			},
+			{
+				name: "a forced add",
+				events: []domain.RosterEvent{
+					domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 7, EffectiveAt: lastLock},
+					domain.RecordedRosterOverride{TeamID: testkit.TeamA(), Kind: domain.OverrideForcedAdd, Reason: "late waiver", EffectiveAt: lastLock},
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				f := newSubscriberFixture(t)
+				f.store.SeedEvents(testkit.TeamA(), tc.events)
+
+				require.NoError(t, f.deliverLast(t))
+
+				entries, err := f.inbox.List(t.Context(), testkit.ManagerA(), 10)
+				require.NoError(t, err)
+				assert.Equal(t, len(entries), 0)
+			})
+		}
+	})
+}
+
+func TestRender(t *testing.T) {
+	effective := time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		kind        domain.NotificationKind
+		data        any
+		wantSubject string
+		wantBody    string
+	}{
+		{
+			name:        "commissioner drop",
+			kind:        domain.NotifyCommissionerDrop,
+			data:        notify.CommissionerDropData{PlayerName: "Player 7", Reason: "added in error", EffectiveAt: effective},
+			wantSubject: "Player 7 was dropped from your roster",
+			wantBody:    "A commissioner dropped Player 7 from your roster, effective Thu Apr 2, 2026 12:00 AM UTC.\n\nReason: added in error\n",
+		},
+		{
+			name:        "waiver claim won",
+			kind:        domain.NotifyWaiverClaimWon,
+			data:        notify.WaiverClaimWonData{PlayerName: "Player 7", EffectiveAt: effective},
+			wantSubject: "You won the waiver claim for Player 7",
+			wantBody:    "You won the waiver claim for Player 7. They join your roster effective Thu Apr 2, 2026 12:00 AM UTC.\n",
+		},
+		{
+			name:        "trade offer",
+			kind:        domain.NotifyTradeOffer,
+			data:        notify.TradeOfferData{Proposer: "Flushing Queens", Receive: []string{"Player 1", "Player 3"}},
+			wantSubject: "Flushing Queens offered you a trade",
+			wantBody:    "Flushing Queens offered you a trade.\n\nYou would receive: Player 1, Player 3\nYou would send: nothing\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			subject, body, err := notify.Render(tc.kind, tc.data)
+
+			require.NoError(t, err)
+			assert.Equal(t, subject, tc.wantSubject)
+			assert.Equal(t, body, tc.wantBody)
+		})
+	}
+
+	t.Run("unknown kind", func(t *testing.T) {
+		_, _, err := notify.Render(domain.NotificationKind(99), nil)
+		assert.ErrorIs(t, err, domain.ErrUnrecognizedNotificationKind)
+	})
+}
+
+// reversedRosterStore loads each stream newest first, so a subscriber that
+// indexes the history by sequence finds the wrong events.
+type reversedRosterStore struct {
+	*testkit.FakeRosterStore
+}
+
+func (s reversedRosterStore) Load(ctx context.Context, id domain.TeamID) ([]eventlog.Recorded[domain.RosterEvent], ports.Version, error) {
+	history, version, err := s.FakeRosterStore.Load(ctx, id)
+	slices.Reverse(history)
+
+	return history, version, err
+}
@@ -0,0 +1,104 @@
+package notify
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+)
+
+type messageTemplate struct {
+	subject *template.Template
+	body    *template.Template
+}
+
+// templates holds the subject and body of each kind of notification. Each kind
+// is rendered from its own data type below.
+var templates = map[domain.NotificationKind]messageTemplate{
+	domain.NotifyCommissionerDrop: parse("commissioner_drop",
+		`{{.PlayerName}} was dropped from your roster`,
+		`A commissioner dropped {{.PlayerName}} from your roster, effective {{when .EffectiveAt}}.
+
+Reason: {{.Reason}}
+`),
+	domain.NotifyWaiverClaimWon: parse("waiver_claim_won",
+		`You won the waiver claim for {{.PlayerName}}`,
+		`You won the waiver claim for {{.PlayerName}}. They join your roster effective {{when .EffectiveAt}}.
+`),
+	domain.NotifyTradeOffer: parse("trade_offer",
+		`{{.Proposer}} offered you a trade`,
+		`{{.Proposer}} offered you a trade.
+
+You would receive: {{list .Receive}}
+You would send: {{list .Send}}
+`),
+}
+
+// CommissionerDropData fills in NotifyCommissionerDrop.
+type CommissionerDropData struct {
+	PlayerName  string
+	Reason      string
+	EffectiveAt time.Time
+}
+
+// WaiverClaimWonData fills in NotifyWaiverClaimWon.
+type WaiverClaimWonData struct {
+	PlayerName  string
+	EffectiveAt time.Time
+}
+
+// TradeOfferData fills in NotifyTradeOffer. Proposer is the proposing team's
+// name; Receive and Send are player names, from the receiving team's side.
+type TradeOfferData struct {
+	Proposer string
+	Receive  []string
+	Send     []string
+}
+
+// Render fills in the templates for kind with data.
+func Render(kind domain.NotificationKind, data any) (subject, body string, err error) {
+	t, ok := templates[kind]
+	if !ok {
+		return "", "", fmt.Errorf("%w: %v", domain.ErrUnrecognizedNotificationKind, kind)
+	}
+
+	var sb strings.Builder
+	err = t.subject.Execute(&sb, data)
+	if err != nil {
+		return "", "", err
+	}
+	subject = sb.String()
+
+	sb.Reset()
+	err = t.body.Execute(&sb, data)
+	if err != nil {
+		return "", "", err
+	}
+
+	return subject, sb.String(), nil
+}
+
+// when shows a time with its zone, since readers may not share the league's.
+func when(t time.Time) string {
+	return t.Format("Mon Jan 2, 2006 3:04 PM MST")
+}
+
+// list joins names for a sentence, or says there are none.
+func list(names []string) string {
+	if len(names) == 0 {
+		return "nothing"
+	}
+
+	return strings.Join(names, ", ")
+}
+
+func parse(name, subject, body string) messageTemplate {
+	funcs := template.FuncMap{"when": when, "list": list}
+
+	return messageTemplate{
+		subject: template.Must(template.New(name + ".subject").Funcs(funcs).Parse(subject)),
+		body:    template.Must(template.New(name + ".body").Funcs(funcs).Parse(body)),
+	}
+}
@@ -0,0 +1,127 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/usecase/league"
+)
+
+// TradeOffersName is the outbox subscription TradeOffers is registered under,
+// and the name it records handled messages by.
+const TradeOffersName = "trade_offers"
+
+// TradeOffers turns trade offers from the trade outbox into notifications for
+// the managers of the receiving team. Every other trade event is acknowledged
+// and dropped.
+//
+// A message is marked handled once the notification has gone out, so a
+// redelivery sends nothing.
+type TradeOffers struct {
+	Members    ports.MembershipRepository
+	Leagues    ports.LeagueStore
+	Players    ports.PlayerRepository
+	Handled    ports.HandledMessages
+	Clock      ports.Clock
+	Dispatcher Dispatcher
+}
+
+func (o TradeOffers) Deliver(ctx context.Context, key string, msg ports.TradeOutboxMessage) error {
+	offer, ok := msg.Event.(domain.ProposedTrade)
+	if !ok {
+		return nil
+	}
+
+	return handleOnce(ctx, o.Handled, o.Clock, TradeOffersName, key, func() error {
+		return o.offered(ctx, key, offer)
+	})
+}
+
+// offered notifies the receiving team's managers of the players each side would
+// send.
+func (o TradeOffers) offered(ctx context.Context, key string, offer domain.ProposedTrade) error {
+	proposer, err := o.teamName(ctx, offer.Terms.Proposer)
+	if err != nil {
+		return err
+	}
+
+	receive, err := o.names(ctx, offer.Terms.ProposerSends)
+	if err != nil {
+		return err
+	}
+
+	send, err := o.names(ctx, offer.Terms.ReceiverSends)
+	if err != nil {
+		return err
+	}
+
+	subject, body, err := Render(domain.NotifyTradeOffer, TradeOfferData{
+		Proposer: proposer,
+		Receive:  receive,
+		Send:     send,
+	})
+	if err != nil {
+		return err
+	}
+
+	return notifyManagers(ctx, o.Members, o.Dispatcher, offer.Terms.Receiver, ports.Notification{
+		Key:       key + "/offer",
+		Kind:      domain.NotifyTradeOffer,
+		Subject:   subject,
+		Body:      body,
+		CreatedAt: o.Clock.Now(),
+	})
+}
+
+// teamName returns the name the team plays under in its league.
+func (o TradeOffers) teamName(ctx context.Context, id domain.TeamID) (string, error) {
+	leagueID, err := o.Members.LeagueOf(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	view, err := league.LoadLeague(ctx, o.Leagues, leagueID)
+	if err != nil {
+		return "", err
+	}
+
+	team, ok := view.Team(id)
+	if !ok {
+		return "", fmt.Errorf("%w: team %v, league %v", domain.ErrTeamNotInLeague, id, leagueID)
+	}
+
+	return team.Name, nil
+}
+
+func (o TradeOffers) names(ctx context.Context, ids []domain.PlayerID) ([]string, error) {
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		player, err := o.Players.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, player.Name)
+	}
+
+	return names, nil
+}
+
+func NewTradeOffers(
+	members ports.MembershipRepository,
+	leagues ports.LeagueStore,
+	players ports.PlayerRepository,
+	handled ports.HandledMessages,
+	clock ports.Clock,
+	dispatcher Dispatcher,
+) TradeOffers {
+	return TradeOffers{
+		Members:    members,
+		Leagues:    leagues,
+		Players:    players,
+		Handled:    handled,
+		Clock:      clock,
+		Dispatcher: dispatcher,
+	}
+}
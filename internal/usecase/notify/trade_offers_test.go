@@ -0,0 +1,69 @@
+package notify_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/notify"
+)
+
+func TestTradeOffers_Deliver(t *testing.T) {
+	players := testkit.NewFakePlayerRepository()
+	players.SeedPlayerIDs(1, 2)
+
+	inbox := testkit.NewFakeNotificationInbox()
+	email := &testkit.RecordingNotifier{}
+	offers := notify.NewTradeOffers(testkit.NewLeagueMemberships(),
+		testkit.NewLeagueStoreInPhase(domain.PhaseInSeason), players, testkit.NewFakeHandledMessages(),
+		testkit.NewStubClock(testkit.TodayLock()),
+		notify.NewDispatcher(testkit.NewFakeNotificationPreferences(),
+			notify.Channel{Channel: domain.ChannelInApp, Notifier: inbox},
+			notify.Channel{Channel: domain.ChannelEmail, Notifier: email},
+		),
+	)
+
+	offer := ports.TradeOutboxMessage{
+		ID:       1,
+		TradeID:  5,
+		Sequence: 1,
+		Event: domain.ProposedTrade{
+			TradeID: 5,
+			Terms: domain.TradeTerms{
+				Proposer:      testkit.TeamA(),
+				Receiver:      testkit.TeamB(),
+				ProposerSends: []domain.PlayerID{1},
+				ReceiverSends: []domain.PlayerID{2},
+			},
+			ProposedBy: testkit.ManagerA(),
+			ProposedAt: testkit.TodayLock(),
+		},
+	}
+	accepted := ports.TradeOutboxMessage{
+		ID:       2,
+		TradeID:  5,
+		Sequence: 2,
+		Event:    domain.AcceptedTrade{TradeID: 5, AcceptedAt: testkit.TodayLock()},
+	}
+
+	for _, msg := range []ports.TradeOutboxMessage{offer, offer, accepted} {
+		require.NoError(t, offers.Deliver(t.Context(), msg.IdempotencyKey(), msg))
+	}
+
+	entries, err := inbox.List(t.Context(), testkit.ManagerB(), 10)
+	require.NoError(t, err)
+	require.Equal(t, len(entries), 1)
+	assert.Equal(t, entries[0].Kind, domain.NotifyTradeOffer)
+	assert.Equal(t, entries[0].Key, "trade/5/1/offer")
+	assert.Equal(t, entries[0].Subject, "Team A offered you a trade")
+	assert.Contains(t, entries[0].Body, "You would receive: Player 1")
+	assert.Contains(t, entries[0].Body, "You would send: Player 2")
+	assert.Equal(t, len(email.Sent), 1)
+
+	proposers, err := inbox.List(t.Context(), testkit.ManagerA(), 10)
+	require.NoError(t, err)
+	assert.Equal(t, len(proposers), 0)
+}
@@ -0,0 +1,156 @@
+// Package outbox delivers committed roster and trade events to the subscribers
+// that react to them.
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// DefaultBatchSize bounds how many messages one run delivers to each subscriber.
+const DefaultBatchSize = 100
+
+// RetryPolicy spaces out redeliveries of a failing message, doubling the delay
+// after each failure up to MaxDelay, and dead-letters it once MaxAttempts
+// deliveries have failed.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 8,
+	BaseDelay:   time.Second,
+	MaxDelay:    10 * time.Minute,
+}
+
+// Backoff returns the delay before retrying after failed deliveries.
+func (p RetryPolicy) Backoff(failed int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < failed && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, p.MaxDelay)
+}
+
+// Subscription registers a subscriber with the relay. Name keys its delivery
+// state, so renaming a subscription delivers every message to it again.
+type Subscription[M ports.OutboxEntry] struct {
+	Name       string
+	Subscriber ports.Subscriber[M]
+}
+
+// Relay delivers outbox messages to each subscription at least once.
+//
+// Each subscription is served separately: one that keeps failing delays only its
+// own messages. Messages are offered oldest first, but a message waiting on a
+// retry does not hold back the ones after it, so subscribers must not rely on
+// seeing a stream's events in sequence order.
+type Relay[M ports.OutboxEntry] struct {
+	Outbox        ports.Outbox[M]
+	Clock         ports.Clock
+	Policy        RetryPolicy
+	BatchSize     int
+	Subscriptions []Subscription[M]
+}
+
+// RunDue offers each subscription its due messages and returns the joined outbox
+// errors, if any. Failed deliveries are recorded for retry rather than returned.
+// A cancelled context stops the run before the next message.
+func (r Relay[M]) RunDue(ctx context.Context) error {
+	var errs []error
+	for _, sub := range r.Subscriptions {
+		err := r.deliver(ctx, sub)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("subscriber %s: %w", sub.Name, err))
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Run delivers due messages, then again every interval, until ctx is done.
+// Errors go to report, if set, and the messages involved are offered again on a
+// later run.
+func (r Relay[M]) Run(ctx context.Context, interval time.Duration, report func(error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		err := r.RunDue(ctx)
+		if err != nil && ctx.Err() == nil && report != nil {
+			report(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r Relay[M]) deliver(ctx context.Context, sub Subscription[M]) error {
+	now := r.Clock.Now()
+
+	messages, err := r.Outbox.Pending(ctx, sub.Name, now, r.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, msg := range messages {
+		if ctx.Err() != nil {
+			errs = append(errs, ctx.Err())
+			break
+		}
+
+		delivery := sub.Subscriber.Deliver(ctx, msg.IdempotencyKey(), msg)
+		if ctx.Err() != nil {
+			// Shutting down is not the subscriber failing; the next run offers the
+			// message again without counting an attempt.
+			errs = append(errs, ctx.Err())
+			break
+		}
+
+		err := r.record(ctx, sub.Name, msg, now, delivery)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("message %v: %w", msg.OutboxID(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r Relay[M]) record(ctx context.Context, subscriber string, msg M, now time.Time, delivery error) error {
+	if delivery == nil {
+		return r.Outbox.MarkDelivered(ctx, subscriber, msg.OutboxID(), now)
+	}
+
+	failed := msg.FailedAttempts() + 1
+	if failed >= r.Policy.MaxAttempts {
+		return r.Outbox.DeadLetter(ctx, subscriber, msg.OutboxID(), now, delivery.Error())
+	}
+
+	return r.Outbox.Retry(ctx, subscriber, msg.OutboxID(), now.Add(r.Policy.Backoff(failed)), delivery.Error())
+}
+
+func NewRelay[M ports.OutboxEntry](outbox ports.Outbox[M], clock ports.Clock, subscriptions ...Subscription[M]) Relay[M] {
+	return Relay[M]{
+		Outbox:        outbox,
+		Clock:         clock,
+		Policy:        DefaultRetryPolicy,
+		BatchSize:     DefaultBatchSize,
+		Subscriptions: subscriptions,
+	}
+}
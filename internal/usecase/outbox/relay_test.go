@@ -0,0 +1,187 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/outbox"
+)
+
+var errUnavailable = errors.New("subscriber unavailable")
+
+type relayFixture struct {
+	outbox *testkit.FakeRosterOutbox
+	clock  *testkit.StubClock
+}
+
+func newRelayFixture(events int) relayFixture {
+	f := relayFixture{
+		outbox: testkit.NewFakeRosterOutbox(),
+		clock:  testkit.NewStubClock(testkit.TodayLock()),
+	}
+
+	for i := 1; i <= events; i++ {
+		f.outbox.Publish(testkit.TeamA(), eventlog.Recorded[domain.RosterEvent]{
+			Sequence: eventlog.Sequence(i),
+			Event:    domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: domain.PlayerID(i), EffectiveAt: testkit.TodayLock()},
+		})
+	}
+
+	return f
+}
+
+func (f relayFixture) relay(subs ...outbox.Subscription[ports.OutboxMessage]) outbox.Relay[ports.OutboxMessage] {
+	relay := outbox.NewRelay(f.outbox, f.clock, subs...)
+	relay.Policy = outbox.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Minute, MaxDelay: time.Hour}
+
+	return relay
+}
+
+func TestRelay_RunDue(t *testing.T) {
+	t.Run("delivers every message to every subscriber once", func(t *testing.T) {
+		f := newRelayFixture(2)
+		feed, alerts := &testkit.RecordingSubscriber{}, &testkit.RecordingSubscriber{}
+		relay := f.relay(outbox.Subscription[ports.OutboxMessage]{Name: "feed", Subscriber: feed}, outbox.Subscription[ports.OutboxMessage]{Name: "alerts", Subscriber: alerts})
+
+		require.NoError(t, relay.RunDue(t.Context()))
+		require.NoError(t, relay.RunDue(t.Context()))
+
+		for _, sub := range []*testkit.RecordingSubscriber{feed, alerts} {
+			require.Equal(t, len(sub.Received), 2)
+			assert.Equal(t, sub.Received[0].Sequence, eventlog.Sequence(1))
+			assert.Equal(t, sub.Received[1].Sequence, eventlog.Sequence(2))
+		}
+		assert.True(t, f.outbox.Delivery("feed", 1).Delivered)
+		assert.True(t, f.outbox.Delivery("alerts", 2).Delivered)
+	})
+
+	t.Run("failed delivery is retried after the backoff", func(t *testing.T) {
+		f := newRelayFixture(1)
+		sub := &testkit.RecordingSubscriber{Failures: []error{errUnavailable}}
+		relay := f.relay(outbox.Subscription[ports.OutboxMessage]{Name: "feed", Subscriber: sub})
+
+		require.NoError(t, relay.RunDue(t.Context()))
+
+		d := f.outbox.Delivery("feed", 1)
+		assert.Equal(t, d.Attempts, 1)
+		assert.Equal(t, d.NextAttemptAt, testkit.TodayLock().Add(time.Minute))
+		assert.Equal(t, d.LastError, errUnavailable.Error())
+
+		f.clock.Advance(30 * time.Second)
+		require.NoError(t, relay.RunDue(t.Context()))
+		assert.Equal(t, len(sub.Received), 0)
+
+		f.clock.Advance(30 * time.Second)
+		require.NoError(t, relay.RunDue(t.Context()))
+		require.Equal(t, len(sub.Received), 1)
+		assert.Equal(t, sub.Received[0].Attempts, 1)
+		assert.True(t, f.outbox.Delivery("feed", 1).Delivered)
+	})
+
+	t.Run("message is dead-lettered once its attempts run out", func(t *testing.T) {
+		f := newRelayFixture(1)
+		sub := &testkit.RecordingSubscriber{Failures: []error{errUnavailable, errUnavailable, errUnavailable, errUnavailable}}
+		relay := f.relay(outbox.Subscription[ports.OutboxMessage]{Name: "feed", Subscriber: sub})
+
+		for range 4 {
+			require.NoError(t, relay.RunDue(t.Context()))
+			f.clock.Advance(time.Hour)
+		}
+
+		d := f.outbox.Delivery("feed", 1)
+		assert.True(t, d.DeadLettered)
+		assert.Equal(t, d.Attempts, 3)
+		assert.Equal(t, len(sub.Failures), 1)
+	})
+
+	t.Run("delivery that fails to be recorded is offered again with the same key", func(t *testing.T) {
+		f := newRelayFixture(1)
+		f.outbox.MarkFailures = []error{errUnavailable}
+		sub := &testkit.RecordingSubscriber{}
+		relay := f.relay(outbox.Subscription[ports.OutboxMessage]{Name: "feed", Subscriber: sub})
+
+		err := relay.RunDue(t.Context())
+		require.ErrorIs(t, err, errUnavailable)
+		assert.Equal(t, f.outbox.Delivery("feed", 1), testkit.FakeDelivery{})
+
+		require.NoError(t, relay.RunDue(t.Context()))
+		require.Equal(t, len(sub.Keys), 2)
+		assert.Equal(t, sub.Keys[0], "roster/111/1")
+		assert.Equal(t, sub.Keys[1], sub.Keys[0])
+		assert.True(t, f.outbox.Delivery("feed", 1).Delivered)
+	})
+
+	t.Run("one failing subscriber does not hold back another", func(t *testing.T) {
+		f := newRelayFixture(1)
+		failing := &testkit.RecordingSubscriber{Failures: []error{errUnavailable}}
+		healthy := &testkit.RecordingSubscriber{}
+		relay := f.relay(outbox.Subscription[ports.OutboxMessage]{Name: "failing", Subscriber: failing}, outbox.Subscription[ports.OutboxMessage]{Name: "healthy", Subscriber: healthy})
+
+		require.NoError(t, relay.RunDue(t.Context()))
+
+		assert.False(t, f.outbox.Delivery("failing", 1).Delivered)
+		assert.True(t, f.outbox.Delivery("healthy", 1).Delivered)
+		assert.Equal(t, len(healthy.Received), 1)
+	})
+
+	t.Run("cancelled context stops without counting an attempt", func(t *testing.T) {
+		f := newRelayFixture(2)
+		ctx, cancel := context.WithCancel(t.Context())
+		sub := cancellingSubscriber{cancel: cancel}
+		relay := f.relay(outbox.Subscription[ports.OutboxMessage]{Name: "feed", Subscriber: sub})
+
+		err := relay.RunDue(ctx)
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, f.outbox.Delivery("feed", 1), testkit.FakeDelivery{})
+		assert.Equal(t, f.outbox.Delivery("feed", 2), testkit.FakeDelivery{})
+	})
+}
+
+func TestRelay_Run(t *testing.T) {
+	f := newRelayFixture(1)
+	ctx, cancel := context.WithCancel(t.Context())
+	relay := f.relay(outbox.Subscription[ports.OutboxMessage]{Name: "feed", Subscriber: cancellingSubscriber{cancel: cancel}})
+
+	var reported []error
+	err := relay.Run(ctx, time.Hour, func(err error) { reported = append(reported, err) })
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, len(reported), 0)
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := outbox.RetryPolicy{MaxAttempts: 10, BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+
+	assert.Equal(t, policy.Backoff(1), time.Second)
+	assert.Equal(t, policy.Backoff(2), 2*time.Second)
+	assert.Equal(t, policy.Backoff(3), 4*time.Second)
+	assert.Equal(t, policy.Backoff(4), 5*time.Second)
+	assert.Equal(t, policy.Backoff(40), 5*time.Second)
+}
+
+func TestOutboxMessage_IdempotencyKey(t *testing.T) {
+	msg := ports.OutboxMessage{ID: 9, TeamID: 111, Sequence: 4, Attempts: 2}
+	redelivered := ports.OutboxMessage{ID: 9, TeamID: 111, Sequence: 4, Attempts: 3}
+
+	assert.Equal(t, msg.IdempotencyKey(), "roster/111/4")
+	assert.Equal(t, redelivered.IdempotencyKey(), msg.IdempotencyKey())
+}
+
+// cancellingSubscriber cancels the run while delivering, as a shutdown would.
+type cancellingSubscriber struct {
+	cancel context.CancelFunc
+}
+
+func (s cancellingSubscriber) Deliver(ctx context.Context, key string, msg ports.OutboxMessage) error {
+	s.cancel()
+	return ctx.Err()
+}
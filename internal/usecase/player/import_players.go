@@ -0,0 +1,55 @@
+package player
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// ImportPlayersHandler upserts a batch of catalog entries keyed by MLB player ID.
+//
+// The whole batch is validated before anything is written, so a bad entry rejects
+// the import rather than leaving it half applied.
+type ImportPlayersHandler struct {
+	Players ports.PlayerRepository
+}
+
+func (h ImportPlayersHandler) Handle(ctx context.Context, cmd ImportPlayersCommand) error {
+	seen := make(map[domain.MLBPlayerID]struct{}, len(cmd.Players))
+	for _, p := range cmd.Players {
+		err := p.Validate()
+		if err != nil {
+			return err
+		}
+
+		if _, ok := seen[p.MLBID]; ok {
+			return fmt.Errorf("%w: %v", domain.ErrDuplicateMLBPlayerID, p.MLBID)
+		}
+		seen[p.MLBID] = struct{}{}
+	}
+
+	_, err := h.Players.Upsert(ctx, cmd.Players)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewImportPlayersHandler(players ports.PlayerRepository) ImportPlayersHandler {
+	return ImportPlayersHandler{
+		Players: players,
+	}
+}
+
+type ImportPlayersCommand struct {
+	Players []domain.Player
+}
+
+func NewImportPlayersCommand(players []domain.Player) ImportPlayersCommand {
+	return ImportPlayersCommand{
+		Players: players,
+	}
+}
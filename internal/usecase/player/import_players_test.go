@@ -0,0 +1,96 @@
+package player_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/player"
+)
+
+func TestImportPlayersHandler_Handle(t *testing.T) {
+	lindor := domain.Player{MLBID: 596019, Name: "Francisco Lindor", Roles: domain.MustRoleSet(domain.RoleHitter), Position: "SS", MLBTeam: "NYM"}
+
+	t.Run("re-importing a changed player updates it in place", func(t *testing.T) {
+		repo := testkit.NewFakePlayerRepository()
+		handler := player.NewImportPlayersHandler(repo)
+
+		require.NoError(t, handler.Handle(t.Context(), player.NewImportPlayersCommand([]domain.Player{lindor})))
+
+		traded := lindor
+		traded.MLBTeam = "SD"
+		require.NoError(t, handler.Handle(t.Context(), player.NewImportPlayersCommand([]domain.Player{traded})))
+
+		found, err := repo.Search(t.Context(), ports.PlayerQuery{NamePrefix: "fran"})
+		require.NoError(t, err)
+		require.Equal(t, len(found), 1)
+		assert.Equal(t, found[0].MLBTeam, "SD")
+	})
+
+	testCases := []struct {
+		name    string
+		players []domain.Player
+		wantErr error
+	}{
+		{
+			name:    "rejects a player without an MLB ID",
+			players: []domain.Player{{Name: "Nobody", Roles: domain.MustRoleSet(domain.RoleHitter)}},
+			wantErr: domain.ErrInvalidPlayer,
+		},
+		{
+			name:    "rejects a player with an unknown role",
+			players: []domain.Player{{MLBID: 1, Name: "Nobody", Roles: domain.RoleSet(1 << 5)}},
+			wantErr: domain.ErrUnrecognizedPlayerRole,
+		},
+		{
+			name:    "rejects a batch listing the same MLB ID twice",
+			players: []domain.Player{lindor, lindor},
+			wantErr: domain.ErrDuplicateMLBPlayerID,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := testkit.NewFakePlayerRepository()
+			handler := player.NewImportPlayersHandler(repo)
+
+			err := handler.Handle(t.Context(), player.NewImportPlayersCommand(tc.players))
+			assert.ErrorIs(t, err, tc.wantErr)
+
+			found, err := repo.Search(t.Context(), ports.PlayerQuery{})
+			require.NoError(t, err)
+			assert.Equal(t, len(found), 0)
+		})
+	}
+}
+
+func TestPlayerSearchLimit(t *testing.T) {
+	repo := testkit.NewFakePlayerRepository()
+	players := make([]domain.Player, ports.DefaultPlayerSearchLimit+1)
+	for i := range players {
+		players[i] = domain.Player{MLBID: domain.MLBPlayerID(i + 1), Name: fmt.Sprintf("Player %03d", i), Roles: domain.MustRoleSet(domain.RoleHitter), Position: "SS", MLBTeam: "NYM"}
+	}
+	require.NoError(t, player.NewImportPlayersHandler(repo).Handle(t.Context(), player.NewImportPlayersCommand(players)))
+
+	testCases := []struct {
+		name  string
+		limit int
+		want  int
+	}{
+		{name: "zero limit returns the default", limit: 0, want: ports.DefaultPlayerSearchLimit},
+		{name: "negative limit returns the default", limit: -1, want: ports.DefaultPlayerSearchLimit},
+		{name: "positive limit caps the results", limit: 3, want: 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			found, err := repo.Search(t.Context(), ports.PlayerQuery{Limit: tc.limit})
+			require.NoError(t, err)
+			assert.Equal(t, len(found), tc.want)
+		})
+	}
+}
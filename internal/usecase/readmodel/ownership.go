@@ -0,0 +1,87 @@
+// Package readmodel keeps query-shaped views of the roster and league logs up to
+// date, each built as a projection.
+package readmodel
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/projection"
+)
+
+const OwnershipProjection = "ownership"
+
+// Ownership tracks which teams roster each player. A player can be on one team
+// in every league, so a player may have several owners. A move counts once it is
+// recorded, including one that takes effect at a later lock.
+type Ownership struct {
+	mu     sync.RWMutex
+	owners map[domain.PlayerID][]domain.TeamID
+}
+
+// Owners returns the teams rostering player, in TeamID order.
+func (o *Ownership) Owners(player domain.PlayerID) []domain.TeamID {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	return slices.Clone(o.owners[player])
+}
+
+// Projection applies adds and removals. Both are idempotent, so applying an event
+// twice leaves ownership as it was.
+func (o *Ownership) Projection() *projection.Projection[domain.RosterEvent] {
+	p := projection.New[domain.RosterEvent](OwnershipProjection)
+
+	projection.On(p, func(ctx context.Context, e domain.AddedPlayerToRoster, _ eventlog.Entry[domain.RosterEvent]) error {
+		o.mu.Lock()
+		defer o.mu.Unlock()
+
+		owners := o.owners[e.PlayerID]
+		i, found := slices.BinarySearch(owners, e.TeamID)
+		if !found {
+			o.owners[e.PlayerID] = slices.Insert(owners, i, e.TeamID)
+		}
+
+		return nil
+	})
+
+	projection.On(p, func(ctx context.Context, e domain.RemovedPlayerFromRoster, _ eventlog.Entry[domain.RosterEvent]) error {
+		o.mu.Lock()
+		defer o.mu.Unlock()
+
+		owners := o.owners[e.PlayerID]
+		i, found := slices.BinarySearch(owners, e.TeamID)
+		if !found {
+			return nil
+		}
+
+		owners = slices.Delete(owners, i, i+1)
+		if len(owners) == 0 {
+			delete(o.owners, e.PlayerID)
+		} else {
+			o.owners[e.PlayerID] = owners
+		}
+
+		return nil
+	})
+
+	p.OnReset(func(ctx context.Context) error {
+		o.mu.Lock()
+		defer o.mu.Unlock()
+
+		clear(o.owners)
+
+		return nil
+	})
+
+	return p
+}
+
+func NewOwnership() *Ownership {
+	return &Ownership{
+		owners: make(map[domain.PlayerID][]domain.TeamID),
+	}
+}
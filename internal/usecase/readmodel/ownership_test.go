@@ -0,0 +1,89 @@
+package readmodel_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/readmodel"
+)
+
+func added(team domain.TeamID, player domain.PlayerID) domain.RosterEvent {
+	return domain.AddedPlayerToRoster{TeamID: team, PlayerID: player, EffectiveAt: testkit.TodayLock()}
+}
+
+func removed(team domain.TeamID, player domain.PlayerID) domain.RosterEvent {
+	return domain.RemovedPlayerFromRoster{TeamID: team, PlayerID: player, EffectiveAt: testkit.TomorrowLock()}
+}
+
+func TestOwnership(t *testing.T) {
+	testCases := []struct {
+		name   string
+		events map[domain.TeamID][]domain.RosterEvent
+		player domain.PlayerID
+		want   []domain.TeamID
+	}{
+		{
+			name:   "a player nobody added has no owners",
+			player: 7,
+			want:   nil,
+		},
+		{
+			name: "each team that adds a player owns it",
+			events: map[domain.TeamID][]domain.RosterEvent{
+				testkit.TeamB(): {added(testkit.TeamB(), 7)},
+				testkit.TeamA(): {added(testkit.TeamA(), 7)},
+			},
+			player: 7,
+			want:   []domain.TeamID{testkit.TeamA(), testkit.TeamB()},
+		},
+		{
+			name: "removal ends ownership, even before it takes effect",
+			events: map[domain.TeamID][]domain.RosterEvent{
+				testkit.TeamA(): {added(testkit.TeamA(), 7), removed(testkit.TeamA(), 7)},
+			},
+			player: 7,
+			want:   nil,
+		},
+		{
+			name: "re-adding a player owns it again",
+			events: map[domain.TeamID][]domain.RosterEvent{
+				testkit.TeamA(): {added(testkit.TeamA(), 7), removed(testkit.TeamA(), 7), added(testkit.TeamA(), 7)},
+			},
+			player: 7,
+			want:   []domain.TeamID{testkit.TeamA()},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := testkit.NewFakeRosterStore()
+			for team, events := range tc.events {
+				store.SeedEvents(team, events)
+			}
+			ownership := readmodel.NewOwnership()
+
+			require.NoError(t, testkit.NewRosterRunner(store, ownership.Projection()).CatchUp(t.Context()))
+
+			got := ownership.Owners(tc.player)
+			require.Equal(t, len(got), len(tc.want))
+			for i := range tc.want {
+				assert.Equal(t, got[i], tc.want[i])
+			}
+		})
+	}
+}
+
+func TestOwnership_Rebuild(t *testing.T) {
+	store := testkit.NewFakeRosterStore()
+	store.SeedEvents(testkit.TeamA(), []domain.RosterEvent{added(testkit.TeamA(), 7)})
+	ownership := readmodel.NewOwnership()
+	runner := testkit.NewRosterRunner(store, ownership.Projection())
+	require.NoError(t, runner.CatchUp(t.Context()))
+
+	require.NoError(t, runner.Rebuild(t.Context(), readmodel.OwnershipProjection))
+
+	assert.Equal(t, len(ownership.Owners(7)), 1)
+}
@@ -0,0 +1,152 @@
+package readmodel
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/projection"
+	"github.com/spcameron/dugout/internal/usecase/roster"
+)
+
+// RosterMismatch is a team whose stored roster differs from a replay of its
+// stream.
+type RosterMismatch struct {
+	TeamID   domain.TeamID
+	Stored   []domain.RosterEntry
+	Replayed []domain.RosterEntry
+}
+
+// RosterCheckResult is the outcome of a RosterCheck. Through is the checkpoint
+// the read model was compared at.
+type RosterCheckResult struct {
+	Through    eventlog.Position
+	Teams      int
+	Mismatches []RosterMismatch
+}
+
+// RosterCheck compares the current-roster read model with ProjectThrough
+// replays of the log up to the projection's checkpoint, so a projection that
+// is behind is not reported as wrong. The read model can run up to a batch ahead
+// of its checkpoint while the projection is applying one, so a team that
+// changes during the check may show as a mismatch; run it again to confirm.
+type RosterCheck struct {
+	Log         ports.RosterLog
+	Checkpoints ports.ProjectionCheckpoints
+	Rosters     ports.CurrentRosters
+	Projection  string
+	BatchSize   int
+}
+
+func (c RosterCheck) Run(ctx context.Context) (RosterCheckResult, error) {
+	through, err := c.Checkpoints.Checkpoint(ctx, c.Projection)
+	if err != nil {
+		return RosterCheckResult{}, err
+	}
+
+	streams, err := c.streams(ctx, through)
+	if err != nil {
+		return RosterCheckResult{}, err
+	}
+
+	stored, err := c.Rosters.Teams(ctx)
+	if err != nil {
+		return RosterCheckResult{}, err
+	}
+
+	teams := make([]domain.TeamID, 0, len(streams)+len(stored))
+	for team := range streams {
+		teams = append(teams, team)
+	}
+	for _, team := range stored {
+		if _, ok := streams[team]; !ok {
+			teams = append(teams, team)
+		}
+	}
+	slices.Sort(teams)
+
+	result := RosterCheckResult{Through: through, Teams: len(teams)}
+	for _, team := range teams {
+		got, err := c.Rosters.ListTeam(ctx, team)
+		if err != nil {
+			return RosterCheckResult{}, err
+		}
+
+		want, err := replay(team, streams[team])
+		if err != nil {
+			return RosterCheckResult{}, err
+		}
+
+		if !slices.Equal(got, want) {
+			result.Mismatches = append(result.Mismatches, RosterMismatch{
+				TeamID:   team,
+				Stored:   got,
+				Replayed: want,
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// streams reads the log up to through and groups it by team.
+func (c RosterCheck) streams(ctx context.Context, through eventlog.Position) (map[domain.TeamID][]eventlog.Recorded[domain.RosterEvent], error) {
+	streams := make(map[domain.TeamID][]eventlog.Recorded[domain.RosterEvent])
+
+	var after eventlog.Position
+	for after < through {
+		entries, err := c.Log.ReadAfter(ctx, after, c.BatchSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(entries) == 0 {
+			break
+		}
+
+		for _, entry := range entries {
+			if entry.Position > through {
+				return streams, nil
+			}
+
+			team := entry.Event.Team()
+			streams[team] = append(streams[team], entry.Recorded)
+		}
+
+		after = entries[len(entries)-1].Position
+	}
+
+	return streams, nil
+}
+
+// replay projects the stream through its latest move, so every recorded move
+// has taken effect, as it has in the read model. A stream that cannot be
+// replayed fails the check; dugout verify says why.
+func replay(team domain.TeamID, committed []eventlog.Recorded[domain.RosterEvent]) ([]domain.RosterEntry, error) {
+	var through time.Time
+	for _, re := range committed {
+		at := re.Event.OccurredAt()
+		if at.After(through) {
+			through = at
+		}
+	}
+
+	view, err := roster.NewRosterStream(team, committed).TryProjectThrough(through)
+	if err != nil {
+		return nil, err
+	}
+
+	return view.Entries, nil
+}
+
+func NewRosterCheck(log ports.RosterLog, checkpoints ports.ProjectionCheckpoints, rosters ports.CurrentRosters, name string) RosterCheck {
+	return RosterCheck{
+		Log:         log,
+		Checkpoints: checkpoints,
+		Rosters:     rosters,
+		Projection:  name,
+		BatchSize:   projection.DefaultBatchSize,
+	}
+}
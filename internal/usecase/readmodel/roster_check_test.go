@@ -0,0 +1,163 @@
+package readmodel_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/projection"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/readmodel"
+)
+
+type checkFixture struct {
+	store   *testkit.FakeRosterStore
+	rosters *testkit.FakeCurrentRosters
+	runner  projection.Runner[domain.RosterEvent]
+	check   readmodel.RosterCheck
+}
+
+func newCheckFixture(t *testing.T) checkFixture {
+	t.Helper()
+
+	f := checkFixture{
+		store:   testkit.NewFakeRosterStore(),
+		rosters: testkit.NewFakeCurrentRosters(testkit.NewLeagueMemberships()),
+	}
+	f.runner = testkit.NewRosterRunner(f.store, f.rosters.Projection())
+	f.check = readmodel.NewRosterCheck(f.store, f.runner.Checkpoints, f.rosters, "current_rosters")
+	f.check.BatchSize = 2
+
+	return f
+}
+
+func activated(team domain.TeamID, player domain.PlayerID) domain.RosterEvent {
+	return domain.ActivatedPlayerOnRoster{TeamID: team, PlayerID: player, PlayerRole: domain.RoleHitter, EffectiveAt: testkit.TomorrowLock()}
+}
+
+func hitter(team domain.TeamID, player domain.PlayerID, status domain.RosterStatus) domain.RosterEntry {
+	return domain.RosterEntry{TeamID: team, PlayerID: player, RosterStatus: status, Eligibility: domain.MustRoleSet(domain.RoleHitter)}
+}
+
+func addedHitter(team domain.TeamID, player domain.PlayerID) domain.RosterEvent {
+	return domain.AddedPlayerToRoster{TeamID: team, PlayerID: player, Eligibility: domain.MustRoleSet(domain.RoleHitter), EffectiveAt: testkit.TodayLock()}
+}
+
+func TestCurrentRostersProjection(t *testing.T) {
+	f := newCheckFixture(t)
+	f.store.SeedEvents(testkit.TeamA(), []domain.RosterEvent{
+		addedHitter(testkit.TeamA(), 1),
+		addedHitter(testkit.TeamA(), 2),
+		activated(testkit.TeamA(), 2),
+		removed(testkit.TeamA(), 1),
+	})
+	f.store.SeedEvents(testkit.TeamB(), []domain.RosterEvent{addedHitter(testkit.TeamB(), 3)})
+
+	require.NoError(t, f.runner.CatchUp(t.Context()))
+
+	entries, err := f.rosters.ListLeague(t.Context(), testkit.LeagueA())
+	require.NoError(t, err)
+	assert.Equal(t, entries, []domain.RosterEntry{
+		hitter(testkit.TeamA(), 2, domain.StatusActiveHitter),
+		hitter(testkit.TeamB(), 3, domain.StatusInactive),
+	})
+
+	owner, err := f.rosters.Owner(t.Context(), testkit.LeagueA(), 3)
+	require.NoError(t, err)
+	assert.Equal(t, owner, testkit.TeamB())
+
+	counts, err := f.rosters.Counts(t.Context(), testkit.LeagueA())
+	require.NoError(t, err)
+	assert.Equal(t, counts[testkit.TeamA()], domain.RosterCounts{Total: 1, ActiveHitters: 1})
+}
+
+func TestRosterCheck(t *testing.T) {
+	history := map[domain.TeamID][]domain.RosterEvent{
+		testkit.TeamA(): {
+			addedHitter(testkit.TeamA(), 1),
+			addedHitter(testkit.TeamA(), 2),
+			activated(testkit.TeamA(), 2),
+		},
+		testkit.TeamB(): {
+			addedHitter(testkit.TeamB(), 3),
+			removed(testkit.TeamB(), 3),
+		},
+	}
+
+	testCases := []struct {
+		name  string
+		drift func(r *testkit.FakeCurrentRosters)
+		want  []readmodel.RosterMismatch
+	}{
+		{
+			name:  "a caught-up read model matches the replay",
+			drift: func(r *testkit.FakeCurrentRosters) {},
+		},
+		{
+			name: "reports a wrong status",
+			drift: func(r *testkit.FakeCurrentRosters) {
+				r.Put(hitter(testkit.TeamA(), 2, domain.StatusInactive))
+			},
+			want: []readmodel.RosterMismatch{{
+				TeamID:   testkit.TeamA(),
+				Stored:   []domain.RosterEntry{hitter(testkit.TeamA(), 1, domain.StatusInactive), hitter(testkit.TeamA(), 2, domain.StatusInactive)},
+				Replayed: []domain.RosterEntry{hitter(testkit.TeamA(), 1, domain.StatusInactive), hitter(testkit.TeamA(), 2, domain.StatusActiveHitter)},
+			}},
+		},
+		{
+			name: "reports an entry the replay removed",
+			drift: func(r *testkit.FakeCurrentRosters) {
+				r.Put(hitter(testkit.TeamB(), 3, domain.StatusInactive))
+			},
+			want: []readmodel.RosterMismatch{{
+				TeamID:   testkit.TeamB(),
+				Stored:   []domain.RosterEntry{hitter(testkit.TeamB(), 3, domain.StatusInactive)},
+				Replayed: []domain.RosterEntry{},
+			}},
+		},
+		{
+			name: "reports a team with no stream",
+			drift: func(r *testkit.FakeCurrentRosters) {
+				r.Put(hitter(testkit.TeamC(), 4, domain.StatusInactive))
+			},
+			want: []readmodel.RosterMismatch{{
+				TeamID: testkit.TeamC(),
+				Stored: []domain.RosterEntry{hitter(testkit.TeamC(), 4, domain.StatusInactive)},
+			}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newCheckFixture(t)
+			for team, events := range history {
+				f.store.SeedEvents(team, events)
+			}
+			require.NoError(t, f.runner.CatchUp(t.Context()))
+			tc.drift(f.rosters)
+
+			got, err := f.check.Run(t.Context())
+			require.NoError(t, err)
+
+			assert.Equal(t, got.Through, eventlog.Position(5))
+			assert.Equal(t, got.Mismatches, tc.want)
+		})
+	}
+
+	t.Run("compares at the projection's checkpoint", func(t *testing.T) {
+		f := newCheckFixture(t)
+		f.store.SeedEvents(testkit.TeamA(), history[testkit.TeamA()])
+		require.NoError(t, f.runner.CatchUp(t.Context()))
+
+		f.store.SeedEvents(testkit.TeamB(), history[testkit.TeamB()])
+
+		got, err := f.check.Run(t.Context())
+		require.NoError(t, err)
+
+		assert.Equal(t, got.Through, eventlog.Position(3))
+		assert.Equal(t, got.Teams, 1)
+		assert.Equal(t, len(got.Mismatches), 0)
+	})
+}
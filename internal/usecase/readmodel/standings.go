@@ -0,0 +1,102 @@
+package readmodel
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/projection"
+)
+
+const StandingsProjection = "standings"
+
+// Standings ranks each league's teams from the regular-season results recorded
+// in the league log. It is the ports.Standings the playoffs are seeded from.
+type Standings struct {
+	mu      sync.RWMutex
+	leagues map[domain.LeagueID]*leagueResults
+}
+
+// leagueResults keys results by their sequence in the league's stream, so an
+// event applied twice is counted once.
+type leagueResults struct {
+	teams   []domain.TeamID
+	results map[eventlog.Sequence]domain.MatchupResult
+}
+
+// Standings returns every team in the league from first place down. A league
+// the projection has not seen has no teams.
+func (s *Standings) Standings(ctx context.Context, id domain.LeagueID) ([]domain.TeamID, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	league, ok := s.leagues[id]
+	if !ok {
+		return nil, nil
+	}
+
+	results := make([]domain.MatchupResult, 0, len(league.results))
+	for _, r := range league.results {
+		results = append(results, r)
+	}
+
+	return domain.RankStandings(league.teams, results), nil
+}
+
+// Projection applies the teams that join each league and the results recorded
+// for them.
+func (s *Standings) Projection() *projection.Projection[domain.LeagueEvent] {
+	p := projection.New[domain.LeagueEvent](StandingsProjection)
+
+	projection.On(p, func(ctx context.Context, e domain.JoinedLeague, _ eventlog.Entry[domain.LeagueEvent]) error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		league := s.league(e.LeagueID)
+		if !slices.Contains(league.teams, e.TeamID) {
+			league.teams = append(league.teams, e.TeamID)
+		}
+
+		return nil
+	})
+
+	projection.On(p, func(ctx context.Context, e domain.RecordedMatchupResult, entry eventlog.Entry[domain.LeagueEvent]) error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.league(e.LeagueID).results[entry.Sequence] = e.Result
+
+		return nil
+	})
+
+	p.OnReset(func(ctx context.Context) error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		clear(s.leagues)
+
+		return nil
+	})
+
+	return p
+}
+
+// league returns the league's results, creating them on first use. Callers
+// hold the write lock.
+func (s *Standings) league(id domain.LeagueID) *leagueResults {
+	league, ok := s.leagues[id]
+	if !ok {
+		league = &leagueResults{results: make(map[eventlog.Sequence]domain.MatchupResult)}
+		s.leagues[id] = league
+	}
+
+	return league
+}
+
+func NewStandings() *Standings {
+	return &Standings{
+		leagues: make(map[domain.LeagueID]*leagueResults),
+	}
+}
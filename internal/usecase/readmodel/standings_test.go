@@ -0,0 +1,83 @@
+package readmodel_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/readmodel"
+)
+
+func recorded(period int, home, away domain.TeamID, homeScore, awayScore float64) domain.LeagueEvent {
+	return domain.RecordedMatchupResult{
+		LeagueID:   testkit.LeagueA(),
+		Result:     domain.MatchupResult{Period: period, Home: home, Away: away, HomeScore: homeScore, AwayScore: awayScore},
+		RecordedAt: testkit.TodayLock(),
+	}
+}
+
+func TestStandings(t *testing.T) {
+	testCases := []struct {
+		name    string
+		results []domain.LeagueEvent
+		league  domain.LeagueID
+		want    []domain.TeamID
+	}{
+		{
+			name:   "a league the log does not hold has no standings",
+			league: 99,
+			want:   nil,
+		},
+		{
+			name:   "teams without results rank by TeamID",
+			league: testkit.LeagueA(),
+			want:   []domain.TeamID{testkit.TeamA(), testkit.TeamB()},
+		},
+		{
+			name: "recorded results rank the teams",
+			results: []domain.LeagueEvent{
+				recorded(1, testkit.TeamA(), testkit.TeamB(), 70, 90),
+				recorded(2, testkit.TeamB(), testkit.TeamA(), 60, 65),
+				recorded(3, testkit.TeamA(), testkit.TeamB(), 50, 80),
+			},
+			league: testkit.LeagueA(),
+			want:   []domain.TeamID{testkit.TeamB(), testkit.TeamA()},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := testkit.NewFakeLeagueStore()
+			store.SeedEvents(testkit.LeagueA(), append(testkit.LeagueHistory(domain.PhaseInSeason), tc.results...))
+			standings := readmodel.NewStandings()
+
+			require.NoError(t, testkit.NewLeagueRunner(store, standings.Projection()).CatchUp(t.Context()))
+
+			got, err := standings.Standings(t.Context(), tc.league)
+			require.NoError(t, err)
+			require.Equal(t, len(got), len(tc.want))
+			for i := range tc.want {
+				assert.Equal(t, got[i], tc.want[i])
+			}
+		})
+	}
+}
+
+func TestStandings_Rebuild(t *testing.T) {
+	store := testkit.NewFakeLeagueStore()
+	store.SeedEvents(testkit.LeagueA(), append(testkit.LeagueHistory(domain.PhaseInSeason),
+		recorded(1, testkit.TeamA(), testkit.TeamB(), 70, 90),
+	))
+	standings := readmodel.NewStandings()
+	runner := testkit.NewLeagueRunner(store, standings.Projection())
+	require.NoError(t, runner.CatchUp(t.Context()))
+
+	require.NoError(t, runner.Rebuild(t.Context(), readmodel.StandingsProjection))
+
+	got, err := standings.Standings(t.Context(), testkit.LeagueA())
+	require.NoError(t, err)
+	require.Equal(t, len(got), 2)
+	assert.Equal(t, got[0], testkit.TeamB())
+}
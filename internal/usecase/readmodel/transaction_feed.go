@@ -0,0 +1,135 @@
+package readmodel
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/projection"
+)
+
+const TransactionFeedProjection = "transaction_feed"
+
+type TransactionKind int
+
+const (
+	TransactionAdd TransactionKind = iota + 1
+	TransactionDrop
+	TransactionOverride
+)
+
+// Transaction is one entry in the feed. PlayerID is zero for overrides, and
+// Actor and Reason are set only for them.
+type Transaction struct {
+	Position    eventlog.Position
+	TeamID      domain.TeamID
+	Kind        TransactionKind
+	PlayerID    domain.PlayerID
+	Actor       domain.UserID
+	Reason      string
+	EffectiveAt time.Time
+}
+
+// TransactionFeed lists the adds, drops and commissioner overrides recorded on
+// every roster. Lineup changes are not transactions and are left out.
+type TransactionFeed struct {
+	mu           sync.RWMutex
+	transactions []Transaction
+}
+
+// Recent returns up to limit transactions, newest first.
+func (f *TransactionFeed) Recent(limit int) []Transaction {
+	return f.newest(limit, func(Transaction) bool { return true })
+}
+
+// ForTeam returns up to limit of team's transactions, newest first.
+func (f *TransactionFeed) ForTeam(team domain.TeamID, limit int) []Transaction {
+	return f.newest(limit, func(t Transaction) bool { return t.TeamID == team })
+}
+
+// Projection records a transaction per add, drop and override. Entries at or
+// before the newest position already recorded are skipped, so a batch applied
+// twice is recorded once.
+func (f *TransactionFeed) Projection() *projection.Projection[domain.RosterEvent] {
+	p := projection.New[domain.RosterEvent](TransactionFeedProjection)
+
+	projection.On(p, func(ctx context.Context, e domain.AddedPlayerToRoster, entry eventlog.Entry[domain.RosterEvent]) error {
+		f.record(Transaction{
+			Position:    entry.Position,
+			TeamID:      e.TeamID,
+			Kind:        TransactionAdd,
+			PlayerID:    e.PlayerID,
+			EffectiveAt: e.EffectiveAt,
+		})
+
+		return nil
+	})
+
+	projection.On(p, func(ctx context.Context, e domain.RemovedPlayerFromRoster, entry eventlog.Entry[domain.RosterEvent]) error {
+		f.record(Transaction{
+			Position:    entry.Position,
+			TeamID:      e.TeamID,
+			Kind:        TransactionDrop,
+			PlayerID:    e.PlayerID,
+			EffectiveAt: e.EffectiveAt,
+		})
+
+		return nil
+	})
+
+	projection.On(p, func(ctx context.Context, e domain.RecordedRosterOverride, entry eventlog.Entry[domain.RosterEvent]) error {
+		f.record(Transaction{
+			Position:    entry.Position,
+			TeamID:      e.TeamID,
+			Kind:        TransactionOverride,
+			Actor:       e.Actor,
+			Reason:      e.Reason,
+			EffectiveAt: e.EffectiveAt,
+		})
+
+		return nil
+	})
+
+	p.OnReset(func(ctx context.Context) error {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		f.transactions = nil
+
+		return nil
+	})
+
+	return p
+}
+
+func (f *TransactionFeed) record(t Transaction) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	n := len(f.transactions)
+	if n > 0 && t.Position <= f.transactions[n-1].Position {
+		return
+	}
+
+	f.transactions = append(f.transactions, t)
+}
+
+func (f *TransactionFeed) newest(limit int, keep func(Transaction) bool) []Transaction {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	var out []Transaction
+	for i := len(f.transactions) - 1; i >= 0 && len(out) < limit; i-- {
+		if keep(f.transactions[i]) {
+			out = append(out, f.transactions[i])
+		}
+	}
+
+	return out
+}
+
+func NewTransactionFeed() *TransactionFeed {
+	return &TransactionFeed{}
+}
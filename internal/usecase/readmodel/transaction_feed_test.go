@@ -0,0 +1,67 @@
+package readmodel_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/readmodel"
+)
+
+func TestTransactionFeed(t *testing.T) {
+	store := testkit.NewFakeRosterStore()
+	store.SeedEvents(testkit.TeamA(), []domain.RosterEvent{
+		added(testkit.TeamA(), 7),
+		domain.ActivatedPlayerOnRoster{TeamID: testkit.TeamA(), PlayerID: 7, EffectiveAt: testkit.TodayLock()},
+	})
+	store.SeedEvents(testkit.TeamB(), []domain.RosterEvent{added(testkit.TeamB(), 8)})
+	_, err := store.Append(t.Context(), testkit.TeamA(), []domain.RosterEvent{
+		domain.RecordedRosterOverride{
+			TeamID:      testkit.TeamA(),
+			Actor:       testkit.Commissioner(),
+			Kind:        domain.OverrideForcedRemove,
+			Reason:      "added in error",
+			EffectiveAt: testkit.TodayLock(),
+		},
+		removed(testkit.TeamA(), 7),
+	}, 2)
+	require.NoError(t, err)
+
+	feed := readmodel.NewTransactionFeed()
+	require.NoError(t, testkit.NewRosterRunner(store, feed.Projection()).CatchUp(t.Context()))
+
+	t.Run("lists adds, drops and overrides newest first", func(t *testing.T) {
+		got := feed.Recent(10)
+
+		require.Equal(t, len(got), 4)
+		assert.Equal(t, got[0].Kind, readmodel.TransactionDrop)
+		assert.Equal(t, got[0].Position, eventlog.Position(5))
+		assert.Equal(t, got[1].Kind, readmodel.TransactionOverride)
+		assert.Equal(t, got[1].Actor, testkit.Commissioner())
+		assert.Equal(t, got[1].Reason, "added in error")
+		assert.Equal(t, got[2].Kind, readmodel.TransactionAdd)
+		assert.Equal(t, got[2].TeamID, testkit.TeamB())
+		assert.Equal(t, got[3].PlayerID, domain.PlayerID(7))
+	})
+
+	t.Run("limits and filters by team", func(t *testing.T) {
+		got := feed.ForTeam(testkit.TeamA(), 2)
+
+		require.Equal(t, len(got), 2)
+		assert.Equal(t, got[0].Kind, readmodel.TransactionDrop)
+		assert.Equal(t, got[1].Kind, readmodel.TransactionOverride)
+	})
+
+	t.Run("records an entry applied twice once", func(t *testing.T) {
+		p := feed.Projection()
+		last, err := store.ReadAfter(t.Context(), 4, 1)
+		require.NoError(t, err)
+
+		require.NoError(t, p.Apply(t.Context(), last[0]))
+
+		assert.Equal(t, len(feed.Recent(10)), 4)
+	})
+}
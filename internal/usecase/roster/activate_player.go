@@ -0,0 +1,90 @@
+package roster
+
+import (
+	"context"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+type ActivatePlayerHandler struct {
+	Store  ports.RosterStore
+	Lock   ports.LeagueLock
+	Access Access
+}
+
+func (h ActivatePlayerHandler) Handle(ctx context.Context, cmd ActivatePlayerCommand) (err error) {
+	ctx, span := startCommand(ctx, "ActivatePlayer", cmd.TeamID, cmd.PlayerID)
+	defer func() { span.end(ctx, err) }()
+
+	rules, err := h.Access.authorizeRosterChange(ctx, cmd.Actor, cmd.TeamID)
+	if err != nil {
+		return err
+	}
+
+	effective, err := targetLock(ctx, h.Lock, cmd.At)
+	if err != nil {
+		return err
+	}
+
+	committed, version, err := h.Store.Load(ctx, cmd.TeamID)
+	if err != nil {
+		return err
+	}
+
+	recordLoaded(ctx, version)
+
+	stream := NewRosterStream(cmd.TeamID, committed)
+
+	events, err := DecideAcross(ctx, h.Store, stream, effective, rules, func(rv domain.RosterView) ([]domain.RosterEvent, error) {
+		return rv.DecideActivatePlayer(cmd.PlayerID, cmd.Role)
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = h.Store.Append(ctx, cmd.TeamID, events, version)
+	if err != nil {
+		return err
+	}
+
+	recordAppended(ctx, events)
+
+	return nil
+}
+
+func NewActivatePlayerHandler(store ports.RosterStore, lock ports.LeagueLock, access Access) ActivatePlayerHandler {
+	return ActivatePlayerHandler{
+		Store:  store,
+		Lock:   lock,
+		Access: access,
+	}
+}
+
+// ActivatePlayerCommand takes effect at the next lock, or at the first lock at or after
+// At when it is set.
+type ActivatePlayerCommand struct {
+	TeamID   domain.TeamID
+	PlayerID domain.PlayerID
+	Role     domain.PlayerRole
+	Actor    domain.UserID
+	At       time.Time
+}
+
+func NewActivatePlayerCommand(teamID domain.TeamID, playerID domain.PlayerID, role domain.PlayerRole, actor domain.UserID) ActivatePlayerCommand {
+	return ActivatePlayerCommand{
+		TeamID:   teamID,
+		PlayerID: playerID,
+		Role:     role,
+		Actor:    actor,
+	}
+}
+
+// NewScheduledActivatePlayerCommand queues the move for the first lock at or after at.
+func NewScheduledActivatePlayerCommand(teamID domain.TeamID, playerID domain.PlayerID, role domain.PlayerRole, actor domain.UserID, at time.Time) ActivatePlayerCommand {
+	cmd := NewActivatePlayerCommand(teamID, playerID, role, actor)
+	cmd.At = at
+
+	return cmd
+}
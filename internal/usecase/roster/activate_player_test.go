@@ -0,0 +1,65 @@
+package roster_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/roster"
+)
+
+func TestActivatePlayerHandler_Handle(t *testing.T) {
+	pitcherHistory := []domain.RosterEvent{
+		domain.AddedPlayerToRoster{
+			TeamID:      testkit.TeamA(),
+			PlayerID:    1,
+			Eligibility: domain.MustRoleSet(domain.RolePitcher),
+			EffectiveAt: testkit.TodayLock(),
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		role    domain.PlayerRole
+		wantErr error
+	}{
+		{
+			name:    "eligible role appends ActivatedPlayerOnRoster event",
+			role:    domain.RolePitcher,
+			wantErr: nil,
+		},
+		{
+			name:    "ineligible role returns error and does not append",
+			role:    domain.RoleHitter,
+			wantErr: domain.ErrPlayerNotEligibleForRole,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := testkit.NewFakeRosterStore()
+			spy := testkit.NewSpyRosterStore(store)
+			store.SeedEvents(testkit.TeamA(), pitcherHistory)
+
+			handler := roster.NewActivatePlayerHandler(spy, testkit.NewStubLeagueLock(), inSeasonAccess())
+
+			err := handler.Handle(t.Context(), roster.NewActivatePlayerCommand(testkit.TeamA(), 1, tc.role, testkit.ManagerA()))
+
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+
+				require.Equal(t, len(spy.AppendCalls), 1)
+				require.Equal(t, len(spy.AppendCalls[0].Events), 1)
+				ev, ok := spy.AppendCalls[0].Events[0].(domain.ActivatedPlayerOnRoster)
+				require.True(t, ok)
+				assert.Equal(t, ev.PlayerRole, tc.role)
+				assert.Equal(t, ev.EffectiveAt, handler.Lock.NextLock(t.Context()))
+			} else {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Equal(t, len(spy.AppendCalls), 0)
+			}
+		})
+	}
+}
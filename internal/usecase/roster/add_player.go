@@ -1,52 +1,95 @@
 package roster
 
 import (
+	"context"
+	"time"
+
 	"github.com/spcameron/dugout/internal/domain"
 	"github.com/spcameron/dugout/internal/ports"
 )
 
 type AddPlayerHandler struct {
-	Store ports.RosterStore
-	Lock  ports.LeagueLock
+	Store   ports.RosterStore
+	Lock    ports.LeagueLock
+	Players ports.PlayerRepository
+	Access  Access
 }
 
-func (h AddPlayerHandler) Handle(cmd AddPlayerCommand) error {
-	committed, version, err := h.Store.Load(cmd.TeamID)
+func (h AddPlayerHandler) Handle(ctx context.Context, cmd AddPlayerCommand) (err error) {
+	ctx, span := startCommand(ctx, "AddPlayer", cmd.TeamID, cmd.PlayerID)
+	defer func() { span.end(ctx, err) }()
+
+	rules, err := h.Access.authorizeRosterChange(ctx, cmd.Actor, cmd.TeamID)
+	if err != nil {
+		return err
+	}
+
+	effective, err := targetLock(ctx, h.Lock, cmd.At)
+	if err != nil {
+		return err
+	}
+
+	player, err := h.Players.Get(ctx, cmd.PlayerID)
 	if err != nil {
 		return err
 	}
 
+	committed, version, err := h.Store.Load(ctx, cmd.TeamID)
+	if err != nil {
+		return err
+	}
+
+	recordLoaded(ctx, version)
+
 	stream := NewRosterStream(cmd.TeamID, committed)
-	view := stream.ProjectThrough(h.Lock.NextLock())
 
-	events, err := view.DecideAddPlayer(cmd.PlayerID)
+	events, err := DecideAcross(ctx, h.Store, stream, effective, rules, func(rv domain.RosterView) ([]domain.RosterEvent, error) {
+		return rv.DecideAddPlayer(cmd.PlayerID, player.Roles)
+	})
 	if err != nil {
 		return err
 	}
 
-	_, err = h.Store.Append(cmd.TeamID, events, version)
+	_, err = h.Store.Append(ctx, cmd.TeamID, events, version)
 	if err != nil {
 		return err
 	}
 
+	recordAppended(ctx, events)
+
 	return nil
 }
 
-func NewAddPlayerHandler(store ports.RosterStore, lock ports.LeagueLock) AddPlayerHandler {
+func NewAddPlayerHandler(store ports.RosterStore, lock ports.LeagueLock, players ports.PlayerRepository, access Access) AddPlayerHandler {
 	return AddPlayerHandler{
-		Store: store,
-		Lock:  lock,
+		Store:   store,
+		Lock:    lock,
+		Players: players,
+		Access:  access,
 	}
 }
 
+// AddPlayerCommand takes effect at the next lock, or at the first lock at or after
+// At when it is set.
 type AddPlayerCommand struct {
 	TeamID   domain.TeamID
 	PlayerID domain.PlayerID
+	Actor    domain.UserID
+	At       time.Time
 }
 
-func NewAddPlayerCommand(teamID domain.TeamID, playerID domain.PlayerID) AddPlayerCommand {
+func NewAddPlayerCommand(teamID domain.TeamID, playerID domain.PlayerID, actor domain.UserID) AddPlayerCommand {
 	return AddPlayerCommand{
 		TeamID:   teamID,
 		PlayerID: playerID,
+		Actor:    actor,
 	}
 }
+
+// NewScheduledAddPlayerCommand queues the move for the first lock at or after at.
+func NewScheduledAddPlayerCommand(teamID domain.TeamID, playerID domain.PlayerID, actor domain.UserID, at time.Time) AddPlayerCommand {
+	cmd := NewAddPlayerCommand(teamID, playerID, actor)
+	cmd.At = at
+
+	return cmd
+}
@@ -1,9 +1,11 @@
 package roster_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
 	"github.com/spcameron/dugout/internal/ports"
 	"github.com/spcameron/dugout/internal/testsupport/assert"
 	"github.com/spcameron/dugout/internal/testsupport/require"
@@ -56,10 +58,13 @@ func TestAddPlayerHandler_Handle(t *testing.T) {
 
 			store.SeedEvents(tc.teamID, tc.history)
 
-			handler := roster.NewAddPlayerHandler(spy, leagueLock)
-			cmd := roster.NewAddPlayerCommand(tc.teamID, tc.playerID)
+			players := testkit.NewFakePlayerRepository()
+			players.SeedPlayerIDs(tc.playerID)
 
-			err := handler.Handle(cmd)
+			handler := roster.NewAddPlayerHandler(spy, leagueLock, players, inSeasonAccess())
+			cmd := roster.NewAddPlayerCommand(tc.teamID, tc.playerID, testkit.ManagerA())
+
+			err := handler.Handle(t.Context(), cmd)
 
 			if tc.wantErr == nil {
 				assert.Nil(t, err)
@@ -76,7 +81,7 @@ func TestAddPlayerHandler_Handle(t *testing.T) {
 				require.Equal(t, len(appendCall.Events), 1)
 				appendedEvent := appendCall.Events[0]
 				require.Equal(t, appendedEvent.Team(), tc.teamID)
-				require.Equal(t, appendedEvent.OccurredAt(), handler.Lock.NextLock())
+				require.Equal(t, appendedEvent.OccurredAt(), handler.Lock.NextLock(t.Context()))
 
 				ev, ok := appendedEvent.(domain.AddedPlayerToRoster)
 				require.True(t, ok)
@@ -115,16 +120,109 @@ func TestAddPlayerHandler_Handle(t *testing.T) {
 		},
 	}
 
+	t.Run("added event records the player's catalog eligibility", func(t *testing.T) {
+		spy := testkit.NewSpyRosterStore(testkit.NewFakeRosterStore())
+		players := testkit.NewFakePlayerRepository()
+		players.SeedPlayers(domain.Player{
+			ID:    1,
+			MLBID: 660271,
+			Name:  "Shohei Ohtani",
+			Roles: domain.MustRoleSet(domain.RoleHitter, domain.RolePitcher),
+		})
+
+		handler := roster.NewAddPlayerHandler(spy, testkit.NewStubLeagueLock(), players, inSeasonAccess())
+
+		require.NoError(t, handler.Handle(t.Context(), roster.NewAddPlayerCommand(testkit.TeamA(), 1, testkit.ManagerA())))
+
+		require.Equal(t, len(spy.AppendCalls), 1)
+		ev, ok := spy.AppendCalls[0].Events[0].(domain.AddedPlayerToRoster)
+		require.True(t, ok)
+		assert.Equal(t, ev.Eligibility, domain.MustRoleSet(domain.RoleHitter, domain.RolePitcher))
+	})
+
+	t.Run("unknown player returns ErrPlayerNotFound and does not load or append", func(t *testing.T) {
+		spy := testkit.NewSpyRosterStore(testkit.NewFakeRosterStore())
+		handler := roster.NewAddPlayerHandler(spy, testkit.NewStubLeagueLock(), testkit.NewFakePlayerRepository(), inSeasonAccess())
+
+		err := handler.Handle(t.Context(), roster.NewAddPlayerCommand(testkit.TeamA(), 1, testkit.ManagerA()))
+
+		assert.ErrorIs(t, err, ports.ErrPlayerNotFound)
+		assert.Equal(t, len(spy.LoadCalls), 0)
+		assert.Equal(t, len(spy.AppendCalls), 0)
+	})
+
+	t.Run("cancelled context returns before loading the roster", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+
+		spy := testkit.NewSpyRosterStore(testkit.NewFakeRosterStore())
+		players := testkit.NewFakePlayerRepository()
+		players.SeedPlayerIDs(1)
+		handler := roster.NewAddPlayerHandler(spy, testkit.NewStubLeagueLock(), players, inSeasonAccess())
+
+		err := handler.Handle(ctx, roster.NewAddPlayerCommand(testkit.TeamA(), 1, testkit.ManagerA()))
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, len(spy.LoadCalls), 0)
+		assert.Equal(t, len(spy.AppendCalls), 0)
+	})
+
+	t.Run("context cancelled after load aborts the append", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		store := testkit.NewFakeRosterStore()
+		players := testkit.NewFakePlayerRepository()
+		players.SeedPlayerIDs(1)
+		handler := roster.NewAddPlayerHandler(cancelOnLoad{store, cancel}, testkit.NewStubLeagueLock(), players, inSeasonAccess())
+
+		err := handler.Handle(ctx, roster.NewAddPlayerCommand(testkit.TeamA(), 1, testkit.ManagerA()))
+
+		assert.ErrorIs(t, err, context.Canceled)
+		committed, _, err := store.Load(t.Context(), testkit.TeamA())
+		require.NoError(t, err)
+		assert.Equal(t, len(committed), 0)
+	})
+
+	t.Run("league add limit returns error and does not append", func(t *testing.T) {
+		store := testkit.NewFakeRosterStore()
+		store.SeedEvents(testkit.TeamA(), generateRosterHistory(testkit.TeamA(), 1))
+		spy := testkit.NewSpyRosterStore(store)
+		players := testkit.NewFakePlayerRepository()
+		players.SeedPlayerIDs(2)
+
+		handler := roster.NewAddPlayerHandler(spy, testkit.NewStubLeagueLock(), players, limitedAccess(domain.TransactionLimits{AddsPerPeriod: 1}))
+
+		err := handler.Handle(t.Context(), roster.NewAddPlayerCommand(testkit.TeamA(), 2, testkit.ManagerA()))
+
+		assert.ErrorIs(t, err, domain.ErrPeriodAddLimitReached)
+		assert.Equal(t, len(spy.AppendCalls), 0)
+	})
+
+	players := testkit.NewFakePlayerRepository()
+	players.SeedPlayerIDs(1)
+
 	for _, tc := range failureTestCases {
-		handler := roster.NewAddPlayerHandler(tc.store, testkit.NewStubLeagueLock())
-		cmd := roster.NewAddPlayerCommand(testkit.TeamA(), 1)
+		handler := roster.NewAddPlayerHandler(tc.store, testkit.NewStubLeagueLock(), players, inSeasonAccess())
+		cmd := roster.NewAddPlayerCommand(testkit.TeamA(), 1, testkit.ManagerA())
 
-		err := handler.Handle(cmd)
+		err := handler.Handle(t.Context(), cmd)
 
 		assert.ErrorIs(t, err, tc.wantErr)
 	}
 }
 
+// cancelOnLoad cancels the request's context once the roster has loaded, as if
+// the client went away while the handler was deciding.
+type cancelOnLoad struct {
+	*testkit.FakeRosterStore
+	cancel context.CancelFunc
+}
+
+func (s cancelOnLoad) Load(ctx context.Context, id domain.TeamID) ([]eventlog.Recorded[domain.RosterEvent], ports.Version, error) {
+	defer s.cancel()
+
+	return s.FakeRosterStore.Load(ctx, id)
+}
+
 func generateRosterHistory(id domain.TeamID, players int) []domain.RosterEvent {
 	history := make([]domain.RosterEvent, players)
 	for i := range players {
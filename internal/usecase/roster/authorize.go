@@ -0,0 +1,122 @@
+package roster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/usecase/league"
+)
+
+// Access decides who may change a team's roster and whether its league is in a
+// phase that allows roster moves.
+type Access struct {
+	Members ports.MembershipRepository
+	Leagues ports.LeagueStore
+}
+
+func NewAccess(members ports.MembershipRepository, leagues ports.LeagueStore) Access {
+	return Access{
+		Members: members,
+		Leagues: leagues,
+	}
+}
+
+// authorizeRosterChange returns ErrNotAuthorized unless actor manages team or is
+// a commissioner of its league, and ErrRosterMovesClosed unless the league's
+// phase allows roster moves. It returns the transaction rules the change is held
+// to.
+func (a Access) authorizeRosterChange(ctx context.Context, actor domain.UserID, team domain.TeamID) (domain.TransactionRules, error) {
+	leagueID, err := a.authorize(ctx, actor, team, domain.Memberships.CanManageRoster)
+	if err != nil {
+		return domain.TransactionRules{}, err
+	}
+
+	return a.leagueRules(ctx, leagueID)
+}
+
+// RosterMovesOpen returns ErrRosterMovesClosed unless team's league is in a
+// phase that allows roster moves, and returns the transaction rules moves are
+// held to. It is for moves no user makes directly, such as trade settlement.
+func (a Access) RosterMovesOpen(ctx context.Context, team domain.TeamID) (domain.TransactionRules, error) {
+	leagueID, err := a.Members.LeagueOf(ctx, team)
+	if err != nil {
+		return domain.TransactionRules{}, err
+	}
+
+	return a.leagueRules(ctx, leagueID)
+}
+
+func (a Access) leagueRules(ctx context.Context, leagueID domain.LeagueID) (domain.TransactionRules, error) {
+	view, err := league.LoadLeague(ctx, a.Leagues, leagueID)
+	if err != nil {
+		return domain.TransactionRules{}, err
+	}
+
+	if !view.Phase.AllowsRosterMoves() {
+		return domain.TransactionRules{}, fmt.Errorf("%w: league %v is in %v", domain.ErrRosterMovesClosed, leagueID, view.Phase)
+	}
+
+	return view.Settings.TransactionRules(), nil
+}
+
+// AuthorizeCommissioner returns ErrNotAuthorized unless actor is a commissioner
+// of team's league. Overrides bypass the usual roster rules, so team managers
+// cannot apply them to their own rosters, and commissioners may apply them in
+// any phase.
+func (a Access) AuthorizeCommissioner(ctx context.Context, actor domain.UserID, team domain.TeamID) error {
+	_, err := a.authorize(ctx, actor, team, func(ms domain.Memberships, league domain.LeagueID, _ domain.TeamID) bool {
+		return ms.IsCommissioner(league)
+	})
+
+	return err
+}
+
+// AuthorizeManager returns ErrNotAuthorized unless actor manages or co-manages
+// team, and returns team's league. Trades are between teams, so a commissioner
+// cannot make one for a team they do not manage.
+func (a Access) AuthorizeManager(ctx context.Context, actor domain.UserID, team domain.TeamID) (domain.LeagueID, error) {
+	return a.authorize(ctx, actor, team, func(ms domain.Memberships, _ domain.LeagueID, team domain.TeamID) bool {
+		return ms.ManagesTeam(team)
+	})
+}
+
+// RequireInLeague returns ErrTeamNotInLeague unless team belongs to league,
+// including when the team does not exist.
+func (a Access) RequireInLeague(ctx context.Context, league domain.LeagueID, team domain.TeamID) error {
+	teamLeague, err := a.Members.LeagueOf(ctx, team)
+	if err != nil && !errors.Is(err, ports.ErrTeamNotFound) {
+		return err
+	}
+
+	if err != nil || teamLeague != league {
+		return fmt.Errorf("%w: team %v, league %v", domain.ErrTeamNotInLeague, team, league)
+	}
+
+	return nil
+}
+
+func (a Access) authorize(
+	ctx context.Context,
+	actor domain.UserID,
+	team domain.TeamID,
+	allowed func(domain.Memberships, domain.LeagueID, domain.TeamID) bool,
+) (domain.LeagueID, error) {
+	league, err := a.Members.LeagueOf(ctx, team)
+	if err != nil {
+		return 0, err
+	}
+
+	memberships, err := a.Members.ListForUser(ctx, actor)
+	if err != nil {
+		return 0, err
+	}
+
+	if !allowed(memberships, league, team) {
+		return 0, fmt.Errorf("%w: user %v, team %v", domain.ErrNotAuthorized, actor, team)
+	}
+
+	return league, nil
+}
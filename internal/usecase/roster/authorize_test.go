@@ -0,0 +1,185 @@
+package roster_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/roster"
+)
+
+func TestRosterAuthorization(t *testing.T) {
+	coManager := domain.UserID(12)
+	outsider := domain.UserID(50)
+
+	testCases := []struct {
+		name    string
+		actor   domain.UserID
+		teamID  domain.TeamID
+		wantErr error
+	}{
+		{
+			name:   "manager may change their own roster",
+			actor:  testkit.ManagerA(),
+			teamID: testkit.TeamA(),
+		},
+		{
+			name:   "co-manager may change their team's roster",
+			actor:  coManager,
+			teamID: testkit.TeamA(),
+		},
+		{
+			name:   "commissioner may change any roster in the league",
+			actor:  testkit.Commissioner(),
+			teamID: testkit.TeamB(),
+		},
+		{
+			name:    "manager may not change another team's roster",
+			actor:   testkit.ManagerB(),
+			teamID:  testkit.TeamA(),
+			wantErr: domain.ErrNotAuthorized,
+		},
+		{
+			name:    "user without memberships may not change a roster",
+			actor:   outsider,
+			teamID:  testkit.TeamA(),
+			wantErr: domain.ErrNotAuthorized,
+		},
+		{
+			name:    "team outside any league is not found",
+			actor:   testkit.Commissioner(),
+			teamID:  testkit.TeamC(),
+			wantErr: ports.ErrTeamNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			members := testkit.NewLeagueMemberships()
+			err := members.Grant(t.Context(), domain.Membership{UserID: coManager, LeagueID: testkit.LeagueA(), TeamID: testkit.TeamA(), Role: domain.MembershipCoManager})
+			assert.NoError(t, err)
+
+			spy := testkit.NewSpyRosterStore(testkit.NewFakeRosterStore())
+			players := testkit.NewFakePlayerRepository()
+			players.SeedPlayerIDs(1)
+
+			handler := roster.NewAddPlayerHandler(spy, testkit.NewStubLeagueLock(), players, roster.NewAccess(members, testkit.NewLeagueStoreInPhase(domain.PhaseInSeason)))
+
+			err = handler.Handle(t.Context(), roster.NewAddPlayerCommand(tc.teamID, 1, tc.actor))
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Equal(t, len(spy.LoadCalls), 0)
+				assert.Equal(t, len(spy.AppendCalls), 0)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, len(spy.AppendCalls), 1)
+		})
+	}
+
+	t.Run("overrides are reserved for the commissioner", func(t *testing.T) {
+		store := testkit.NewFakeRosterStore()
+		store.SeedEvents(testkit.TeamA(), []domain.RosterEvent{
+			domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 1, Eligibility: domain.MustRoleSet(domain.RoleHitter), EffectiveAt: testkit.TodayLock()},
+		})
+		spy := testkit.NewSpyRosterStore(store)
+
+		handler := roster.NewForceRemovePlayerHandler(spy, testkit.NewStubLeagueLock(), inSeasonAccess())
+
+		err := handler.Handle(t.Context(), roster.NewForceRosterMoveCommand(testkit.TeamA(), 1, testkit.ManagerA(), "injured"))
+		assert.ErrorIs(t, err, domain.ErrNotAuthorized)
+		assert.Equal(t, len(spy.AppendCalls), 0)
+
+		err = handler.Handle(t.Context(), roster.NewForceRosterMoveCommand(testkit.TeamA(), 1, testkit.Commissioner(), "injured"))
+		assert.NoError(t, err)
+		assert.Equal(t, len(spy.AppendCalls), 1)
+	})
+
+	t.Run("roster moves follow the league phase", func(t *testing.T) {
+		phases := []struct {
+			phase domain.LeaguePhase
+			open  bool
+		}{
+			{domain.PhasePreDraft, false},
+			{domain.PhaseDrafting, false},
+			{domain.PhaseInSeason, true},
+			{domain.PhasePlayoffs, true},
+			{domain.PhaseComplete, false},
+		}
+
+		for _, p := range phases {
+			spy := testkit.NewSpyRosterStore(testkit.NewFakeRosterStore())
+			players := testkit.NewFakePlayerRepository()
+			players.SeedPlayerIDs(1)
+			access := roster.NewAccess(testkit.NewLeagueMemberships(), testkit.NewLeagueStoreInPhase(p.phase))
+
+			handler := roster.NewAddPlayerHandler(spy, testkit.NewStubLeagueLock(), players, access)
+
+			err := handler.Handle(t.Context(), roster.NewAddPlayerCommand(testkit.TeamA(), 1, testkit.ManagerA()))
+
+			if p.open {
+				assert.NoError(t, err)
+				continue
+			}
+
+			assert.ErrorIs(t, err, domain.ErrRosterMovesClosed)
+			assert.Equal(t, len(spy.AppendCalls), 0)
+		}
+	})
+
+	t.Run("commissioners may override rosters in any phase", func(t *testing.T) {
+		spy := testkit.NewSpyRosterStore(testkit.NewFakeRosterStore())
+		players := testkit.NewFakePlayerRepository()
+		players.SeedPlayerIDs(1)
+		access := roster.NewAccess(testkit.NewLeagueMemberships(), testkit.NewLeagueStoreInPhase(domain.PhaseDrafting))
+
+		handler := roster.NewForceAddPlayerHandler(spy, testkit.NewStubLeagueLock(), players, access)
+
+		err := handler.Handle(t.Context(), roster.NewForceRosterMoveCommand(testkit.TeamA(), 1, testkit.Commissioner(), "draft correction"))
+		assert.NoError(t, err)
+		assert.Equal(t, len(spy.AppendCalls), 1)
+	})
+
+	t.Run("team in a league that was never created is not found", func(t *testing.T) {
+		access := roster.NewAccess(testkit.NewLeagueMemberships(), testkit.NewFakeLeagueStore())
+		handler := roster.NewRemovePlayerHandler(testkit.NewFakeRosterStore(), testkit.NewStubLeagueLock(), access)
+
+		err := handler.Handle(t.Context(), roster.NewRemovePlayerCommand(testkit.TeamA(), 1, testkit.ManagerA()))
+		assert.ErrorIs(t, err, ports.ErrLeagueNotFound)
+	})
+}
+
+// inSeasonAccess returns access for the NewLeagueMemberships league once its
+// season has started, when managers may change their rosters.
+func inSeasonAccess() roster.Access {
+	return roster.NewAccess(testkit.NewLeagueMemberships(), testkit.NewLeagueStoreInPhase(domain.PhaseInSeason))
+}
+
+// limitedAccess is inSeasonAccess for a league whose season began at TodayLock,
+// in weekly periods, with the given transaction limits.
+func limitedAccess(limits domain.TransactionLimits) roster.Access {
+	leagues := inSeasonLeague(func(s *domain.LeagueSettings) {
+		s.Calendar = domain.MatchupCalendar{SeasonStart: testkit.TodayLock(), PeriodDays: 7}
+		s.Transactions = limits
+	})
+
+	return roster.NewAccess(testkit.NewLeagueMemberships(), leagues)
+}
+
+// inSeasonLeague returns a store holding LeagueA in season, created with the
+// test settings as changed by configure.
+func inSeasonLeague(configure func(*domain.LeagueSettings)) *testkit.FakeLeagueStore {
+	history := testkit.LeagueHistory(domain.PhaseInSeason)
+	created := history[0].(domain.CreatedLeague)
+	configure(&created.Settings)
+	history[0] = created
+
+	leagues := testkit.NewFakeLeagueStore()
+	leagues.SeedEvents(testkit.LeagueA(), history)
+
+	return leagues
+}
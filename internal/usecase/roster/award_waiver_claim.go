@@ -0,0 +1,99 @@
+package roster
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// AwardWaiverClaimHandler adds a player to the team that won the waiver claim on
+// them, at the next lock, and records the award alongside the add so the team's
+// managers can be told. Only commissioners of the team's league award claims,
+// and the add is held to the league's transaction rules like any other.
+type AwardWaiverClaimHandler struct {
+	Store   ports.RosterStore
+	Lock    ports.LeagueLock
+	Players ports.PlayerRepository
+	Access  Access
+}
+
+func (h AwardWaiverClaimHandler) Handle(ctx context.Context, cmd AwardWaiverClaimCommand) (err error) {
+	ctx, span := startCommand(ctx, "AwardWaiverClaim", cmd.TeamID, cmd.PlayerID)
+	defer func() { span.end(ctx, err) }()
+
+	err = h.Access.AuthorizeCommissioner(ctx, cmd.Actor, cmd.TeamID)
+	if err != nil {
+		return err
+	}
+
+	rules, err := h.Access.authorizeRosterChange(ctx, cmd.Actor, cmd.TeamID)
+	if err != nil {
+		return err
+	}
+
+	player, err := h.Players.Get(ctx, cmd.PlayerID)
+	if err != nil {
+		return err
+	}
+
+	committed, version, err := h.Store.Load(ctx, cmd.TeamID)
+	if err != nil {
+		return err
+	}
+
+	recordLoaded(ctx, version)
+
+	stream := NewRosterStream(cmd.TeamID, committed)
+	effective := h.Lock.NextLock(ctx)
+
+	events, err := DecideAcross(ctx, h.Store, stream, effective, rules, func(rv domain.RosterView) ([]domain.RosterEvent, error) {
+		return rv.DecideAddPlayer(cmd.PlayerID, player.Roles)
+	})
+	if err != nil {
+		return err
+	}
+
+	events = append(events, domain.AwardedWaiverClaim{
+		TeamID:      cmd.TeamID,
+		PlayerID:    cmd.PlayerID,
+		EffectiveAt: effective,
+	})
+
+	_, err = h.Store.Append(ctx, cmd.TeamID, events, version)
+	if err != nil {
+		return err
+	}
+
+	recordAppended(ctx, events)
+
+	return nil
+}
+
+func NewAwardWaiverClaimHandler(
+	store ports.RosterStore,
+	lock ports.LeagueLock,
+	players ports.PlayerRepository,
+	access Access,
+) AwardWaiverClaimHandler {
+	return AwardWaiverClaimHandler{
+		Store:   store,
+		Lock:    lock,
+		Players: players,
+		Access:  access,
+	}
+}
+
+type AwardWaiverClaimCommand struct {
+	TeamID   domain.TeamID
+	PlayerID domain.PlayerID
+	Actor    domain.UserID
+}
+
+func NewAwardWaiverClaimCommand(teamID domain.TeamID, playerID domain.PlayerID, actor domain.UserID) AwardWaiverClaimCommand {
+	return AwardWaiverClaimCommand{
+		TeamID:   teamID,
+		PlayerID: playerID,
+		Actor:    actor,
+	}
+}
@@ -0,0 +1,78 @@
+package roster_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/roster"
+)
+
+func TestAwardWaiverClaimHandler_Handle(t *testing.T) {
+	testCases := []struct {
+		name     string
+		playerID domain.PlayerID
+		history  []domain.RosterEvent
+		actor    domain.UserID
+		wantErr  error
+	}{
+		{
+			name:     "award adds the player at the next lock and records the claim",
+			playerID: 1,
+			actor:    testkit.Commissioner(),
+		},
+		{
+			name:     "award is held to the roster limit",
+			playerID: domain.MaxRosterSize + 1,
+			history:  generateRosterHistory(testkit.TeamA(), domain.MaxRosterSize),
+			actor:    testkit.Commissioner(),
+			wantErr:  domain.ErrRosterFull,
+		},
+		{
+			name:     "a team's manager cannot award a claim",
+			playerID: 1,
+			actor:    testkit.ManagerA(),
+			wantErr:  domain.ErrNotAuthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lock := testkit.NewStubLeagueLock()
+			store := testkit.NewFakeRosterStore()
+			spy := testkit.NewSpyRosterStore(store)
+
+			store.SeedEvents(testkit.TeamA(), tc.history)
+
+			players := testkit.NewFakePlayerRepository()
+			players.SeedPlayerIDs(tc.playerID)
+
+			handler := roster.NewAwardWaiverClaimHandler(spy, lock, players, inSeasonAccess())
+			err := handler.Handle(t.Context(), roster.NewAwardWaiverClaimCommand(testkit.TeamA(), tc.playerID, tc.actor))
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Equal(t, len(spy.AppendCalls), 0)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, len(spy.AppendCalls), 1)
+
+			events := spy.AppendCalls[0].Events
+			require.Equal(t, len(events), 2)
+
+			added, ok := events[0].(domain.AddedPlayerToRoster)
+			require.True(t, ok)
+			assert.Equal(t, added.PlayerID, tc.playerID)
+			assert.Equal(t, added.EffectiveAt, lock.NextLock(t.Context()))
+
+			award, ok := events[1].(domain.AwardedWaiverClaim)
+			require.True(t, ok)
+			assert.Equal(t, award.PlayerID, tc.playerID)
+			assert.Equal(t, award.EffectiveAt, added.EffectiveAt)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package roster
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// ForceAddPlayerHandler adds a player at the last lock rather than the next one,
+// for commissioners correcting a roster mid-period. Only commissioners of the
+// team's league may use it.
+type ForceAddPlayerHandler struct {
+	Store   ports.RosterStore
+	Lock    ports.LeagueLock
+	Players ports.PlayerRepository
+	Access  Access
+}
+
+func (h ForceAddPlayerHandler) Handle(ctx context.Context, cmd ForceRosterMoveCommand) (err error) {
+	ctx, span := startCommand(ctx, "ForceAddPlayer", cmd.TeamID, cmd.PlayerID)
+	defer func() { span.end(ctx, err) }()
+
+	err = h.Access.AuthorizeCommissioner(ctx, cmd.Actor, cmd.TeamID)
+	if err != nil {
+		return err
+	}
+
+	player, err := h.Players.Get(ctx, cmd.PlayerID)
+	if err != nil {
+		return err
+	}
+
+	return handleForcedMove(ctx, h.Store, h.Lock, cmd, domain.OverrideForcedAdd, func(rv domain.RosterView, id domain.PlayerID) ([]domain.RosterEvent, error) {
+		return rv.DecideAddPlayer(id, player.Roles)
+	})
+}
+
+func NewForceAddPlayerHandler(
+	store ports.RosterStore,
+	lock ports.LeagueLock,
+	players ports.PlayerRepository,
+	access Access,
+) ForceAddPlayerHandler {
+	return ForceAddPlayerHandler{
+		Store:   store,
+		Lock:    lock,
+		Players: players,
+		Access:  access,
+	}
+}
+
+// ForceRemovePlayerHandler removes a player at the last lock rather than the next one,
+// for commissioners correcting a roster mid-period. Only commissioners of the
+// team's league may use it.
+type ForceRemovePlayerHandler struct {
+	Store  ports.RosterStore
+	Lock   ports.LeagueLock
+	Access Access
+}
+
+func (h ForceRemovePlayerHandler) Handle(ctx context.Context, cmd ForceRosterMoveCommand) (err error) {
+	ctx, span := startCommand(ctx, "ForceRemovePlayer", cmd.TeamID, cmd.PlayerID)
+	defer func() { span.end(ctx, err) }()
+
+	err = h.Access.AuthorizeCommissioner(ctx, cmd.Actor, cmd.TeamID)
+	if err != nil {
+		return err
+	}
+
+	return handleForcedMove(ctx, h.Store, h.Lock, cmd, domain.OverrideForcedRemove, domain.RosterView.DecideRemovePlayer)
+}
+
+func NewForceRemovePlayerHandler(store ports.RosterStore, lock ports.LeagueLock, access Access) ForceRemovePlayerHandler {
+	return ForceRemovePlayerHandler{
+		Store:  store,
+		Lock:   lock,
+		Access: access,
+	}
+}
+
+type ForceRosterMoveCommand struct {
+	TeamID   domain.TeamID
+	PlayerID domain.PlayerID
+	Actor    domain.UserID
+	Reason   string
+}
+
+func NewForceRosterMoveCommand(teamID domain.TeamID, playerID domain.PlayerID, actor domain.UserID, reason string) ForceRosterMoveCommand {
+	return ForceRosterMoveCommand{
+		TeamID:   teamID,
+		PlayerID: playerID,
+		Actor:    actor,
+		Reason:   reason,
+	}
+}
+
+func handleForcedMove(
+	ctx context.Context,
+	store ports.RosterStore,
+	lock ports.LeagueLock,
+	cmd ForceRosterMoveCommand,
+	kind domain.OverrideKind,
+	decide func(domain.RosterView, domain.PlayerID) ([]domain.RosterEvent, error),
+) error {
+	err := validateOverrideReason(cmd.Reason)
+	if err != nil {
+		return err
+	}
+
+	committed, version, err := store.Load(ctx, cmd.TeamID)
+	if err != nil {
+		return err
+	}
+
+	recordLoaded(ctx, version)
+
+	stream := NewRosterStream(cmd.TeamID, committed)
+	effective := lock.LastLock(ctx)
+
+	events, err := decideOverride(ctx, store, stream, effective, func(rv domain.RosterView) ([]domain.RosterEvent, error) {
+		return decide(rv, cmd.PlayerID)
+	})
+	if err != nil {
+		return err
+	}
+
+	events = append(events, domain.RecordedRosterOverride{
+		TeamID:      cmd.TeamID,
+		Actor:       cmd.Actor,
+		Kind:        kind,
+		Reason:      cmd.Reason,
+		EffectiveAt: effective,
+	})
+
+	_, err = store.Append(ctx, cmd.TeamID, events, version)
+	if err != nil {
+		return err
+	}
+
+	recordAppended(ctx, events)
+
+	return nil
+}
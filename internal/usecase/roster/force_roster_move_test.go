@@ -0,0 +1,161 @@
+package roster_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/roster"
+)
+
+func TestForceAddPlayerHandler_Handle(t *testing.T) {
+	testCases := []struct {
+		name     string
+		playerID domain.PlayerID
+		history  []domain.RosterEvent
+		reason   string
+		wantErr  error
+	}{
+		{
+			name:     "forced add takes effect at the last lock",
+			playerID: 1,
+			history:  nil,
+			reason:   "waiver processed late",
+		},
+		{
+			name:     "forced add still respects the roster limit",
+			playerID: domain.MaxRosterSize + 1,
+			history:  generateRosterHistory(testkit.TeamA(), domain.MaxRosterSize),
+			reason:   "waiver processed late",
+			wantErr:  domain.ErrRosterFull,
+		},
+		{
+			name:     "forced add conflicting with a pending add is rejected",
+			playerID: 1,
+			history: []domain.RosterEvent{
+				domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 1, EffectiveAt: testkit.TomorrowLock()},
+			},
+			reason:  "waiver processed late",
+			wantErr: domain.ErrPlayerAlreadyOnRoster,
+		},
+		{
+			name:     "forced add without a reason is rejected",
+			playerID: 1,
+			reason:   "",
+			wantErr:  domain.ErrOverrideReasonRequired,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lock := testkit.NewStubLeagueLock()
+			store := testkit.NewFakeRosterStore()
+			spy := testkit.NewSpyRosterStore(store)
+
+			store.SeedEvents(testkit.TeamA(), tc.history)
+
+			players := testkit.NewFakePlayerRepository()
+			players.SeedPlayerIDs(tc.playerID)
+
+			handler := roster.NewForceAddPlayerHandler(spy, lock, players, inSeasonAccess())
+			cmd := roster.NewForceRosterMoveCommand(testkit.TeamA(), tc.playerID, testkit.Commissioner(), tc.reason)
+
+			err := handler.Handle(t.Context(), cmd)
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Equal(t, len(spy.AppendCalls), 0)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, len(spy.AppendCalls), 1)
+
+			appendCall := spy.AppendCalls[0]
+			assert.Equal(t, appendCall.Version, ports.Version(len(tc.history)))
+			require.Equal(t, len(appendCall.Events), 2)
+
+			added, ok := appendCall.Events[0].(domain.AddedPlayerToRoster)
+			require.True(t, ok)
+			assert.Equal(t, added.PlayerID, tc.playerID)
+			assert.Equal(t, added.EffectiveAt, lock.LastLock(t.Context()))
+
+			override, ok := appendCall.Events[1].(domain.RecordedRosterOverride)
+			require.True(t, ok)
+			assert.Equal(t, override.Kind, domain.OverrideForcedAdd)
+			assert.Equal(t, override.Actor, testkit.Commissioner())
+			assert.Equal(t, override.EffectiveAt, lock.LastLock(t.Context()))
+		})
+	}
+}
+
+func TestForceAddPlayerHandler_UnknownPlayer(t *testing.T) {
+	spy := testkit.NewSpyRosterStore(testkit.NewFakeRosterStore())
+	handler := roster.NewForceAddPlayerHandler(spy, testkit.NewStubLeagueLock(), testkit.NewFakePlayerRepository(), inSeasonAccess())
+
+	err := handler.Handle(t.Context(), roster.NewForceRosterMoveCommand(testkit.TeamA(), 1, testkit.Commissioner(), "waiver processed late"))
+
+	assert.ErrorIs(t, err, ports.ErrPlayerNotFound)
+	assert.Equal(t, len(spy.LoadCalls), 0)
+	assert.Equal(t, len(spy.AppendCalls), 0)
+}
+
+func TestForceRemovePlayerHandler_Handle(t *testing.T) {
+	testCases := []struct {
+		name     string
+		playerID domain.PlayerID
+		history  []domain.RosterEvent
+		wantErr  error
+	}{
+		{
+			name:     "forced removal takes effect at the last lock",
+			playerID: 1,
+			history:  generateRosterHistory(testkit.TeamA(), 1),
+		},
+		{
+			name:     "forced removal of a player not on the roster is rejected",
+			playerID: 2,
+			history:  generateRosterHistory(testkit.TeamA(), 1),
+			wantErr:  domain.ErrPlayerNotOnRoster,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lock := testkit.NewStubLeagueLock()
+			store := testkit.NewFakeRosterStore()
+			spy := testkit.NewSpyRosterStore(store)
+
+			store.SeedEvents(testkit.TeamA(), tc.history)
+
+			handler := roster.NewForceRemovePlayerHandler(spy, lock, inSeasonAccess())
+			cmd := roster.NewForceRosterMoveCommand(testkit.TeamA(), tc.playerID, testkit.Commissioner(), "player suspended")
+
+			err := handler.Handle(t.Context(), cmd)
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Equal(t, len(spy.AppendCalls), 0)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, len(spy.AppendCalls), 1)
+
+			appendCall := spy.AppendCalls[0]
+			require.Equal(t, len(appendCall.Events), 2)
+
+			removed, ok := appendCall.Events[0].(domain.RemovedPlayerFromRoster)
+			require.True(t, ok)
+			assert.Equal(t, removed.PlayerID, tc.playerID)
+			assert.Equal(t, removed.EffectiveAt, lock.LastLock(t.Context()))
+
+			override, ok := appendCall.Events[1].(domain.RecordedRosterOverride)
+			require.True(t, ok)
+			assert.Equal(t, override.Kind, domain.OverrideForcedRemove)
+		})
+	}
+}
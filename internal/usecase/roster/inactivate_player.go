@@ -0,0 +1,88 @@
+package roster
+
+import (
+	"context"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+type InactivatePlayerHandler struct {
+	Store  ports.RosterStore
+	Lock   ports.LeagueLock
+	Access Access
+}
+
+func (h InactivatePlayerHandler) Handle(ctx context.Context, cmd InactivatePlayerCommand) (err error) {
+	ctx, span := startCommand(ctx, "InactivatePlayer", cmd.TeamID, cmd.PlayerID)
+	defer func() { span.end(ctx, err) }()
+
+	rules, err := h.Access.authorizeRosterChange(ctx, cmd.Actor, cmd.TeamID)
+	if err != nil {
+		return err
+	}
+
+	effective, err := targetLock(ctx, h.Lock, cmd.At)
+	if err != nil {
+		return err
+	}
+
+	committed, version, err := h.Store.Load(ctx, cmd.TeamID)
+	if err != nil {
+		return err
+	}
+
+	recordLoaded(ctx, version)
+
+	stream := NewRosterStream(cmd.TeamID, committed)
+
+	events, err := DecideAcross(ctx, h.Store, stream, effective, rules, func(rv domain.RosterView) ([]domain.RosterEvent, error) {
+		return rv.DecideInactivatePlayer(cmd.PlayerID)
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = h.Store.Append(ctx, cmd.TeamID, events, version)
+	if err != nil {
+		return err
+	}
+
+	recordAppended(ctx, events)
+
+	return nil
+}
+
+func NewInactivatePlayerHandler(store ports.RosterStore, lock ports.LeagueLock, access Access) InactivatePlayerHandler {
+	return InactivatePlayerHandler{
+		Store:  store,
+		Lock:   lock,
+		Access: access,
+	}
+}
+
+// InactivatePlayerCommand takes effect at the next lock, or at the first lock at or after
+// At when it is set.
+type InactivatePlayerCommand struct {
+	TeamID   domain.TeamID
+	PlayerID domain.PlayerID
+	Actor    domain.UserID
+	At       time.Time
+}
+
+func NewInactivatePlayerCommand(teamID domain.TeamID, playerID domain.PlayerID, actor domain.UserID) InactivatePlayerCommand {
+	return InactivatePlayerCommand{
+		TeamID:   teamID,
+		PlayerID: playerID,
+		Actor:    actor,
+	}
+}
+
+// NewScheduledInactivatePlayerCommand queues the move for the first lock at or after at.
+func NewScheduledInactivatePlayerCommand(teamID domain.TeamID, playerID domain.PlayerID, actor domain.UserID, at time.Time) InactivatePlayerCommand {
+	cmd := NewInactivatePlayerCommand(teamID, playerID, actor)
+	cmd.At = at
+
+	return cmd
+}
@@ -0,0 +1,59 @@
+package roster_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/roster"
+)
+
+func TestInactivatePlayerHandler_Handle(t *testing.T) {
+	testCases := []struct {
+		name    string
+		history []domain.RosterEvent
+		wantErr error
+	}{
+		{
+			name: "active player appends InactivatedPlayerOnRoster event",
+			history: []domain.RosterEvent{
+				domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 1, Eligibility: domain.MustRoleSet(domain.RoleHitter), EffectiveAt: testkit.TodayLock()},
+				domain.ActivatedPlayerOnRoster{TeamID: testkit.TeamA(), PlayerID: 1, PlayerRole: domain.RoleHitter, EffectiveAt: testkit.TodayLock()},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "inactive player returns error and does not append",
+			history: []domain.RosterEvent{
+				domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 1, Eligibility: domain.MustRoleSet(domain.RoleHitter), EffectiveAt: testkit.TodayLock()},
+			},
+			wantErr: domain.ErrPlayerAlreadyInactive,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := testkit.NewFakeRosterStore()
+			spy := testkit.NewSpyRosterStore(store)
+			store.SeedEvents(testkit.TeamA(), tc.history)
+
+			handler := roster.NewInactivatePlayerHandler(spy, testkit.NewStubLeagueLock(), inSeasonAccess())
+
+			err := handler.Handle(t.Context(), roster.NewInactivatePlayerCommand(testkit.TeamA(), 1, testkit.ManagerA()))
+
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+
+				require.Equal(t, len(spy.AppendCalls), 1)
+				require.Equal(t, len(spy.AppendCalls[0].Events), 1)
+				_, ok := spy.AppendCalls[0].Events[0].(domain.InactivatedPlayerOnRoster)
+				assert.True(t, ok)
+			} else {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Equal(t, len(spy.AppendCalls), 0)
+			}
+		})
+	}
+}
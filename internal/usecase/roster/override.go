@@ -0,0 +1,40 @@
+package roster
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// decideOverride runs decide against the view at effective and every later view,
+// so the compensating events cannot leave a later projection in a state the
+// normal commands would have rejected. Overrides are not held to the league's
+// transaction limits.
+func decideOverride(
+	ctx context.Context,
+	store ports.RosterStore,
+	stream *RosterStream,
+	effective time.Time,
+	decide func(domain.RosterView) ([]domain.RosterEvent, error),
+) ([]domain.RosterEvent, error) {
+	return DecideAcross(ctx, store, stream, effective, domain.TransactionRules{}, decide)
+}
+
+func validateOverrideReason(reason string) error {
+	if strings.TrimSpace(reason) == "" {
+		return domain.ErrOverrideReasonRequired
+	}
+
+	return nil
+}
+
+func laterOf(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+
+	return b
+}
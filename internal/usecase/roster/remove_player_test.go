@@ -0,0 +1,63 @@
+package roster_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/roster"
+)
+
+func TestRemovePlayerHandler_Handle(t *testing.T) {
+	testCases := []struct {
+		name     string
+		playerID domain.PlayerID
+		history  []domain.RosterEvent
+		wantErr  error
+	}{
+		{
+			name:     "player on roster appends RemovedPlayerFromRoster event",
+			playerID: 1,
+			history:  generateRosterHistory(testkit.TeamA(), 1),
+			wantErr:  nil,
+		},
+		{
+			name:     "player not on roster returns error and does not append",
+			playerID: 2,
+			history:  generateRosterHistory(testkit.TeamA(), 1),
+			wantErr:  domain.ErrPlayerNotOnRoster,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := testkit.NewFakeRosterStore()
+			spy := testkit.NewSpyRosterStore(store)
+			store.SeedEvents(testkit.TeamA(), tc.history)
+
+			handler := roster.NewRemovePlayerHandler(spy, testkit.NewStubLeagueLock(), inSeasonAccess())
+
+			err := handler.Handle(t.Context(), roster.NewRemovePlayerCommand(testkit.TeamA(), tc.playerID, testkit.ManagerA()))
+
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+
+				require.Equal(t, len(spy.AppendCalls), 1)
+				appendCall := spy.AppendCalls[0]
+				assert.Equal(t, appendCall.Version, ports.Version(len(tc.history)))
+
+				require.Equal(t, len(appendCall.Events), 1)
+				ev, ok := appendCall.Events[0].(domain.RemovedPlayerFromRoster)
+				require.True(t, ok)
+				assert.Equal(t, ev.PlayerID, tc.playerID)
+				assert.Equal(t, ev.EffectiveAt, handler.Lock.NextLock(t.Context()))
+			} else {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Equal(t, len(spy.AppendCalls), 0)
+			}
+		})
+	}
+}
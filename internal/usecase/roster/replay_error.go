@@ -0,0 +1,31 @@
+package roster
+
+import (
+	"fmt"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+)
+
+// ReplayError reports the event a stream could not be replayed past. Sequence
+// is zero for a pending event. It matches eventlog.ErrUnreplayableEvent as well
+// as Err, so callers can tell a corrupt stream from a rejected command even when
+// Err is a domain error such as ErrPlayerNotOnRoster.
+type ReplayError struct {
+	TeamID   domain.TeamID
+	Sequence eventlog.Sequence
+	Event    domain.RosterEvent
+	Err      error
+}
+
+func (e *ReplayError) Error() string {
+	if e.Sequence == 0 {
+		return fmt.Sprintf("%v: team %v, pending %T: %v", eventlog.ErrUnreplayableEvent, e.TeamID, e.Event, e.Err)
+	}
+
+	return fmt.Sprintf("%v: team %v, sequence %v (%T): %v", eventlog.ErrUnreplayableEvent, e.TeamID, e.Sequence, e.Event, e.Err)
+}
+
+func (e *ReplayError) Unwrap() []error {
+	return []error{eventlog.ErrUnreplayableEvent, e.Err}
+}
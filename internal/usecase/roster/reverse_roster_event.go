@@ -0,0 +1,109 @@
+package roster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// ReverseRosterEventHandler appends compensating events that undo a recorded roster
+// event, leaving the original in place.
+//
+// The reversal takes effect at the later of the original event and the last lock.
+// Only commissioners of the team's league may reverse events.
+type ReverseRosterEventHandler struct {
+	Store  ports.RosterStore
+	Lock   ports.LeagueLock
+	Access Access
+}
+
+func (h ReverseRosterEventHandler) Handle(ctx context.Context, cmd ReverseRosterEventCommand) (err error) {
+	ctx, span := startCommand(ctx, "ReverseRosterEvent", cmd.TeamID, 0)
+	defer func() { span.end(ctx, err) }()
+
+	err = h.Access.AuthorizeCommissioner(ctx, cmd.Actor, cmd.TeamID)
+	if err != nil {
+		return err
+	}
+
+	err = validateOverrideReason(cmd.Reason)
+	if err != nil {
+		return err
+	}
+
+	committed, version, err := h.Store.Load(ctx, cmd.TeamID)
+	if err != nil {
+		return err
+	}
+
+	recordLoaded(ctx, version)
+
+	stream := NewRosterStream(cmd.TeamID, committed)
+
+	target, ok := stream.Find(cmd.Sequence)
+	if !ok {
+		return fmt.Errorf("%w: team %v, sequence %v", eventlog.ErrRecordedEventNotFound, cmd.TeamID, cmd.Sequence)
+	}
+
+	if stream.Reversed(cmd.Sequence) {
+		return fmt.Errorf("%w: sequence %v", domain.ErrRosterEventAlreadyReversed, cmd.Sequence)
+	}
+
+	effective := laterOf(target.Event.OccurredAt(), h.Lock.LastLock(ctx))
+	before, err := ProjectBefore(ctx, h.Store, stream, cmd.Sequence, target.Event.OccurredAt())
+	if err != nil {
+		return err
+	}
+
+	events, err := decideOverride(ctx, h.Store, stream, effective, func(rv domain.RosterView) ([]domain.RosterEvent, error) {
+		return rv.DecideReverse(target.Event, before)
+	})
+	if err != nil {
+		return err
+	}
+
+	events = append(events, domain.RecordedRosterOverride{
+		TeamID:      cmd.TeamID,
+		Actor:       cmd.Actor,
+		Kind:        domain.OverrideReversal,
+		Reason:      cmd.Reason,
+		Reverses:    cmd.Sequence,
+		EffectiveAt: effective,
+	})
+
+	_, err = h.Store.Append(ctx, cmd.TeamID, events, version)
+	if err != nil {
+		return err
+	}
+
+	recordAppended(ctx, events)
+
+	return nil
+}
+
+func NewReverseRosterEventHandler(store ports.RosterStore, lock ports.LeagueLock, access Access) ReverseRosterEventHandler {
+	return ReverseRosterEventHandler{
+		Store:  store,
+		Lock:   lock,
+		Access: access,
+	}
+}
+
+type ReverseRosterEventCommand struct {
+	TeamID   domain.TeamID
+	Sequence eventlog.Sequence
+	Actor    domain.UserID
+	Reason   string
+}
+
+func NewReverseRosterEventCommand(teamID domain.TeamID, seq eventlog.Sequence, actor domain.UserID, reason string) ReverseRosterEventCommand {
+	return ReverseRosterEventCommand{
+		TeamID:   teamID,
+		Sequence: seq,
+		Actor:    actor,
+		Reason:   reason,
+	}
+}
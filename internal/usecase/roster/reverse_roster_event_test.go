@@ -0,0 +1,155 @@
+package roster_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/roster"
+)
+
+func TestReverseRosterEventHandler_Handle(t *testing.T) {
+	testCases := []struct {
+		name          string
+		history       []domain.RosterEvent
+		sequence      eventlog.Sequence
+		reason        string
+		wantEvent     domain.RosterEvent
+		wantEffective time.Time
+		wantErr       error
+	}{
+		{
+			name: "reversing a locked add appends a removal and override at the last lock",
+			history: []domain.RosterEvent{
+				domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 1, EffectiveAt: testkit.TodayLock()},
+			},
+			sequence:      1,
+			reason:        "added the wrong player",
+			wantEvent:     domain.RemovedPlayerFromRoster{TeamID: testkit.TeamA(), PlayerID: 1, EffectiveAt: testkit.TodayLock()},
+			wantEffective: testkit.TodayLock(),
+		},
+		{
+			name: "reversing a pending add takes effect at the original lock",
+			history: []domain.RosterEvent{
+				domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 1, EffectiveAt: testkit.TomorrowLock()},
+			},
+			sequence:      1,
+			reason:        "added the wrong player",
+			wantEvent:     domain.RemovedPlayerFromRoster{TeamID: testkit.TeamA(), PlayerID: 1, EffectiveAt: testkit.TomorrowLock()},
+			wantEffective: testkit.TomorrowLock(),
+		},
+		{
+			name: "reversing an inactivation restores the player's prior role",
+			history: []domain.RosterEvent{
+				domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 1, Eligibility: domain.MustRoleSet(domain.RolePitcher), EffectiveAt: testkit.TodayLock()},
+				domain.ActivatedPlayerOnRoster{TeamID: testkit.TeamA(), PlayerID: 1, PlayerRole: domain.RolePitcher, EffectiveAt: testkit.TodayLock()},
+				domain.InactivatedPlayerOnRoster{TeamID: testkit.TeamA(), PlayerID: 1, EffectiveAt: testkit.TodayLock()},
+			},
+			sequence:      3,
+			reason:        "benched by mistake",
+			wantEvent:     domain.ActivatedPlayerOnRoster{TeamID: testkit.TeamA(), PlayerID: 1, PlayerRole: domain.RolePitcher, EffectiveAt: testkit.TodayLock()},
+			wantEffective: testkit.TodayLock(),
+		},
+		{
+			name: "blank reason is rejected",
+			history: []domain.RosterEvent{
+				domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 1, EffectiveAt: testkit.TodayLock()},
+			},
+			sequence: 1,
+			reason:   "  ",
+			wantErr:  domain.ErrOverrideReasonRequired,
+		},
+		{
+			name: "unknown sequence is rejected",
+			history: []domain.RosterEvent{
+				domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 1, EffectiveAt: testkit.TodayLock()},
+			},
+			sequence: 2,
+			reason:   "typo",
+			wantErr:  eventlog.ErrRecordedEventNotFound,
+		},
+		{
+			name: "event that was already reversed is rejected",
+			history: []domain.RosterEvent{
+				domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 1, EffectiveAt: testkit.TodayLock()},
+				domain.RemovedPlayerFromRoster{TeamID: testkit.TeamA(), PlayerID: 1, EffectiveAt: testkit.TodayLock()},
+				domain.RecordedRosterOverride{TeamID: testkit.TeamA(), Kind: domain.OverrideReversal, Reason: "typo", Reverses: 1, EffectiveAt: testkit.TodayLock()},
+			},
+			sequence: 1,
+			reason:   "typo",
+			wantErr:  domain.ErrRosterEventAlreadyReversed,
+		},
+		{
+			name: "reversal that would break the next lock's roster is rejected",
+			history: []domain.RosterEvent{
+				domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 1, EffectiveAt: testkit.TodayLock()},
+				domain.RemovedPlayerFromRoster{TeamID: testkit.TeamA(), PlayerID: 1, EffectiveAt: testkit.TomorrowLock()},
+			},
+			sequence: 1,
+			reason:   "typo",
+			wantErr:  domain.ErrPlayerNotOnRoster,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := testkit.NewFakeRosterStore()
+			spy := testkit.NewSpyRosterStore(store)
+
+			store.SeedEvents(testkit.TeamA(), tc.history)
+
+			handler := roster.NewReverseRosterEventHandler(spy, testkit.NewStubLeagueLock(), inSeasonAccess())
+			cmd := roster.NewReverseRosterEventCommand(testkit.TeamA(), tc.sequence, testkit.Commissioner(), tc.reason)
+
+			err := handler.Handle(t.Context(), cmd)
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Equal(t, len(spy.AppendCalls), 0)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, len(spy.AppendCalls), 1)
+
+			appendCall := spy.AppendCalls[0]
+			assert.Equal(t, appendCall.Version, ports.Version(len(tc.history)))
+			require.Equal(t, len(appendCall.Events), 2)
+			assert.Equal(t, appendCall.Events[0], tc.wantEvent)
+
+			override, ok := appendCall.Events[1].(domain.RecordedRosterOverride)
+			require.True(t, ok)
+			assert.Equal(t, override.Actor, testkit.Commissioner())
+			assert.Equal(t, override.Kind, domain.OverrideReversal)
+			assert.Equal(t, override.Reason, tc.reason)
+			assert.Equal(t, override.Reverses, tc.sequence)
+			assert.Equal(t, override.EffectiveAt, tc.wantEffective)
+		})
+	}
+
+	failureTestCases := []struct {
+		name    string
+		store   ports.RosterStore
+		wantErr error
+	}{
+		{
+			name:    "load returns error, handle returns error",
+			store:   &testkit.FailingLoadRosterStore{},
+			wantErr: testkit.ErrFailingLoad,
+		},
+	}
+
+	for _, tc := range failureTestCases {
+		handler := roster.NewReverseRosterEventHandler(tc.store, testkit.NewStubLeagueLock(), inSeasonAccess())
+		cmd := roster.NewReverseRosterEventCommand(testkit.TeamA(), 1, testkit.Commissioner(), "typo")
+
+		err := handler.Handle(t.Context(), cmd)
+
+		assert.ErrorIs(t, err, tc.wantErr)
+	}
+}
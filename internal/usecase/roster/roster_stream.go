@@ -28,43 +28,215 @@ func (rs *RosterStream) Stage(events ...domain.RosterEvent) error {
 	return nil
 }
 
+// ProjectThrough builds the view as of through from the committed and pending
+// events in the order they take effect. Events taking effect at the same lock
+// apply in the order they were recorded, pending ones last.
+//
+// Panics if the stream cannot be replayed; see TryProjectThrough.
 func (rs RosterStream) ProjectThrough(through time.Time) domain.RosterView {
+	return mustProject(rs.TryProjectThrough(through))
+}
+
+// TryProjectThrough is ProjectThrough, returning a *ReplayError instead of
+// panicking when a sequence is duplicated or an event breaks a roster invariant.
+func (rs RosterStream) TryProjectThrough(through time.Time) (domain.RosterView, error) {
 	rv := domain.RosterView{
 		TeamID:           rs.TeamID,
 		EffectiveThrough: through,
 	}
 
-	sortedCommitted := orderEventsByUniqueSequence(rs.Committed)
+	sortedCommitted, err := rs.sortedCommitted()
+	if err != nil {
+		return domain.RosterView{}, err
+	}
 
-	applyThrough(&rv, through, extractEvents(sortedCommitted))
-	applyThrough(&rv, through, rs.Pending)
+	err = rs.applyThrough(&rv, through, effectiveOrder(append(sortedCommitted, pendingRecords(rs.Pending)...)))
+	if err != nil {
+		return domain.RosterView{}, err
+	}
 
-	return rv
+	return rv, nil
 }
 
-func NewRosterStream(id domain.TeamID, committed []eventlog.Recorded[domain.RosterEvent]) *RosterStream {
-	return &RosterStream{
-		TeamID:    id,
-		Committed: committed,
-	}
+// ProjectBefore builds a view from the committed events recorded strictly before seq,
+// in the order they take effect, ignoring pending events.
+//
+// Panics if the stream cannot be replayed; see TryProjectBefore.
+func (rs RosterStream) ProjectBefore(seq eventlog.Sequence, through time.Time) domain.RosterView {
+	return mustProject(rs.TryProjectBefore(seq, through))
 }
 
-func orderEventsByUniqueSequence(recordedEvents []eventlog.Recorded[domain.RosterEvent]) []eventlog.Recorded[domain.RosterEvent] {
-	assertUniqueSequences(recordedEvents)
+// TryProjectBefore is ProjectBefore, returning a *ReplayError instead of
+// panicking.
+func (rs RosterStream) TryProjectBefore(seq eventlog.Sequence, through time.Time) (domain.RosterView, error) {
+	rv := domain.RosterView{
+		TeamID:           rs.TeamID,
+		EffectiveThrough: through,
+	}
+
+	sortedCommitted, err := rs.sortedCommitted()
+	if err != nil {
+		return domain.RosterView{}, err
+	}
+
+	var prior []eventlog.Recorded[domain.RosterEvent]
+	for _, re := range sortedCommitted {
+		if re.Sequence >= seq {
+			break
+		}
+		prior = append(prior, re)
+	}
+
+	err = rs.applyThrough(&rv, through, effectiveOrder(prior))
+	if err != nil {
+		return domain.RosterView{}, err
+	}
 
-	return sortBySequence(recordedEvents)
+	return rv, nil
 }
 
-func assertUniqueSequences(recordedEvents []eventlog.Recorded[domain.RosterEvent]) {
-	seen := make(map[eventlog.Sequence]struct{}, len(recordedEvents))
-	for _, ev := range recordedEvents {
-		if _, ok := seen[ev.Sequence]; !ok {
-			seen[ev.Sequence] = struct{}{}
+// LocksAfter returns the distinct locks after t at which committed events take
+// effect, in time order.
+func (rs RosterStream) LocksAfter(t time.Time) []time.Time {
+	var locks []time.Time
+	for _, re := range rs.Committed {
+		at := re.Event.OccurredAt()
+		if !at.After(t) || slices.ContainsFunc(locks, at.Equal) {
 			continue
 		}
 
-		panic(fmt.Errorf("%w: %v", eventlog.ErrDuplicateRecordedEventSequence, ev.Sequence))
+		locks = append(locks, at)
 	}
+
+	slices.SortFunc(locks, time.Time.Compare)
+
+	return locks
+}
+
+// Find returns the committed event recorded at seq.
+func (rs RosterStream) Find(seq eventlog.Sequence) (eventlog.Recorded[domain.RosterEvent], bool) {
+	for _, re := range rs.Committed {
+		if re.Sequence == seq {
+			return re, true
+		}
+	}
+
+	return eventlog.Recorded[domain.RosterEvent]{}, false
+}
+
+// Reversed reports whether a committed override has already reversed the event at seq.
+func (rs RosterStream) Reversed(seq eventlog.Sequence) bool {
+	for _, re := range rs.Committed {
+		ov, ok := re.Event.(domain.RecordedRosterOverride)
+		if ok && ov.Kind == domain.OverrideReversal && ov.Reverses == seq {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TallyStats totals the stats credited to the team, crediting each game against
+// the lineup projected through that game's lock.
+func (rs RosterStream) TallyStats(games []domain.GameStats) domain.StatTotals {
+	return rs.Tally(games, domain.UsageCaps{}).Totals
+}
+
+// Tally counts games against the lineup projected through each game's lock,
+// stopping each slot at its cap. Games are counted in lock order, so the cap is
+// reached by the earliest games.
+//
+// Panics if the stream cannot be replayed; see TryTally.
+func (rs RosterStream) Tally(games []domain.GameStats, caps domain.UsageCaps) domain.Tally {
+	tally, err := rs.TryTally(games, caps)
+	if err != nil {
+		panic(err)
+	}
+
+	return tally
+}
+
+// TryTally is Tally, returning a *ReplayError instead of panicking.
+func (rs RosterStream) TryTally(games []domain.GameStats, caps domain.UsageCaps) (domain.Tally, error) {
+	ordered := slices.Clone(games)
+	slices.SortStableFunc(ordered, func(a, b domain.GameStats) int {
+		return a.Lock.Compare(b.Lock)
+	})
+
+	views := make(map[time.Time]domain.RosterView)
+
+	tally := domain.NewTally(caps)
+	for _, g := range ordered {
+		view, ok := views[g.Lock]
+		if !ok {
+			var err error
+			view, err = rs.TryProjectThrough(g.Lock)
+			if err != nil {
+				return domain.Tally{}, err
+			}
+			views[g.Lock] = view
+		}
+
+		tally.Count(view, g)
+	}
+
+	return tally, nil
+}
+
+// PlayerIDs returns every player the team has ever rostered, in the order they
+// were first added.
+//
+// Panics if a sequence is duplicated; see TryPlayerIDs.
+func (rs RosterStream) PlayerIDs() []domain.PlayerID {
+	ids, err := rs.TryPlayerIDs()
+	if err != nil {
+		panic(err)
+	}
+
+	return ids
+}
+
+// TryPlayerIDs is PlayerIDs, returning a *ReplayError instead of panicking.
+func (rs RosterStream) TryPlayerIDs() ([]domain.PlayerID, error) {
+	sortedCommitted, err := rs.sortedCommitted()
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []domain.PlayerID
+	for _, re := range sortedCommitted {
+		ev, ok := re.Event.(domain.AddedPlayerToRoster)
+		if ok && !slices.Contains(ids, ev.PlayerID) {
+			ids = append(ids, ev.PlayerID)
+		}
+	}
+
+	return ids, nil
+}
+
+func NewRosterStream(id domain.TeamID, committed []eventlog.Recorded[domain.RosterEvent]) *RosterStream {
+	return &RosterStream{
+		TeamID:    id,
+		Committed: committed,
+	}
+}
+
+// sortedCommitted returns the committed events in sequence order, or a
+// *ReplayError for the first sequence that is recorded twice.
+func (rs RosterStream) sortedCommitted() ([]eventlog.Recorded[domain.RosterEvent], error) {
+	sorted := sortBySequence(rs.Committed)
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].Sequence == sorted[i-1].Sequence {
+			return nil, &ReplayError{
+				TeamID:   rs.TeamID,
+				Sequence: sorted[i].Sequence,
+				Event:    sorted[i].Event,
+				Err:      eventlog.ErrDuplicateRecordedEventSequence,
+			}
+		}
+	}
+
+	return sorted, nil
 }
 
 func sortBySequence(recordedEvents []eventlog.Recorded[domain.RosterEvent]) []eventlog.Recorded[domain.RosterEvent] {
@@ -77,21 +249,52 @@ func sortBySequence(recordedEvents []eventlog.Recorded[domain.RosterEvent]) []ev
 	return sorted
 }
 
-func extractEvents(recordedEvents []eventlog.Recorded[domain.RosterEvent]) []domain.RosterEvent {
-	extracted := make([]domain.RosterEvent, len(recordedEvents))
-	for i, re := range recordedEvents {
-		extracted[i] = re.Event
+// effectiveOrder sorts records, already in the order they were recorded, by the
+// lock they take effect at. The sort is stable, so records taking effect at the
+// same lock keep their recorded order.
+func effectiveOrder(records []eventlog.Recorded[domain.RosterEvent]) []eventlog.Recorded[domain.RosterEvent] {
+	slices.SortStableFunc(records, func(a, b eventlog.Recorded[domain.RosterEvent]) int {
+		return a.Event.OccurredAt().Compare(b.Event.OccurredAt())
+	})
+
+	return records
+}
+
+// pendingRecords wraps pending events for applyThrough. They have no sequence
+// yet, so a ReplayError for one reports sequence zero.
+func pendingRecords(events []domain.RosterEvent) []eventlog.Recorded[domain.RosterEvent] {
+	records := make([]eventlog.Recorded[domain.RosterEvent], len(events))
+	for i, ev := range events {
+		records[i] = eventlog.Recorded[domain.RosterEvent]{Event: ev}
 	}
 
-	return extracted
+	return records
 }
 
-func applyThrough(rv *domain.RosterView, through time.Time, events []domain.RosterEvent) {
-	for _, ev := range events {
-		if ev.OccurredAt().After(through) {
+func (rs RosterStream) applyThrough(rv *domain.RosterView, through time.Time, recordedEvents []eventlog.Recorded[domain.RosterEvent]) error {
+	for _, re := range recordedEvents {
+		if re.Event.OccurredAt().After(through) {
 			continue
 		}
 
-		rv.Apply(ev)
+		err := rv.TryApplyRecorded(re)
+		if err != nil {
+			return &ReplayError{
+				TeamID:   rs.TeamID,
+				Sequence: re.Sequence,
+				Event:    re.Event,
+				Err:      err,
+			}
+		}
+	}
+
+	return nil
+}
+
+func mustProject(rv domain.RosterView, err error) domain.RosterView {
+	if err != nil {
+		panic(err)
 	}
+
+	return rv
 }
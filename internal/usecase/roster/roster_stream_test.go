@@ -1,6 +1,7 @@
 package roster_test
 
 import (
+	"errors"
 	"slices"
 	"testing"
 	"time"
@@ -354,3 +355,153 @@ func TestProjectThrough(t *testing.T) {
 		})
 	}
 }
+
+func TestTryProjectThrough(t *testing.T) {
+	hitters := domain.MustRoleSet(domain.RoleHitter)
+	added := domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 1, Eligibility: hitters, EffectiveAt: testkit.TodayLock()}
+	strayActivation := domain.ActivatedPlayerOnRoster{TeamID: testkit.TeamA(), PlayerID: 2, PlayerRole: domain.RoleHitter, EffectiveAt: testkit.TodayLock()}
+
+	testCases := []struct {
+		name      string
+		committed []eventlog.Recorded[domain.RosterEvent]
+		pending   []domain.RosterEvent
+		want      roster.ReplayError
+	}{
+		{
+			name: "reports a duplicated sequence",
+			committed: []eventlog.Recorded[domain.RosterEvent]{
+				{Sequence: 1, Event: added},
+				{Sequence: 1, Event: strayActivation},
+			},
+			want: roster.ReplayError{TeamID: testkit.TeamA(), Sequence: 1, Event: strayActivation, Err: eventlog.ErrDuplicateRecordedEventSequence},
+		},
+		{
+			name: "reports the committed event that breaks an invariant",
+			committed: []eventlog.Recorded[domain.RosterEvent]{
+				{Sequence: 1, Event: added},
+				{Sequence: 2, Event: strayActivation},
+			},
+			want: roster.ReplayError{TeamID: testkit.TeamA(), Sequence: 2, Event: strayActivation, Err: domain.ErrPlayerNotOnRoster},
+		},
+		{
+			name:      "reports a pending event at sequence zero",
+			committed: []eventlog.Recorded[domain.RosterEvent]{{Sequence: 1, Event: added}},
+			pending:   []domain.RosterEvent{added},
+			want:      roster.ReplayError{TeamID: testkit.TeamA(), Event: added, Err: domain.ErrPlayerAlreadyOnRoster},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rs := roster.RosterStream{TeamID: testkit.TeamA(), Committed: tc.committed, Pending: tc.pending}
+
+			_, err := rs.TryProjectThrough(testkit.TodayLock())
+
+			var replayErr *roster.ReplayError
+			require.True(t, errors.As(err, &replayErr))
+			assert.Equal(t, replayErr.TeamID, tc.want.TeamID)
+			assert.Equal(t, replayErr.Sequence, tc.want.Sequence)
+			assert.Equal(t, replayErr.Event, tc.want.Event)
+			assert.ErrorIs(t, err, tc.want.Err)
+			assert.ErrorIs(t, err, eventlog.ErrUnreplayableEvent)
+
+			require.Panics(t, func() { _ = rs.ProjectThrough(testkit.TodayLock()) })
+		})
+	}
+
+	t.Run("matches ProjectThrough on a sound stream", func(t *testing.T) {
+		rs := roster.RosterStream{
+			TeamID:    testkit.TeamA(),
+			Committed: []eventlog.Recorded[domain.RosterEvent]{{Sequence: 1, Event: added}},
+		}
+
+		got, err := rs.TryProjectThrough(testkit.TodayLock())
+
+		require.NoError(t, err)
+		assert.Equal(t, got, rs.ProjectThrough(testkit.TodayLock()))
+	})
+}
+
+func TestTallyStats(t *testing.T) {
+	twoWay := domain.MustRoleSet(domain.RoleHitter, domain.RolePitcher)
+
+	// Player 1 hits today and pitches tomorrow.
+	rs := roster.NewRosterStream(testkit.TeamA(), []eventlog.Recorded[domain.RosterEvent]{
+		{
+			Sequence: 1,
+			Event:    domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 1, Eligibility: twoWay, EffectiveAt: testkit.TodayLock()},
+		},
+		{
+			Sequence: 2,
+			Event:    domain.ActivatedPlayerOnRoster{TeamID: testkit.TeamA(), PlayerID: 1, PlayerRole: domain.RoleHitter, EffectiveAt: testkit.TodayLock()},
+		},
+		{
+			Sequence: 3,
+			Event:    domain.InactivatedPlayerOnRoster{TeamID: testkit.TeamA(), PlayerID: 1, EffectiveAt: testkit.TomorrowLock()},
+		},
+		{
+			Sequence: 4,
+			Event:    domain.ActivatedPlayerOnRoster{TeamID: testkit.TeamA(), PlayerID: 1, PlayerRole: domain.RolePitcher, EffectiveAt: testkit.TomorrowLock()},
+		},
+	})
+
+	games := []domain.GameStats{
+		{
+			PlayerID: 1,
+			Lock:     testkit.TodayLock(),
+			Hitting:  domain.HittingLine{AtBats: 4, Hits: 2, HomeRuns: 1},
+			Pitching: domain.PitchingLine{OutsRecorded: 3},
+		},
+		{
+			PlayerID: 1,
+			Lock:     testkit.TomorrowLock(),
+			Hitting:  domain.HittingLine{AtBats: 3, Hits: 1},
+			Pitching: domain.PitchingLine{OutsRecorded: 18, Strikeouts: 10, Wins: 1},
+		},
+	}
+
+	got := rs.TallyStats(games)
+
+	assert.Equal(t, got, domain.StatTotals{
+		Hitting:  domain.HittingLine{AtBats: 4, Hits: 2, HomeRuns: 1},
+		Pitching: domain.PitchingLine{OutsRecorded: 18, Strikeouts: 10, Wins: 1},
+	})
+}
+
+func TestTally(t *testing.T) {
+	rs := roster.NewRosterStream(testkit.TeamA(), []eventlog.Recorded[domain.RosterEvent]{
+		{
+			Sequence: 1,
+			Event:    domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 1, Eligibility: domain.MustRoleSet(domain.RoleHitter), EffectiveAt: testkit.TodayLock()},
+		},
+		{
+			Sequence: 2,
+			Event:    domain.ActivatedPlayerOnRoster{TeamID: testkit.TeamA(), PlayerID: 1, PlayerRole: domain.RoleHitter, EffectiveAt: testkit.TodayLock()},
+		},
+	})
+
+	// Listed out of order; the earlier game reaches the cap first.
+	games := []domain.GameStats{
+		{PlayerID: 1, Lock: testkit.TomorrowLock(), Hitting: domain.HittingLine{Hits: 3}},
+		{PlayerID: 1, Lock: testkit.TodayLock(), Hitting: domain.HittingLine{Hits: 1}},
+	}
+
+	got := rs.Tally(games, domain.UsageCaps{HitterGames: 1})
+
+	assert.Equal(t, got.Usage, domain.SlotUsage{HitterGames: 1})
+	assert.Equal(t, got.Totals.Hitting, domain.HittingLine{Hits: 1})
+	assert.Equal(t, rs.PlayerIDs(), []domain.PlayerID{1})
+}
+
+func TestLocksAfter(t *testing.T) {
+	later := testkit.TomorrowLock().AddDate(0, 0, 3)
+	rs := roster.NewRosterStream(testkit.TeamA(), []eventlog.Recorded[domain.RosterEvent]{
+		{Sequence: 1, Event: domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 1, EffectiveAt: testkit.TodayLock()}},
+		{Sequence: 2, Event: domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 2, EffectiveAt: later}},
+		{Sequence: 3, Event: domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 3, EffectiveAt: testkit.TomorrowLock()}},
+		{Sequence: 4, Event: domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 4, EffectiveAt: later}},
+	})
+
+	assert.Equal(t, rs.LocksAfter(testkit.TodayLock()), []time.Time{testkit.TomorrowLock(), later})
+	assert.Equal(t, len(rs.LocksAfter(later)), 0)
+}
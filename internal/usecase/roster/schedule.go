@@ -0,0 +1,166 @@
+package roster
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// targetLock returns the lock a move takes effect at: the next lock, unless the
+// manager asked for a later one. Requested times snap forward to a lock.
+func targetLock(ctx context.Context, lock ports.LeagueLock, requested time.Time) (time.Time, error) {
+	next := lock.NextLock(ctx)
+	if requested.IsZero() {
+		return next, nil
+	}
+
+	target := lock.LockAtOrAfter(ctx, requested)
+	if target.Before(next) {
+		return time.Time{}, fmt.Errorf("%w: %v is before the next lock at %v", domain.ErrLockPassed, target, next)
+	}
+
+	return target, nil
+}
+
+// DecideAcross runs decide against the view at effective. When moves are
+// already scheduled for later locks, it then replays the stream with the new
+// events in the order everything takes effect, and decides each scheduled move
+// again against the roster as it would stand at its lock. A scheduled move that
+// the new events would make invalid is a conflict; one the new events leave
+// alone is not, even if it would now fail for some other reason.
+func DecideAcross(
+	ctx context.Context,
+	store ports.RosterStore,
+	stream *RosterStream,
+	effective time.Time,
+	rules domain.TransactionRules,
+	decide func(domain.RosterView) ([]domain.RosterEvent, error),
+) ([]domain.RosterEvent, error) {
+	view, err := ProjectThrough(ctx, store, stream, effective)
+	if err != nil {
+		return nil, err
+	}
+	view.Rules = rules
+
+	events, err := decide(view)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(stream.LocksAfter(effective)) == 0 {
+		return events, nil
+	}
+
+	defer observeProjection(ctx, store, time.Now())
+
+	err = recheckScheduled(stream, effective, rules, events)
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// scheduledMove is a record in a recheck replay, marked when it is one of the
+// events being decided rather than one already in the stream.
+type scheduledMove struct {
+	eventlog.Recorded[domain.RosterEvent]
+	decided bool
+}
+
+// recheckScheduled replays the stream twice in step, without and with events,
+// and decides each committed move after effective against both. Moves that
+// commissioners forced or reversed, and players received in trades, are decided
+// without rules, as they were made.
+func recheckScheduled(stream *RosterStream, effective time.Time, rules domain.TransactionRules, events []domain.RosterEvent) error {
+	committed, err := stream.sortedCommitted()
+	if err != nil {
+		return err
+	}
+
+	overridden := make(map[eventlog.Sequence]bool)
+	for _, re := range committed {
+		switch re.Event.(type) {
+		case domain.RecordedRosterOverride, domain.ReceivedInTrade:
+			overridden[re.Sequence-1] = true
+		}
+	}
+
+	var moves []scheduledMove
+	for _, re := range committed {
+		moves = append(moves, scheduledMove{Recorded: re})
+	}
+	for _, re := range pendingRecords(stream.Pending) {
+		moves = append(moves, scheduledMove{Recorded: re})
+	}
+	for _, re := range pendingRecords(events) {
+		moves = append(moves, scheduledMove{Recorded: re, decided: true})
+	}
+	slices.SortStableFunc(moves, func(a, b scheduledMove) int {
+		return a.Event.OccurredAt().Compare(b.Event.OccurredAt())
+	})
+
+	without := domain.RosterView{TeamID: stream.TeamID}
+	with := domain.RosterView{TeamID: stream.TeamID}
+	for _, m := range moves {
+		at := m.Event.OccurredAt()
+		without.EffectiveThrough, with.EffectiveThrough = at, at
+
+		if m.decided {
+			err := with.TryApplyRecorded(m.Recorded)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if m.Sequence != 0 && at.After(effective) {
+			moveRules := rules
+			if overridden[m.Sequence] {
+				moveRules = domain.TransactionRules{}
+			}
+
+			err := redecide(with, moveRules, m.Event)
+			if err != nil && redecide(without, moveRules, m.Event) == nil {
+				return fmt.Errorf("%w: move at %v: %w", domain.ErrScheduledMoveConflict, at, err)
+			}
+		}
+
+		err := without.TryApplyRecorded(m.Recorded)
+		if err != nil {
+			return &ReplayError{TeamID: stream.TeamID, Sequence: m.Sequence, Event: m.Event, Err: err}
+		}
+
+		err = with.TryApplyRecorded(m.Recorded)
+		if err != nil {
+			return fmt.Errorf("%w: move at %v: %w", domain.ErrScheduledMoveConflict, at, err)
+		}
+	}
+
+	return nil
+}
+
+// redecide decides the move event records again against rv, under rules.
+// Annotations carry no decision of their own.
+func redecide(rv domain.RosterView, rules domain.TransactionRules, event domain.RosterEvent) error {
+	rv.Rules = rules
+
+	var err error
+	switch e := event.(type) {
+	case domain.AddedPlayerToRoster:
+		_, err = rv.DecideAddPlayer(e.PlayerID, e.EligibleRoles())
+	case domain.RemovedPlayerFromRoster:
+		_, err = rv.DecideRemovePlayer(e.PlayerID)
+	case domain.ActivatedPlayerOnRoster:
+		_, err = rv.DecideActivatePlayer(e.PlayerID, e.PlayerRole)
+	case domain.InactivatedPlayerOnRoster:
+		_, err = rv.DecideInactivatePlayer(e.PlayerID)
+	}
+
+	return err
+}
@@ -0,0 +1,134 @@
+package roster_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/roster"
+)
+
+func TestScheduledRosterMoves(t *testing.T) {
+	// Monday is two locks after the next one.
+	monday := testkit.TomorrowLock().AddDate(0, 0, 2)
+	hitter := domain.MustRoleSet(domain.RoleHitter)
+
+	// onRoster seeds player 1 on the roster from TodayLock, followed by later.
+	onRoster := func(later ...domain.RosterEvent) *testkit.FakeRosterStore {
+		store := testkit.NewFakeRosterStore()
+		store.SeedEvents(testkit.TeamA(), append([]domain.RosterEvent{
+			domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 1, Eligibility: hitter, EffectiveAt: testkit.TodayLock()},
+		}, later...))
+
+		return store
+	}
+
+	t.Run("scheduled move takes effect at the target lock", func(t *testing.T) {
+		spy := testkit.NewSpyRosterStore(onRoster())
+		handler := roster.NewActivatePlayerHandler(spy, testkit.NewStubLeagueLock(), inSeasonAccess())
+
+		err := handler.Handle(t.Context(), roster.NewScheduledActivatePlayerCommand(testkit.TeamA(), 1, domain.RoleHitter, testkit.ManagerA(), monday))
+		require.NoError(t, err)
+
+		require.Equal(t, len(spy.AppendCalls), 1)
+		assert.Equal(t, spy.AppendCalls[0].Events, []domain.RosterEvent{
+			domain.ActivatedPlayerOnRoster{TeamID: testkit.TeamA(), PlayerID: 1, PlayerRole: domain.RoleHitter, EffectiveAt: monday},
+		})
+	})
+
+	t.Run("requested time snaps forward to a lock", func(t *testing.T) {
+		spy := testkit.NewSpyRosterStore(onRoster(
+			domain.ActivatedPlayerOnRoster{TeamID: testkit.TeamA(), PlayerID: 1, PlayerRole: domain.RoleHitter, EffectiveAt: testkit.TodayLock()},
+		))
+		handler := roster.NewInactivatePlayerHandler(spy, testkit.NewStubLeagueLock(), inSeasonAccess())
+
+		err := handler.Handle(t.Context(), roster.NewScheduledInactivatePlayerCommand(testkit.TeamA(), 1, testkit.ManagerA(), testkit.TodayLock().Add(time.Hour)))
+		require.NoError(t, err)
+
+		require.Equal(t, len(spy.AppendCalls), 1)
+		assert.Equal(t, spy.AppendCalls[0].Events[0].OccurredAt(), testkit.TomorrowLock())
+	})
+
+	t.Run("reject a lock that has passed without loading", func(t *testing.T) {
+		spy := testkit.NewSpyRosterStore(onRoster())
+		handler := roster.NewRemovePlayerHandler(spy, testkit.NewStubLeagueLock(), inSeasonAccess())
+
+		err := handler.Handle(t.Context(), roster.NewScheduledRemovePlayerCommand(testkit.TeamA(), 1, testkit.ManagerA(), testkit.TodayLock()))
+
+		assert.ErrorIs(t, err, domain.ErrLockPassed)
+		assert.Equal(t, len(spy.LoadCalls), 0)
+		assert.Equal(t, len(spy.AppendCalls), 0)
+	})
+
+	t.Run("scheduled move is validated against the roster at its lock", func(t *testing.T) {
+		store := testkit.NewFakeRosterStore()
+		store.SeedEvents(testkit.TeamA(), []domain.RosterEvent{
+			domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 1, Eligibility: hitter, EffectiveAt: monday},
+		})
+		spy := testkit.NewSpyRosterStore(store)
+		handler := roster.NewActivatePlayerHandler(spy, testkit.NewStubLeagueLock(), inSeasonAccess())
+
+		err := handler.Handle(t.Context(), roster.NewActivatePlayerCommand(testkit.TeamA(), 1, domain.RoleHitter, testkit.ManagerA()))
+		assert.ErrorIs(t, err, domain.ErrPlayerNotOnRoster)
+
+		err = handler.Handle(t.Context(), roster.NewScheduledActivatePlayerCommand(testkit.TeamA(), 1, domain.RoleHitter, testkit.ManagerA(), monday))
+		assert.NoError(t, err)
+		assert.Equal(t, len(spy.AppendCalls), 1)
+	})
+
+	t.Run("accept an earlier move that leaves a scheduled one valid", func(t *testing.T) {
+		spy := testkit.NewSpyRosterStore(onRoster(
+			domain.RemovedPlayerFromRoster{TeamID: testkit.TeamA(), PlayerID: 1, EffectiveAt: monday},
+		))
+		handler := roster.NewActivatePlayerHandler(spy, testkit.NewStubLeagueLock(), inSeasonAccess())
+
+		err := handler.Handle(t.Context(), roster.NewActivatePlayerCommand(testkit.TeamA(), 1, domain.RoleHitter, testkit.ManagerA()))
+		require.NoError(t, err)
+		require.Equal(t, len(spy.AppendCalls), 1)
+
+		committed, _, err := spy.Load(t.Context(), testkit.TeamA())
+		require.NoError(t, err)
+		stream := roster.NewRosterStream(testkit.TeamA(), committed)
+
+		tomorrow, err := stream.TryProjectThrough(testkit.TomorrowLock())
+		require.NoError(t, err)
+		assert.Equal(t, tomorrow.Counts().ActiveHitters, 1)
+
+		later, err := stream.TryProjectThrough(monday)
+		require.NoError(t, err)
+		assert.False(t, later.PlayerOnRoster(1))
+	})
+
+	t.Run("reject an earlier move that strands a scheduled one", func(t *testing.T) {
+		spy := testkit.NewSpyRosterStore(onRoster(
+			domain.ActivatedPlayerOnRoster{TeamID: testkit.TeamA(), PlayerID: 1, PlayerRole: domain.RoleHitter, EffectiveAt: monday},
+		))
+		handler := roster.NewRemovePlayerHandler(spy, testkit.NewStubLeagueLock(), inSeasonAccess())
+
+		err := handler.Handle(t.Context(), roster.NewRemovePlayerCommand(testkit.TeamA(), 1, testkit.ManagerA()))
+
+		assert.ErrorIs(t, err, domain.ErrScheduledMoveConflict)
+		assert.ErrorIs(t, err, domain.ErrPlayerNotOnRoster)
+		assert.Equal(t, len(spy.AppendCalls), 0)
+	})
+
+	t.Run("season limit counts adds already scheduled", func(t *testing.T) {
+		store := testkit.NewFakeRosterStore()
+		store.SeedEvents(testkit.TeamA(), []domain.RosterEvent{
+			domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 1, Eligibility: hitter, EffectiveAt: monday},
+		})
+		spy := testkit.NewSpyRosterStore(store)
+		players := testkit.NewFakePlayerRepository()
+		players.SeedPlayerIDs(2)
+		handler := roster.NewAddPlayerHandler(spy, testkit.NewStubLeagueLock(), players, limitedAccess(domain.TransactionLimits{AddsPerSeason: 1}))
+
+		err := handler.Handle(t.Context(), roster.NewAddPlayerCommand(testkit.TeamA(), 2, testkit.ManagerA()))
+
+		assert.ErrorIs(t, err, domain.ErrScheduledMoveConflict)
+		assert.ErrorIs(t, err, domain.ErrSeasonAddLimitReached)
+		assert.Equal(t, len(spy.AppendCalls), 0)
+	})
+}
@@ -0,0 +1,67 @@
+package roster
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/telemetry"
+)
+
+// commandSpan is the span around one roster command.
+type commandSpan struct {
+	trace.Span
+}
+
+// startCommand opens the span for a roster command. Handlers record the version
+// they loaded and the events they appended on it as they go, and end it with the
+// error they return. A zero player is left off, for commands about an event
+// rather than a player.
+func startCommand(ctx context.Context, name string, team domain.TeamID, player domain.PlayerID) (context.Context, commandSpan) {
+	ctx, span := telemetry.Start(ctx, "roster."+name,
+		telemetry.Command.String(name),
+		telemetry.TeamID.Int(int(team)),
+	)
+	if player != 0 {
+		span.SetAttributes(telemetry.PlayerID.Int(int(player)))
+	}
+
+	return ctx, commandSpan{Span: span}
+}
+
+func (s commandSpan) end(ctx context.Context, err error) {
+	telemetry.End(s.Span, err)
+}
+
+func recordLoaded(ctx context.Context, version ports.Version) {
+	trace.SpanFromContext(ctx).SetAttributes(telemetry.LoadedVersion.Int64(int64(version)))
+}
+
+func recordAppended(ctx context.Context, events []domain.RosterEvent) {
+	trace.SpanFromContext(ctx).SetAttributes(telemetry.EventsAppended.Int(len(events)))
+}
+
+// ProjectThrough is stream.TryProjectThrough, telling store how long the replay
+// took when store is a ports.ProjectionObserver. Handlers project through it so
+// the stream itself does not time anything.
+func ProjectThrough(ctx context.Context, store ports.RosterStore, stream *RosterStream, through time.Time) (domain.RosterView, error) {
+	defer observeProjection(ctx, store, time.Now())
+	return stream.TryProjectThrough(through)
+}
+
+// ProjectBefore is stream.TryProjectBefore, timed like ProjectThrough.
+func ProjectBefore(ctx context.Context, store ports.RosterStore, stream *RosterStream, seq eventlog.Sequence, through time.Time) (domain.RosterView, error) {
+	defer observeProjection(ctx, store, time.Now())
+	return stream.TryProjectBefore(seq, through)
+}
+
+func observeProjection(ctx context.Context, store ports.RosterStore, start time.Time) {
+	observer, ok := store.(ports.ProjectionObserver)
+	if ok {
+		observer.ObserveProjection(ctx, time.Since(start))
+	}
+}
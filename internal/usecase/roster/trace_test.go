@@ -0,0 +1,154 @@
+package roster_test
+
+import (
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/telemetry"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/roster"
+)
+
+func TestRosterCommandSpans(t *testing.T) {
+	testCases := []struct {
+		name      string
+		history   []domain.RosterEvent
+		store     ports.RosterStore
+		actor     domain.UserID
+		wantAttrs []attribute.KeyValue
+		wantClass string
+	}{
+		{
+			name:    "successful add records the loaded version and appended events",
+			history: generateRosterHistory(testkit.TeamA(), 2),
+			wantAttrs: []attribute.KeyValue{
+				telemetry.LoadedVersion.Int64(2),
+				telemetry.EventsAppended.Int(1),
+			},
+		},
+		{
+			name:      "rule violation is classed as rejected",
+			history:   generateRosterHistory(testkit.TeamA(), domain.MaxRosterSize),
+			wantAttrs: []attribute.KeyValue{telemetry.LoadedVersion.Int64(domain.MaxRosterSize)},
+			wantClass: telemetry.ClassRejected,
+		},
+		{
+			name:      "stale version is classed as a version conflict",
+			store:     &testkit.VersionConflictRosterStore{},
+			wantClass: telemetry.ClassVersionConflict,
+		},
+		{
+			name:      "store failure is classed as internal",
+			store:     &testkit.FailingLoadRosterStore{},
+			wantClass: telemetry.ClassInternal,
+		},
+		{
+			name:      "another team's manager is classed as not authorized",
+			actor:     testkit.ManagerB(),
+			wantClass: telemetry.ClassNotAuthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			spans := testkit.RecordSpans(t)
+			metrics, _ := testkit.RecordMetrics(t)
+
+			store := tc.store
+			if store == nil {
+				fake := testkit.NewFakeRosterStore()
+				fake.SeedEvents(testkit.TeamA(), tc.history)
+				store = fake
+			}
+
+			players := testkit.NewFakePlayerRepository()
+			players.SeedPlayerIDs(domain.MaxRosterSize + 1)
+
+			actor := tc.actor
+			if actor == 0 {
+				actor = testkit.ManagerA()
+			}
+
+			handler := roster.NewAddPlayerHandler(telemetry.NewRosterStore(store, metrics), testkit.NewStubLeagueLock(), players, inSeasonAccess())
+			_ = handler.Handle(t.Context(), roster.NewAddPlayerCommand(testkit.TeamA(), domain.MaxRosterSize+1, actor))
+
+			span := testkit.SpanNamed(t, spans, "roster.AddPlayer")
+
+			want := append([]attribute.KeyValue{
+				telemetry.Command.String("AddPlayer"),
+				telemetry.TeamID.Int(int(testkit.TeamA())),
+				telemetry.PlayerID.Int(domain.MaxRosterSize + 1),
+			}, tc.wantAttrs...)
+			for _, kv := range want {
+				got, ok := testkit.SpanAttr(span, kv.Key)
+				require.True(t, ok)
+				assert.Equal(t, got, kv.Value)
+			}
+
+			class, ok := testkit.SpanAttr(span, telemetry.ErrorClass)
+			if tc.wantClass == "" {
+				assert.False(t, ok)
+				assert.Equal(t, span.Status.Code, codes.Unset)
+				return
+			}
+
+			require.True(t, ok)
+			assert.Equal(t, class.AsString(), tc.wantClass)
+			assert.Equal(t, span.Status.Code, codes.Error)
+		})
+	}
+
+	t.Run("store calls are children of the command span", func(t *testing.T) {
+		spans := testkit.RecordSpans(t)
+		metrics, _ := testkit.RecordMetrics(t)
+
+		players := testkit.NewFakePlayerRepository()
+		players.SeedPlayerIDs(1)
+
+		store := telemetry.NewRosterStore(testkit.NewFakeRosterStore(), metrics)
+		handler := roster.NewAddPlayerHandler(store, testkit.NewStubLeagueLock(), players, inSeasonAccess())
+		err := handler.Handle(t.Context(), roster.NewAddPlayerCommand(testkit.TeamA(), 1, testkit.ManagerA()))
+		require.NoError(t, err)
+
+		command := testkit.SpanNamed(t, spans, "roster.AddPlayer")
+		for _, name := range []string{"RosterStore.Load", "RosterStore.Append"} {
+			child := testkit.SpanNamed(t, spans, name)
+			assert.Equal(t, child.Parent.SpanID(), command.SpanContext.SpanID())
+			assert.Equal(t, child.SpanContext.TraceID(), command.SpanContext.TraceID())
+		}
+	})
+}
+
+func TestRosterCommandMetrics(t *testing.T) {
+	metrics, reader := testkit.RecordMetrics(t)
+
+	store := testkit.NewFakeRosterStore()
+	store.SeedEvents(testkit.TeamA(), generateRosterHistory(testkit.TeamA(), domain.MaxRosterSize))
+
+	players := testkit.NewFakePlayerRepository()
+	players.SeedPlayerIDs(domain.MaxRosterSize + 1)
+
+	handler := telemetry.NewCountedCommand("AddPlayer",
+		roster.NewAddPlayerHandler(telemetry.NewRosterStore(store, metrics), testkit.NewStubLeagueLock(), players, inSeasonAccess()), metrics)
+	err := handler.Handle(t.Context(), roster.NewAddPlayerCommand(testkit.TeamA(), domain.MaxRosterSize+1, testkit.ManagerA()))
+	require.ErrorIs(t, err, domain.ErrRosterFull)
+
+	commands, ok := testkit.CollectMetric(t, reader, telemetry.MetricCommands).(metricdata.Sum[int64])
+	require.True(t, ok)
+	require.Equal(t, len(commands.DataPoints), 1)
+	assert.Equal(t, commands.DataPoints[0].Value, 1)
+	outcome, _ := commands.DataPoints[0].Attributes.Value(telemetry.Outcome)
+	assert.Equal(t, outcome.AsString(), telemetry.OutcomeRejected)
+
+	projections, ok := testkit.CollectMetric(t, reader, telemetry.MetricProjectDuration).(metricdata.Histogram[float64])
+	require.True(t, ok)
+	require.Equal(t, len(projections.DataPoints), 1)
+	assert.True(t, projections.DataPoints[0].Count >= 1)
+}
@@ -0,0 +1,99 @@
+package roster
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/usecase/league"
+)
+
+// CapUsage is how much of its league's usage caps a team has used, with the
+// stats those caps allowed to count.
+type CapUsage struct {
+	Caps   domain.UsageCaps
+	Totals domain.StatTotals
+	Report domain.CapReport
+}
+
+type ViewCapsHandler struct {
+	Store   ports.RosterStore
+	Lock    ports.LeagueLock
+	Stats   ports.GameStatsRepository
+	Members ports.MembershipRepository
+	Leagues ports.LeagueStore
+}
+
+// Handle tallies the team's ingested stats under its league's caps and projects
+// the rest of the season from the lineup as it will stand at the next lock.
+func (h ViewCapsHandler) Handle(ctx context.Context, q ViewCapsQuery) (CapUsage, error) {
+	leagueID, err := h.Members.LeagueOf(ctx, q.TeamID)
+	if err != nil {
+		return CapUsage{}, err
+	}
+
+	view, err := league.LoadLeague(ctx, h.Leagues, leagueID)
+	if err != nil {
+		return CapUsage{}, err
+	}
+
+	committed, _, err := h.Store.Load(ctx, q.TeamID)
+	if err != nil {
+		return CapUsage{}, err
+	}
+
+	stream := NewRosterStream(q.TeamID, committed)
+
+	players, err := stream.TryPlayerIDs()
+	if err != nil {
+		return CapUsage{}, err
+	}
+
+	games, err := h.Stats.ListForPlayers(ctx, players)
+	if err != nil {
+		return CapUsage{}, err
+	}
+
+	caps := view.Settings.Caps
+	tally, err := stream.TryTally(games, caps)
+	if err != nil {
+		return CapUsage{}, err
+	}
+
+	lineup, err := ProjectThrough(ctx, h.Store, stream, h.Lock.NextLock(ctx))
+	if err != nil {
+		return CapUsage{}, err
+	}
+
+	return CapUsage{
+		Caps:   caps,
+		Totals: tally.Totals,
+		Report: domain.ProjectCaps(caps, tally.Usage, lineup, view.Settings.Calendar),
+	}, nil
+}
+
+func NewViewCapsHandler(
+	store ports.RosterStore,
+	lock ports.LeagueLock,
+	stats ports.GameStatsRepository,
+	members ports.MembershipRepository,
+	leagues ports.LeagueStore,
+) ViewCapsHandler {
+	return ViewCapsHandler{
+		Store:   store,
+		Lock:    lock,
+		Stats:   stats,
+		Members: members,
+		Leagues: leagues,
+	}
+}
+
+type ViewCapsQuery struct {
+	TeamID domain.TeamID
+}
+
+func NewViewCapsQuery(teamID domain.TeamID) ViewCapsQuery {
+	return ViewCapsQuery{
+		TeamID: teamID,
+	}
+}
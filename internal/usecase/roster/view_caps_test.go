@@ -0,0 +1,72 @@
+package roster_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/roster"
+)
+
+func TestViewCapsHandler_Handle(t *testing.T) {
+	// Player 1 pitches from TodayLock; player 2 hits from TomorrowLock.
+	history := []domain.RosterEvent{
+		domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 1, Eligibility: domain.MustRoleSet(domain.RolePitcher), EffectiveAt: testkit.TodayLock()},
+		domain.ActivatedPlayerOnRoster{TeamID: testkit.TeamA(), PlayerID: 1, PlayerRole: domain.RolePitcher, EffectiveAt: testkit.TodayLock()},
+		domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 2, Eligibility: domain.MustRoleSet(domain.RoleHitter), EffectiveAt: testkit.TomorrowLock()},
+		domain.ActivatedPlayerOnRoster{TeamID: testkit.TeamA(), PlayerID: 2, PlayerRole: domain.RoleHitter, EffectiveAt: testkit.TomorrowLock()},
+	}
+
+	caps := domain.UsageCaps{InningsPitched: 8, Overflow: domain.OverflowDropCrossingGame}
+	leagues := inSeasonLeague(func(s *domain.LeagueSettings) {
+		s.Calendar = domain.MatchupCalendar{SeasonStart: testkit.TodayLock(), PeriodDays: 7, Periods: 2}
+		s.Caps = caps
+	})
+
+	newHandler := func(store ports.RosterStore, stats ports.GameStatsRepository) roster.ViewCapsHandler {
+		return roster.NewViewCapsHandler(store, testkit.NewStubLeagueLock(), stats, testkit.NewLeagueMemberships(), leagues)
+	}
+
+	t.Run("tallies ingested stats under the caps and projects the lineup", func(t *testing.T) {
+		store := testkit.NewFakeRosterStore()
+		store.SeedEvents(testkit.TeamA(), history)
+
+		stats := testkit.NewFakeGameStats()
+		stats.Seed(
+			domain.GameStats{PlayerID: 1, Lock: testkit.TodayLock(), Pitching: domain.PitchingLine{OutsRecorded: 18, Strikeouts: 9}},
+			domain.GameStats{PlayerID: 1, Lock: testkit.TomorrowLock(), Pitching: domain.PitchingLine{OutsRecorded: 9, Strikeouts: 4}},
+			domain.GameStats{PlayerID: 3, Lock: testkit.TodayLock(), Hitting: domain.HittingLine{Hits: 4}},
+		)
+
+		usage, err := newHandler(store, stats).Handle(t.Context(), roster.NewViewCapsQuery(testkit.TeamA()))
+		require.NoError(t, err)
+
+		assert.Equal(t, usage.Caps, caps)
+		assert.Equal(t, usage.Totals, domain.StatTotals{Pitching: domain.PitchingLine{OutsRecorded: 18, Strikeouts: 9}})
+		assert.Equal(t, usage.Report.OutsPitched.Cap, 24)
+		assert.Equal(t, usage.Report.OutsPitched.Remaining(), 6)
+
+		// At TomorrowLock one day has passed and thirteen are left.
+		assert.Equal(t, usage.Report.HitterGames.Projected, 13)
+		assert.Equal(t, usage.Report.OutsPitched.Projected, 18+18*13)
+	})
+
+	t.Run("team outside any league is not found", func(t *testing.T) {
+		handler := newHandler(testkit.NewFakeRosterStore(), testkit.NewFakeGameStats())
+
+		_, err := handler.Handle(t.Context(), roster.NewViewCapsQuery(testkit.TeamC()))
+
+		assert.ErrorIs(t, err, ports.ErrTeamNotFound)
+	})
+
+	t.Run("load error is returned", func(t *testing.T) {
+		handler := newHandler(&testkit.FailingLoadRosterStore{}, testkit.NewFakeGameStats())
+
+		_, err := handler.Handle(t.Context(), roster.NewViewCapsQuery(testkit.TeamA()))
+
+		assert.ErrorIs(t, err, testkit.ErrFailingLoad)
+	})
+}
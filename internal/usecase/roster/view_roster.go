@@ -0,0 +1,72 @@
+package roster
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+// RosterDetails is a team's roster as it will stand at the next lock, with the
+// catalog entry for each rostered player.
+type RosterDetails struct {
+	View    domain.RosterView
+	Players map[domain.PlayerID]domain.Player
+}
+
+type ViewRosterHandler struct {
+	Store   ports.RosterStore
+	Lock    ports.LeagueLock
+	Players ports.PlayerRepository
+}
+
+// Handle projects the roster through the next lock. Players missing from the
+// catalog are left out of Players rather than failing the whole view.
+func (h ViewRosterHandler) Handle(ctx context.Context, q ViewRosterQuery) (RosterDetails, error) {
+	committed, _, err := h.Store.Load(ctx, q.TeamID)
+	if err != nil {
+		return RosterDetails{}, err
+	}
+
+	view, err := ProjectThrough(ctx, h.Store, NewRosterStream(q.TeamID, committed), h.Lock.NextLock(ctx))
+	if err != nil {
+		return RosterDetails{}, err
+	}
+
+	players := make(map[domain.PlayerID]domain.Player, len(view.Entries))
+	for _, e := range view.Entries {
+		p, err := h.Players.Get(ctx, e.PlayerID)
+		if errors.Is(err, ports.ErrPlayerNotFound) {
+			continue
+		}
+		if err != nil {
+			return RosterDetails{}, err
+		}
+
+		players[e.PlayerID] = p
+	}
+
+	return RosterDetails{
+		View:    view,
+		Players: players,
+	}, nil
+}
+
+func NewViewRosterHandler(store ports.RosterStore, lock ports.LeagueLock, players ports.PlayerRepository) ViewRosterHandler {
+	return ViewRosterHandler{
+		Store:   store,
+		Lock:    lock,
+		Players: players,
+	}
+}
+
+type ViewRosterQuery struct {
+	TeamID domain.TeamID
+}
+
+func NewViewRosterQuery(teamID domain.TeamID) ViewRosterQuery {
+	return ViewRosterQuery{
+		TeamID: teamID,
+	}
+}
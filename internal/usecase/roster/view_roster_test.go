@@ -0,0 +1,58 @@
+package roster_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/roster"
+)
+
+func TestViewRosterHandler_Handle(t *testing.T) {
+	t.Run("projects through the next lock and attaches catalog entries", func(t *testing.T) {
+		store := testkit.NewFakeRosterStore()
+		store.SeedEvents(testkit.TeamA(), []domain.RosterEvent{
+			domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 1, Eligibility: domain.MustRoleSet(domain.RoleHitter), EffectiveAt: testkit.TodayLock()},
+			domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 2, Eligibility: domain.MustRoleSet(domain.RoleHitter), EffectiveAt: testkit.TomorrowLock()},
+		})
+
+		players := testkit.NewFakePlayerRepository()
+		players.SeedPlayerIDs(1)
+
+		handler := roster.NewViewRosterHandler(store, testkit.NewStubLeagueLock(), players)
+
+		details, err := handler.Handle(t.Context(), roster.NewViewRosterQuery(testkit.TeamA()))
+		require.NoError(t, err)
+
+		assert.Equal(t, len(details.View.Entries), 2)
+		assert.Equal(t, details.View.EffectiveThrough, testkit.TomorrowLock())
+		assert.Equal(t, details.Players[1].Name, "Player 1")
+
+		_, ok := details.Players[2]
+		assert.False(t, ok)
+	})
+
+	t.Run("a stream that cannot be replayed is an error, not a panic", func(t *testing.T) {
+		store := testkit.NewFakeRosterStore()
+		store.SeedEvents(testkit.TeamA(), []domain.RosterEvent{
+			domain.InactivatedPlayerOnRoster{TeamID: testkit.TeamA(), PlayerID: 1, EffectiveAt: testkit.TodayLock()},
+		})
+
+		handler := roster.NewViewRosterHandler(store, testkit.NewStubLeagueLock(), testkit.NewFakePlayerRepository())
+
+		_, err := handler.Handle(t.Context(), roster.NewViewRosterQuery(testkit.TeamA()))
+
+		assert.ErrorIs(t, err, eventlog.ErrUnreplayableEvent)
+	})
+
+	t.Run("load error is returned", func(t *testing.T) {
+		handler := roster.NewViewRosterHandler(&testkit.FailingLoadRosterStore{}, testkit.NewStubLeagueLock(), testkit.NewFakePlayerRepository())
+
+		_, err := handler.Handle(t.Context(), roster.NewViewRosterQuery(testkit.TeamA()))
+
+		assert.ErrorIs(t, err, testkit.ErrFailingLoad)
+	})
+}
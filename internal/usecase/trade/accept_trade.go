@@ -0,0 +1,124 @@
+package trade
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/usecase/roster"
+)
+
+// AcceptTradeHandler records an accepted trade and opens its review window.
+//
+// Only a manager of the receiving team may accept, and both teams must be in the
+// same league. Both teams must still roster the players they are sending as of
+// the next lock.
+type AcceptTradeHandler struct {
+	Trades  ports.TradeStore
+	Rosters ports.RosterStore
+	Lock    ports.LeagueLock
+	Clock   ports.Clock
+	Policy  domain.TradeReviewPolicy
+	Access  roster.Access
+}
+
+func (h AcceptTradeHandler) Handle(ctx context.Context, cmd AcceptTradeCommand) error {
+	err := authorizeTerms(ctx, h.Access, cmd.Actor, cmd.Terms.Receiver, cmd.Terms.Proposer)
+	if err != nil {
+		return err
+	}
+
+	committed, version, err := h.Trades.Load(ctx, cmd.TradeID)
+	if err != nil {
+		return err
+	}
+
+	view := ProjectTrade(cmd.TradeID, committed)
+
+	events, err := view.DecideAccept(cmd.Terms, h.Policy, h.Clock.Now())
+	if err != nil {
+		return err
+	}
+
+	err = requireRostered(ctx, h.Rosters, h.Lock, cmd.Terms.Proposer, cmd.Terms.ProposerSends)
+	if err != nil {
+		return err
+	}
+
+	err = requireRostered(ctx, h.Rosters, h.Lock, cmd.Terms.Receiver, cmd.Terms.ReceiverSends)
+	if err != nil {
+		return err
+	}
+
+	_, err = h.Trades.Append(ctx, cmd.TradeID, events, version)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// authorizeTerms returns ErrNotAuthorized unless actor manages team, and
+// ErrTeamNotInLeague unless counterparty is in team's league.
+func authorizeTerms(ctx context.Context, access roster.Access, actor domain.UserID, team, counterparty domain.TeamID) error {
+	league, err := access.AuthorizeManager(ctx, actor, team)
+	if err != nil {
+		return err
+	}
+
+	return access.RequireInLeague(ctx, league, counterparty)
+}
+
+// requireRostered returns ErrPlayerNotOnRoster unless team rosters every one of
+// players as of the next lock.
+func requireRostered(ctx context.Context, rosters ports.RosterStore, lock ports.LeagueLock, teamID domain.TeamID, players []domain.PlayerID) error {
+	committed, _, err := rosters.Load(ctx, teamID)
+	if err != nil {
+		return err
+	}
+
+	view, err := roster.ProjectThrough(ctx, rosters, roster.NewRosterStream(teamID, committed), lock.NextLock(ctx))
+	if err != nil {
+		return err
+	}
+	for _, id := range players {
+		if !view.PlayerOnRoster(id) {
+			return fmt.Errorf("%w: team %v, player %v", domain.ErrPlayerNotOnRoster, teamID, id)
+		}
+	}
+
+	return nil
+}
+
+func NewAcceptTradeHandler(
+	trades ports.TradeStore,
+	rosters ports.RosterStore,
+	lock ports.LeagueLock,
+	clock ports.Clock,
+	policy domain.TradeReviewPolicy,
+	access roster.Access,
+) AcceptTradeHandler {
+	return AcceptTradeHandler{
+		Trades:  trades,
+		Rosters: rosters,
+		Lock:    lock,
+		Clock:   clock,
+		Policy:  policy,
+		Access:  access,
+	}
+}
+
+type AcceptTradeCommand struct {
+	TradeID domain.TradeID
+	Terms   domain.TradeTerms
+	Actor   domain.UserID
+}
+
+func NewAcceptTradeCommand(tradeID domain.TradeID, terms domain.TradeTerms, actor domain.UserID) AcceptTradeCommand {
+	return AcceptTradeCommand{
+		TradeID: tradeID,
+		Terms:   terms,
+		Actor:   actor,
+	}
+}
@@ -0,0 +1,121 @@
+package trade_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/roster"
+	"github.com/spcameron/dugout/internal/usecase/trade"
+)
+
+func reviewPolicy() domain.TradeReviewPolicy {
+	return domain.TradeReviewPolicy{
+		Window:            24 * time.Hour,
+		VetoVotesRequired: 2,
+	}
+}
+
+func oneForOne() domain.TradeTerms {
+	return domain.TradeTerms{
+		Proposer:      testkit.TeamA(),
+		Receiver:      testkit.TeamB(),
+		ProposerSends: []domain.PlayerID{1},
+		ReceiverSends: []domain.PlayerID{2},
+	}
+}
+
+// Managers of the teams outside the trade, who may vote on it.
+const (
+	managerC = domain.UserID(33)
+	managerD = domain.UserID(44)
+	teamD    = domain.TeamID(444)
+)
+
+// tradeAccess is access to LeagueA in season, with TeamC and teamD in the
+// league alongside the trading teams.
+func tradeAccess(t *testing.T) roster.Access {
+	t.Helper()
+
+	members := testkit.NewLeagueMemberships()
+	require.NoError(t, members.Grant(t.Context(), domain.Membership{UserID: managerC, LeagueID: testkit.LeagueA(), TeamID: testkit.TeamC(), Role: domain.MembershipManager}))
+	require.NoError(t, members.Grant(t.Context(), domain.Membership{UserID: managerD, LeagueID: testkit.LeagueA(), TeamID: teamD, Role: domain.MembershipManager}))
+
+	return roster.NewAccess(members, testkit.NewLeagueStoreInPhase(domain.PhaseInSeason))
+}
+
+func seedOneForOneRosters(store *testkit.FakeRosterStore) {
+	store.SeedEvents(testkit.TeamA(), []domain.RosterEvent{
+		domain.AddedPlayerToRoster{TeamID: testkit.TeamA(), PlayerID: 1, Eligibility: domain.MustRoleSet(domain.RoleHitter), EffectiveAt: testkit.TodayLock()},
+	})
+	store.SeedEvents(testkit.TeamB(), []domain.RosterEvent{
+		domain.AddedPlayerToRoster{TeamID: testkit.TeamB(), PlayerID: 2, Eligibility: domain.MustRoleSet(domain.RolePitcher), EffectiveAt: testkit.TodayLock()},
+	})
+}
+
+func TestAcceptTradeHandler_Handle(t *testing.T) {
+	t.Run("accepting opens the review window from the clock", func(t *testing.T) {
+		trades := testkit.NewFakeTradeStore()
+		rosters := testkit.NewFakeRosterStore()
+		seedOneForOneRosters(rosters)
+		clock := testkit.NewStubClock(testkit.TodayLock())
+
+		handler := trade.NewAcceptTradeHandler(trades, rosters, testkit.NewStubLeagueLock(), clock, reviewPolicy(), tradeAccess(t))
+
+		err := handler.Handle(t.Context(), trade.NewAcceptTradeCommand(1, oneForOne(), testkit.ManagerB()))
+		require.NoError(t, err)
+
+		committed, _, err := trades.Load(t.Context(), 1)
+		require.NoError(t, err)
+
+		view := trade.ProjectTrade(1, committed)
+		assert.Equal(t, view.Status, domain.TradeUnderReview)
+		assert.Equal(t, view.ReviewEndsAt, testkit.TodayLock().Add(24*time.Hour))
+	})
+
+	t.Run("accepting a trade for a player not on the roster is rejected", func(t *testing.T) {
+		trades := testkit.NewFakeTradeStore()
+		rosters := testkit.NewFakeRosterStore()
+		clock := testkit.NewStubClock(testkit.TodayLock())
+
+		handler := trade.NewAcceptTradeHandler(trades, rosters, testkit.NewStubLeagueLock(), clock, reviewPolicy(), tradeAccess(t))
+
+		err := handler.Handle(t.Context(), trade.NewAcceptTradeCommand(1, oneForOne(), testkit.ManagerB()))
+		assert.ErrorIs(t, err, domain.ErrPlayerNotOnRoster)
+
+		ids, err := trades.ListUnderReview(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, len(ids), 0)
+	})
+
+	t.Run("only a manager of the receiving team may accept", func(t *testing.T) {
+		testCases := []struct {
+			name  string
+			actor domain.UserID
+		}{
+			{name: "proposing manager", actor: testkit.ManagerA()},
+			{name: "commissioner", actor: testkit.Commissioner()},
+			{name: "manager of another team", actor: managerC},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				trades := testkit.NewFakeTradeStore()
+				rosters := testkit.NewFakeRosterStore()
+				seedOneForOneRosters(rosters)
+
+				handler := trade.NewAcceptTradeHandler(trades, rosters, testkit.NewStubLeagueLock(), testkit.NewStubClock(testkit.TodayLock()), reviewPolicy(), tradeAccess(t))
+
+				err := handler.Handle(t.Context(), trade.NewAcceptTradeCommand(1, oneForOne(), tc.actor))
+				assert.ErrorIs(t, err, domain.ErrNotAuthorized)
+
+				committed, _, err := trades.Load(t.Context(), 1)
+				require.NoError(t, err)
+				assert.Equal(t, len(committed), 0)
+			})
+		}
+	})
+}
@@ -0,0 +1,84 @@
+package trade
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/usecase/roster"
+)
+
+// ProposeTradeHandler records a trade offer. Only a manager of the proposing
+// team may offer a trade, to a team in the same league, and both teams must
+// still roster the players they would send as of the next lock. The receiving
+// team hears about the offer from the trade outbox once it is recorded.
+type ProposeTradeHandler struct {
+	Trades  ports.TradeStore
+	Rosters ports.RosterStore
+	Lock    ports.LeagueLock
+	Clock   ports.Clock
+	Access  roster.Access
+}
+
+func (h ProposeTradeHandler) Handle(ctx context.Context, cmd ProposeTradeCommand) error {
+	err := authorizeTerms(ctx, h.Access, cmd.Actor, cmd.Terms.Proposer, cmd.Terms.Receiver)
+	if err != nil {
+		return err
+	}
+
+	committed, version, err := h.Trades.Load(ctx, cmd.TradeID)
+	if err != nil {
+		return err
+	}
+
+	view := ProjectTrade(cmd.TradeID, committed)
+
+	events, err := view.DecidePropose(cmd.Terms, cmd.Actor, h.Clock.Now())
+	if err != nil {
+		return err
+	}
+
+	err = requireRostered(ctx, h.Rosters, h.Lock, cmd.Terms.Proposer, cmd.Terms.ProposerSends)
+	if err != nil {
+		return err
+	}
+
+	err = requireRostered(ctx, h.Rosters, h.Lock, cmd.Terms.Receiver, cmd.Terms.ReceiverSends)
+	if err != nil {
+		return err
+	}
+
+	_, err = h.Trades.Append(ctx, cmd.TradeID, events, version)
+
+	return err
+}
+
+func NewProposeTradeHandler(
+	trades ports.TradeStore,
+	rosters ports.RosterStore,
+	lock ports.LeagueLock,
+	clock ports.Clock,
+	access roster.Access,
+) ProposeTradeHandler {
+	return ProposeTradeHandler{
+		Trades:  trades,
+		Rosters: rosters,
+		Lock:    lock,
+		Clock:   clock,
+		Access:  access,
+	}
+}
+
+type ProposeTradeCommand struct {
+	TradeID domain.TradeID
+	Terms   domain.TradeTerms
+	Actor   domain.UserID
+}
+
+func NewProposeTradeCommand(tradeID domain.TradeID, terms domain.TradeTerms, actor domain.UserID) ProposeTradeCommand {
+	return ProposeTradeCommand{
+		TradeID: tradeID,
+		Terms:   terms,
+		Actor:   actor,
+	}
+}
@@ -0,0 +1,95 @@
+package trade_test
+
+import (
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/trade"
+)
+
+func TestProposeTradeHandler_Handle(t *testing.T) {
+	newHandler := func(trades *testkit.FakeTradeStore) trade.ProposeTradeHandler {
+		rosters := testkit.NewFakeRosterStore()
+		seedOneForOneRosters(rosters)
+
+		return trade.NewProposeTradeHandler(trades, rosters, testkit.NewStubLeagueLock(), testkit.NewStubClock(testkit.TodayLock()), tradeAccess(t))
+	}
+
+	// committed returns the trade's recorded events.
+	committed := func(t *testing.T, trades *testkit.FakeTradeStore) []domain.TradeEvent {
+		t.Helper()
+
+		history, _, err := trades.Load(t.Context(), 1)
+		require.NoError(t, err)
+
+		events := make([]domain.TradeEvent, len(history))
+		for i, re := range history {
+			events[i] = re.Event
+		}
+
+		return events
+	}
+
+	t.Run("proposing records the offer", func(t *testing.T) {
+		trades := testkit.NewFakeTradeStore()
+
+		err := newHandler(trades).Handle(t.Context(), trade.NewProposeTradeCommand(1, oneForOne(), testkit.ManagerA()))
+		require.NoError(t, err)
+
+		events := committed(t, trades)
+		require.Equal(t, len(events), 1)
+
+		offer, ok := events[0].(domain.ProposedTrade)
+		require.True(t, ok)
+		assert.Equal(t, offer.Terms.Receiver, testkit.TeamB())
+		assert.Equal(t, offer.ProposedBy, testkit.ManagerA())
+	})
+
+	t.Run("offering a player the team does not roster is rejected", func(t *testing.T) {
+		trades := testkit.NewFakeTradeStore()
+		terms := oneForOne()
+		terms.ProposerSends = []domain.PlayerID{9}
+
+		err := newHandler(trades).Handle(t.Context(), trade.NewProposeTradeCommand(1, terms, testkit.ManagerA()))
+		assert.ErrorIs(t, err, domain.ErrPlayerNotOnRoster)
+		assert.Equal(t, len(committed(t, trades)), 0)
+	})
+
+	t.Run("only a manager of the proposing team may offer it", func(t *testing.T) {
+		trades := testkit.NewFakeTradeStore()
+
+		err := newHandler(trades).Handle(t.Context(), trade.NewProposeTradeCommand(1, oneForOne(), testkit.ManagerB()))
+		assert.ErrorIs(t, err, domain.ErrNotAuthorized)
+		assert.Equal(t, len(committed(t, trades)), 0)
+	})
+
+	t.Run("a trade with a team in another league is rejected", func(t *testing.T) {
+		trades := testkit.NewFakeTradeStore()
+		terms := oneForOne()
+		terms.Receiver = 555
+
+		err := newHandler(trades).Handle(t.Context(), trade.NewProposeTradeCommand(1, terms, testkit.ManagerA()))
+		assert.ErrorIs(t, err, domain.ErrTeamNotInLeague)
+		assert.Equal(t, len(committed(t, trades)), 0)
+	})
+
+	t.Run("an offer is accepted on its terms", func(t *testing.T) {
+		trades := testkit.NewFakeTradeStore()
+		rosters := testkit.NewFakeRosterStore()
+		seedOneForOneRosters(rosters)
+		clock := testkit.NewStubClock(testkit.TodayLock())
+
+		propose := trade.NewProposeTradeHandler(trades, rosters, testkit.NewStubLeagueLock(), clock, tradeAccess(t))
+		require.NoError(t, propose.Handle(t.Context(), trade.NewProposeTradeCommand(1, oneForOne(), testkit.ManagerA())))
+
+		accept := trade.NewAcceptTradeHandler(trades, rosters, testkit.NewStubLeagueLock(), clock, reviewPolicy(), tradeAccess(t))
+		require.NoError(t, accept.Handle(t.Context(), trade.NewAcceptTradeCommand(1, oneForOne(), testkit.ManagerB())))
+
+		committed, _, err := trades.Load(t.Context(), 1)
+		require.NoError(t, err)
+		assert.Equal(t, trade.ProjectTrade(1, committed).Status, domain.TradeUnderReview)
+	})
+}
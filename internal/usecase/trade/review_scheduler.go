@@ -0,0 +1,248 @@
+package trade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/usecase/roster"
+)
+
+// ReviewScheduler settles trades whose review window has closed.
+//
+// Each run evaluates every trade under review against the injected clock, in
+// ascending TradeID order. Trades that survived review are applied to both rosters
+// atomically at the next lock; trades the rosters can no longer absorb are recorded
+// as failed, as are trades whose league has closed roster moves. A run that fails
+// part-way can be repeated safely.
+type ReviewScheduler struct {
+	Trades  ports.TradeStore
+	Rosters ports.RosterStore
+	Lock    ports.LeagueLock
+	Clock   ports.Clock
+	Access  roster.Access
+}
+
+// RunDue settles every trade that is due and returns the joined infrastructure errors,
+// if any. A failure on one trade does not stop the others from being settled, but a
+// cancelled context stops the run before the next trade.
+func (s ReviewScheduler) RunDue(ctx context.Context) error {
+	ids, err := s.Trades.ListUnderReview(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := s.Clock.Now()
+
+	var errs []error
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			errs = append(errs, ctx.Err())
+			break
+		}
+
+		err := s.settle(ctx, id, now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("trade %v: %w", id, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Run settles due trades, then again every interval, until ctx is done. Errors
+// go to report, if set, and the trades involved are tried again on a later run.
+func (s ReviewScheduler) Run(ctx context.Context, interval time.Duration, report func(error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		err := s.RunDue(ctx)
+		if err != nil && ctx.Err() == nil && report != nil {
+			report(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s ReviewScheduler) settle(ctx context.Context, id domain.TradeID, now time.Time) error {
+	committed, version, err := s.Trades.Load(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	view := ProjectTrade(id, committed)
+	if view.Status != domain.TradeUnderReview || !view.ReviewClosed(now) {
+		return nil
+	}
+
+	effective := s.Lock.NextLock(ctx)
+	terms := view.Terms
+
+	proposer, proposerVersion, err := s.loadStream(ctx, terms.Proposer)
+	if err != nil {
+		return err
+	}
+
+	receiver, receiverVersion, err := s.loadStream(ctx, terms.Receiver)
+	if err != nil {
+		return err
+	}
+
+	proposerBefore, err := roster.ProjectThrough(ctx, s.Rosters, proposer, effective)
+	if err != nil {
+		return err
+	}
+
+	receiverBefore, err := roster.ProjectThrough(ctx, s.Rosters, receiver, effective)
+	if err != nil {
+		return err
+	}
+
+	// A previous run may have moved the players without recording the execution.
+	if !tradeApplied(proposerBefore, receiverBefore, terms) {
+		_, rejection := s.Access.RosterMovesOpen(ctx, terms.Proposer)
+		if rejection != nil && !errors.As(rejection, new(*domain.Rejection)) {
+			return rejection
+		}
+
+		if rejection == nil {
+			rejection = s.stageTradeSide(ctx, id, proposer, effective, terms.ProposerSends, terms.ReceiverSends, receiverBefore)
+		}
+		if rejection == nil {
+			rejection = s.stageTradeSide(ctx, id, receiver, effective, terms.ReceiverSends, terms.ProposerSends, proposerBefore)
+		}
+
+		// A stream that cannot be replayed is not a reason to fail the trade; leave
+		// it under review until the stream is repaired.
+		if errors.Is(rejection, eventlog.ErrUnreplayableEvent) {
+			return rejection
+		}
+
+		if rejection != nil {
+			events, err := view.DecideFail(now, rejection.Error())
+			if err != nil {
+				return err
+			}
+
+			_, err = s.Trades.Append(ctx, id, events, version)
+			return err
+		}
+
+		_, err = s.Rosters.AppendMany(ctx, []ports.StreamAppend{
+			{TeamID: terms.Proposer, Events: proposer.Pending, Expected: proposerVersion},
+			{TeamID: terms.Receiver, Events: receiver.Pending, Expected: receiverVersion},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	events, err := view.DecideExecute(now, effective)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.Trades.Append(ctx, id, events, version)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s ReviewScheduler) loadStream(ctx context.Context, id domain.TeamID) (*roster.RosterStream, ports.Version, error) {
+	committed, version, err := s.Rosters.Load(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return roster.NewRosterStream(id, committed), version, nil
+}
+
+func NewReviewScheduler(trades ports.TradeStore, rosters ports.RosterStore, lock ports.LeagueLock, clock ports.Clock, access roster.Access) ReviewScheduler {
+	return ReviewScheduler{
+		Trades:  trades,
+		Rosters: rosters,
+		Lock:    lock,
+		Clock:   clock,
+		Access:  access,
+	}
+}
+
+// stageTradeSide stages the removals and then the additions for one team, deciding
+// each move against the roster as it stands after the moves staged before it and
+// rechecking the moves already scheduled after through, as the roster commands
+// do. Trades are not held to the league's transaction limits, and each add is
+// annotated as received in the trade so it does not count toward them later.
+//
+// Incoming players keep the eligibility recorded on the sending team's roster, read
+// from counterparty as it stood before the trade.
+func (s ReviewScheduler) stageTradeSide(ctx context.Context, tradeID domain.TradeID, stream *roster.RosterStream, through time.Time, sends, receives []domain.PlayerID, counterparty domain.RosterView) error {
+	for _, id := range sends {
+		events, err := roster.DecideAcross(ctx, s.Rosters, stream, through, domain.TransactionRules{}, func(rv domain.RosterView) ([]domain.RosterEvent, error) {
+			return rv.DecideRemovePlayer(id)
+		})
+		if err != nil {
+			return fmt.Errorf("team %v: %w", stream.TeamID, err)
+		}
+
+		err = stream.Stage(events...)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, id := range receives {
+		entry, ok := counterparty.Entry(id)
+		if !ok {
+			return fmt.Errorf("team %v: %w: player %v", counterparty.TeamID, domain.ErrPlayerNotOnRoster, id)
+		}
+
+		events, err := roster.DecideAcross(ctx, s.Rosters, stream, through, domain.TransactionRules{}, func(rv domain.RosterView) ([]domain.RosterEvent, error) {
+			return rv.DecideAddPlayer(id, entry.Eligibility)
+		})
+		if err != nil {
+			return fmt.Errorf("team %v: %w", stream.TeamID, err)
+		}
+
+		events = append(events, domain.ReceivedInTrade{
+			TeamID:      stream.TeamID,
+			PlayerID:    id,
+			TradeID:     tradeID,
+			EffectiveAt: through,
+		})
+
+		err = stream.Stage(events...)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func tradeApplied(proposerView, receiverView domain.RosterView, terms domain.TradeTerms) bool {
+	for _, id := range terms.ProposerSends {
+		if proposerView.PlayerOnRoster(id) || !receiverView.PlayerOnRoster(id) {
+			return false
+		}
+	}
+
+	for _, id := range terms.ReceiverSends {
+		if receiverView.PlayerOnRoster(id) || !proposerView.PlayerOnRoster(id) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,249 @@
+package trade_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/roster"
+	"github.com/spcameron/dugout/internal/usecase/trade"
+)
+
+type schedulerFixture struct {
+	trades    *testkit.FakeTradeStore
+	rosters   *testkit.FakeRosterStore
+	spy       *testkit.SpyRosterStore
+	clock     *testkit.StubClock
+	lock      testkit.StubLeagueLock
+	access    roster.Access
+	scheduler trade.ReviewScheduler
+}
+
+func newSchedulerFixture(t *testing.T) schedulerFixture {
+	t.Helper()
+
+	f := schedulerFixture{
+		trades:  testkit.NewFakeTradeStore(),
+		rosters: testkit.NewFakeRosterStore(),
+		clock:   testkit.NewStubClock(testkit.TodayLock()),
+		lock:    testkit.NewStubLeagueLock(),
+		access:  tradeAccess(t),
+	}
+	f.spy = testkit.NewSpyRosterStore(f.rosters)
+	f.scheduler = trade.NewReviewScheduler(f.trades, f.spy, f.lock, f.clock, f.access)
+
+	seedOneForOneRosters(f.rosters)
+
+	accept := trade.NewAcceptTradeHandler(f.trades, f.rosters, f.lock, f.clock, reviewPolicy(), f.access)
+	require.NoError(t, accept.Handle(t.Context(), trade.NewAcceptTradeCommand(1, oneForOne(), testkit.ManagerB())))
+
+	return f
+}
+
+func (f schedulerFixture) tradeStatus(t *testing.T) domain.TradeStatus {
+	t.Helper()
+
+	committed, _, err := f.trades.Load(t.Context(), 1)
+	require.NoError(t, err)
+
+	return trade.ProjectTrade(1, committed).Status
+}
+
+func (f schedulerFixture) onRoster(t *testing.T, teamID domain.TeamID, playerID domain.PlayerID) bool {
+	t.Helper()
+
+	committed, _, err := f.rosters.Load(t.Context(), teamID)
+	require.NoError(t, err)
+
+	return roster.NewRosterStream(teamID, committed).ProjectThrough(f.lock.NextLock(t.Context())).PlayerOnRoster(playerID)
+}
+
+// staleRosterStore loads real history but reports every batch append as stale,
+// as if another writer had committed between Load and AppendMany.
+type staleRosterStore struct {
+	*testkit.FakeRosterStore
+}
+
+func (s staleRosterStore) AppendMany(_ context.Context, appends []ports.StreamAppend) ([]ports.Version, error) {
+	return nil, &ports.VersionConflictError{TeamID: appends[0].TeamID, Current: appends[0].Expected + 1, Expected: appends[0].Expected}
+}
+
+func TestReviewScheduler_RunDue(t *testing.T) {
+	t.Run("trade under review is left alone until the window closes", func(t *testing.T) {
+		f := newSchedulerFixture(t)
+		f.clock.Advance(23 * time.Hour)
+
+		require.NoError(t, f.scheduler.RunDue(t.Context()))
+
+		assert.Equal(t, f.tradeStatus(t), domain.TradeUnderReview)
+		assert.Equal(t, len(f.spy.AppendManyCalls), 0)
+	})
+
+	t.Run("trade executes at the next lock once the window closes", func(t *testing.T) {
+		f := newSchedulerFixture(t)
+		f.clock.Advance(24 * time.Hour)
+
+		require.NoError(t, f.scheduler.RunDue(t.Context()))
+
+		assert.Equal(t, f.tradeStatus(t), domain.TradeExecuted)
+		require.Equal(t, len(f.spy.AppendManyCalls), 1)
+
+		for _, a := range f.spy.AppendManyCalls[0] {
+			require.Equal(t, len(a.Events), 3)
+			for _, ev := range a.Events {
+				assert.Equal(t, ev.OccurredAt(), f.lock.NextLock(t.Context()))
+			}
+		}
+
+		received, ok := f.spy.AppendManyCalls[0][0].Events[1].(domain.AddedPlayerToRoster)
+		require.True(t, ok)
+		assert.Equal(t, received.Eligibility, domain.MustRoleSet(domain.RolePitcher))
+
+		annotation, ok := f.spy.AppendManyCalls[0][0].Events[2].(domain.ReceivedInTrade)
+		require.True(t, ok)
+		assert.Equal(t, annotation.PlayerID, received.PlayerID)
+		assert.Equal(t, annotation.TradeID, domain.TradeID(1))
+
+		assert.False(t, f.onRoster(t, testkit.TeamA(), 1))
+		assert.True(t, f.onRoster(t, testkit.TeamA(), 2))
+		assert.True(t, f.onRoster(t, testkit.TeamB(), 1))
+		assert.False(t, f.onRoster(t, testkit.TeamB(), 2))
+	})
+
+	t.Run("running again at the same instant is a no-op", func(t *testing.T) {
+		f := newSchedulerFixture(t)
+		f.clock.Advance(24 * time.Hour)
+
+		require.NoError(t, f.scheduler.RunDue(t.Context()))
+		require.NoError(t, f.scheduler.RunDue(t.Context()))
+
+		assert.Equal(t, len(f.spy.AppendManyCalls), 1)
+	})
+
+	t.Run("vetoed trade never executes", func(t *testing.T) {
+		f := newSchedulerFixture(t)
+
+		veto := trade.NewCommissionerVetoHandler(f.trades, f.clock, tradeAccess(t))
+		require.NoError(t, veto.Handle(t.Context(), trade.NewCommissionerVetoCommand(1, testkit.Commissioner())))
+
+		f.clock.Advance(24 * time.Hour)
+		require.NoError(t, f.scheduler.RunDue(t.Context()))
+
+		assert.Equal(t, f.tradeStatus(t), domain.TradeVetoed)
+		assert.Equal(t, len(f.spy.AppendManyCalls), 0)
+	})
+
+	t.Run("trade the rosters can no longer absorb is recorded as failed", func(t *testing.T) {
+		f := newSchedulerFixture(t)
+
+		_, err := f.rosters.Append(t.Context(), testkit.TeamB(), []domain.RosterEvent{
+			domain.RemovedPlayerFromRoster{TeamID: testkit.TeamB(), PlayerID: 2, EffectiveAt: testkit.TodayLock()},
+		}, 1)
+		require.NoError(t, err)
+
+		f.clock.Advance(24 * time.Hour)
+		require.NoError(t, f.scheduler.RunDue(t.Context()))
+
+		assert.Equal(t, f.tradeStatus(t), domain.TradeFailed)
+		assert.Equal(t, len(f.spy.AppendManyCalls), 0)
+		assert.True(t, f.onRoster(t, testkit.TeamA(), 1))
+	})
+
+	t.Run("trade that strands a scheduled move is recorded as failed", func(t *testing.T) {
+		f := newSchedulerFixture(t)
+
+		_, err := f.rosters.Append(t.Context(), testkit.TeamA(), []domain.RosterEvent{
+			domain.ActivatedPlayerOnRoster{TeamID: testkit.TeamA(), PlayerID: 1, PlayerRole: domain.RoleHitter, EffectiveAt: testkit.TomorrowLock().Add(72 * time.Hour)},
+		}, 1)
+		require.NoError(t, err)
+
+		f.clock.Advance(24 * time.Hour)
+		require.NoError(t, f.scheduler.RunDue(t.Context()))
+
+		assert.Equal(t, f.tradeStatus(t), domain.TradeFailed)
+		assert.Equal(t, len(f.spy.AppendManyCalls), 0)
+	})
+
+	t.Run("trade in a league that has closed roster moves is recorded as failed", func(t *testing.T) {
+		f := newSchedulerFixture(t)
+		f.access.Leagues = testkit.NewLeagueStoreInPhase(domain.PhaseComplete)
+		closed := trade.NewReviewScheduler(f.trades, f.spy, f.lock, f.clock, f.access)
+
+		f.clock.Advance(24 * time.Hour)
+		require.NoError(t, closed.RunDue(t.Context()))
+
+		assert.Equal(t, f.tradeStatus(t), domain.TradeFailed)
+		assert.Equal(t, len(f.spy.AppendManyCalls), 0)
+	})
+
+	t.Run("roster version conflict leaves the trade under review for the next run", func(t *testing.T) {
+		f := newSchedulerFixture(t)
+		f.clock.Advance(24 * time.Hour)
+
+		conflicting := trade.NewReviewScheduler(f.trades, staleRosterStore{f.rosters}, f.lock, f.clock, f.access)
+
+		err := conflicting.RunDue(t.Context())
+		assert.ErrorIs(t, err, ports.ErrVersionConflict)
+		assert.Equal(t, f.tradeStatus(t), domain.TradeUnderReview)
+	})
+
+	t.Run("roster stream that cannot be replayed leaves the trade under review", func(t *testing.T) {
+		f := newSchedulerFixture(t)
+
+		_, err := f.rosters.Append(t.Context(), testkit.TeamB(), []domain.RosterEvent{
+			domain.InactivatedPlayerOnRoster{TeamID: testkit.TeamB(), PlayerID: 99, EffectiveAt: testkit.TodayLock()},
+		}, 1)
+		require.NoError(t, err)
+
+		f.clock.Advance(24 * time.Hour)
+		err = f.scheduler.RunDue(t.Context())
+
+		assert.ErrorIs(t, err, eventlog.ErrUnreplayableEvent)
+		assert.Equal(t, f.tradeStatus(t), domain.TradeUnderReview)
+		assert.Equal(t, len(f.spy.AppendManyCalls), 0)
+	})
+
+	t.Run("cancelled context stops the run before settling", func(t *testing.T) {
+		f := newSchedulerFixture(t)
+		f.clock.Advance(24 * time.Hour)
+
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+
+		err := f.scheduler.RunDue(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, f.tradeStatus(t), domain.TradeUnderReview)
+		assert.Equal(t, len(f.spy.AppendManyCalls), 0)
+	})
+}
+
+// cancellingTradeStore cancels the run's context when it is asked for due
+// trades, so Run stops after a single pass.
+type cancellingTradeStore struct {
+	*testkit.FakeTradeStore
+	cancel context.CancelFunc
+}
+
+func (s cancellingTradeStore) ListUnderReview(ctx context.Context) ([]domain.TradeID, error) {
+	s.cancel()
+
+	return s.FakeTradeStore.ListUnderReview(ctx)
+}
+
+func TestReviewScheduler_Run(t *testing.T) {
+	f := newSchedulerFixture(t)
+	ctx, cancel := context.WithCancel(t.Context())
+	scheduler := trade.NewReviewScheduler(cancellingTradeStore{FakeTradeStore: f.trades, cancel: cancel}, f.spy, f.lock, f.clock, f.access)
+
+	var reported []error
+	err := scheduler.Run(ctx, time.Hour, func(err error) { reported = append(reported, err) })
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, len(reported), 0)
+}
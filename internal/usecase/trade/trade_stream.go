@@ -0,0 +1,24 @@
+package trade
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+)
+
+// ProjectTrade replays committed trade events in sequence order.
+func ProjectTrade(id domain.TradeID, committed []eventlog.Recorded[domain.TradeEvent]) domain.TradeView {
+	sorted := slices.Clone(committed)
+	slices.SortFunc(sorted, func(a, b eventlog.Recorded[domain.TradeEvent]) int {
+		return cmp.Compare(a.Sequence, b.Sequence)
+	})
+
+	tv := domain.TradeView{TradeID: id}
+	for _, re := range sorted {
+		tv.Apply(re.Event)
+	}
+
+	return tv
+}
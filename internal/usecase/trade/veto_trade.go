@@ -0,0 +1,122 @@
+package trade
+
+import (
+	"context"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/usecase/roster"
+)
+
+// CastVetoVoteHandler records a league manager's vote to veto a trade under review.
+// The vote that reaches the trade's threshold also records the veto.
+//
+// Only a manager of the voting team may cast its vote, and the team must be in
+// the trade's league.
+type CastVetoVoteHandler struct {
+	Trades ports.TradeStore
+	Clock  ports.Clock
+	Access roster.Access
+}
+
+func (h CastVetoVoteHandler) Handle(ctx context.Context, cmd CastVetoVoteCommand) error {
+	committed, version, err := h.Trades.Load(ctx, cmd.TradeID)
+	if err != nil {
+		return err
+	}
+
+	view := ProjectTrade(cmd.TradeID, committed)
+
+	events, err := view.DecideCastVetoVote(cmd.Voter, h.Clock.Now())
+	if err != nil {
+		return err
+	}
+
+	err = authorizeTerms(ctx, h.Access, cmd.Actor, cmd.Voter, view.Terms.Proposer)
+	if err != nil {
+		return err
+	}
+
+	_, err = h.Trades.Append(ctx, cmd.TradeID, events, version)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewCastVetoVoteHandler(trades ports.TradeStore, clock ports.Clock, access roster.Access) CastVetoVoteHandler {
+	return CastVetoVoteHandler{
+		Trades: trades,
+		Clock:  clock,
+		Access: access,
+	}
+}
+
+type CastVetoVoteCommand struct {
+	TradeID domain.TradeID
+	Voter   domain.TeamID
+	Actor   domain.UserID
+}
+
+func NewCastVetoVoteCommand(tradeID domain.TradeID, voter domain.TeamID, actor domain.UserID) CastVetoVoteCommand {
+	return CastVetoVoteCommand{
+		TradeID: tradeID,
+		Voter:   voter,
+		Actor:   actor,
+	}
+}
+
+// CommissionerVetoHandler records a commissioner's veto of a trade under review.
+// Only a commissioner of the trade's league may veto it.
+type CommissionerVetoHandler struct {
+	Trades ports.TradeStore
+	Clock  ports.Clock
+	Access roster.Access
+}
+
+func (h CommissionerVetoHandler) Handle(ctx context.Context, cmd CommissionerVetoCommand) error {
+	committed, version, err := h.Trades.Load(ctx, cmd.TradeID)
+	if err != nil {
+		return err
+	}
+
+	view := ProjectTrade(cmd.TradeID, committed)
+
+	events, err := view.DecideCommissionerVeto(cmd.Actor, h.Clock.Now())
+	if err != nil {
+		return err
+	}
+
+	err = h.Access.AuthorizeCommissioner(ctx, cmd.Actor, view.Terms.Proposer)
+	if err != nil {
+		return err
+	}
+
+	_, err = h.Trades.Append(ctx, cmd.TradeID, events, version)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewCommissionerVetoHandler(trades ports.TradeStore, clock ports.Clock, access roster.Access) CommissionerVetoHandler {
+	return CommissionerVetoHandler{
+		Trades: trades,
+		Clock:  clock,
+		Access: access,
+	}
+}
+
+type CommissionerVetoCommand struct {
+	TradeID domain.TradeID
+	Actor   domain.UserID
+}
+
+func NewCommissionerVetoCommand(tradeID domain.TradeID, actor domain.UserID) CommissionerVetoCommand {
+	return CommissionerVetoCommand{
+		TradeID: tradeID,
+		Actor:   actor,
+	}
+}
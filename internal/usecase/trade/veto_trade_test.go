@@ -0,0 +1,80 @@
+package trade_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/trade"
+)
+
+func TestCastVetoVoteHandler_Handle(t *testing.T) {
+	t.Run("the vote that reaches the threshold vetoes the trade", func(t *testing.T) {
+		f := newSchedulerFixture(t)
+		handler := trade.NewCastVetoVoteHandler(f.trades, f.clock, tradeAccess(t))
+
+		require.NoError(t, handler.Handle(t.Context(), trade.NewCastVetoVoteCommand(1, testkit.TeamC(), managerC)))
+		assert.Equal(t, f.tradeStatus(t), domain.TradeUnderReview)
+
+		err := handler.Handle(t.Context(), trade.NewCastVetoVoteCommand(1, testkit.TeamC(), managerC))
+		assert.ErrorIs(t, err, domain.ErrAlreadyVotedOnTrade)
+
+		require.NoError(t, handler.Handle(t.Context(), trade.NewCastVetoVoteCommand(1, teamD, managerD)))
+		assert.Equal(t, f.tradeStatus(t), domain.TradeVetoed)
+	})
+
+	t.Run("only a manager of the voting team may cast its vote", func(t *testing.T) {
+		f := newSchedulerFixture(t)
+		handler := trade.NewCastVetoVoteHandler(f.trades, f.clock, tradeAccess(t))
+
+		err := handler.Handle(t.Context(), trade.NewCastVetoVoteCommand(1, testkit.TeamC(), managerD))
+		assert.ErrorIs(t, err, domain.ErrNotAuthorized)
+	})
+
+	t.Run("a team outside the trade's league cannot vote", func(t *testing.T) {
+		f := newSchedulerFixture(t)
+		access := tradeAccess(t)
+		const outsider = domain.UserID(55)
+		require.NoError(t, access.Members.Grant(t.Context(), domain.Membership{UserID: outsider, LeagueID: 2, TeamID: 555, Role: domain.MembershipManager}))
+		handler := trade.NewCastVetoVoteHandler(f.trades, f.clock, access)
+
+		err := handler.Handle(t.Context(), trade.NewCastVetoVoteCommand(1, 555, outsider))
+		assert.ErrorIs(t, err, domain.ErrTeamNotInLeague)
+
+		committed, _, err := f.trades.Load(t.Context(), 1)
+		require.NoError(t, err)
+		assert.Equal(t, len(trade.ProjectTrade(1, committed).VetoVoters), 0)
+	})
+}
+
+func TestCommissionerVetoHandler_Handle(t *testing.T) {
+	t.Run("a commissioner of the league vetoes the trade", func(t *testing.T) {
+		f := newSchedulerFixture(t)
+		handler := trade.NewCommissionerVetoHandler(f.trades, f.clock, tradeAccess(t))
+
+		require.NoError(t, handler.Handle(t.Context(), trade.NewCommissionerVetoCommand(1, testkit.Commissioner())))
+		assert.Equal(t, f.tradeStatus(t), domain.TradeVetoed)
+	})
+
+	t.Run("anyone else is not authorized", func(t *testing.T) {
+		f := newSchedulerFixture(t)
+		handler := trade.NewCommissionerVetoHandler(f.trades, f.clock, tradeAccess(t))
+
+		err := handler.Handle(t.Context(), trade.NewCommissionerVetoCommand(1, managerC))
+		assert.ErrorIs(t, err, domain.ErrNotAuthorized)
+		assert.Equal(t, f.tradeStatus(t), domain.TradeUnderReview)
+	})
+
+	t.Run("the review window must still be open", func(t *testing.T) {
+		f := newSchedulerFixture(t)
+		f.clock.Advance(25 * time.Hour)
+		handler := trade.NewCommissionerVetoHandler(f.trades, f.clock, tradeAccess(t))
+
+		err := handler.Handle(t.Context(), trade.NewCommissionerVetoCommand(1, testkit.Commissioner()))
+		assert.ErrorIs(t, err, domain.ErrTradeReviewClosed)
+		assert.Equal(t, f.tradeStatus(t), domain.TradeUnderReview)
+	})
+}
@@ -0,0 +1,235 @@
+package verify
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+)
+
+type IssueKind int
+
+const (
+	IssueUnreadable IssueKind = iota + 1
+	IssueDuplicateSequence
+	IssueSequenceGap
+	IssueVersionMismatch
+	IssueWrongTeam
+	IssueInvariantViolation
+)
+
+func (k IssueKind) String() string {
+	switch k {
+	case IssueUnreadable:
+		return "IssueUnreadable"
+	case IssueDuplicateSequence:
+		return "IssueDuplicateSequence"
+	case IssueSequenceGap:
+		return "IssueSequenceGap"
+	case IssueVersionMismatch:
+		return "IssueVersionMismatch"
+	case IssueWrongTeam:
+		return "IssueWrongTeam"
+	case IssueInvariantViolation:
+		return "IssueInvariantViolation"
+	default:
+		return fmt.Sprintf("IssueKind(%d)", int(k))
+	}
+}
+
+// Code returns the lowercase name used for the kind in reports.
+func (k IssueKind) Code() string {
+	switch k {
+	case IssueUnreadable:
+		return "unreadable"
+	case IssueDuplicateSequence:
+		return "duplicate_sequence"
+	case IssueSequenceGap:
+		return "sequence_gap"
+	case IssueVersionMismatch:
+		return "version_mismatch"
+	case IssueWrongTeam:
+		return "wrong_team"
+	case IssueInvariantViolation:
+		return "invariant_violation"
+	default:
+		return ""
+	}
+}
+
+// Issue is a problem found in one team's stream. Sequence is the event the
+// issue was found at, or zero when it concerns the stream as a whole.
+type Issue struct {
+	TeamID   domain.TeamID
+	Kind     IssueKind
+	Sequence eventlog.Sequence
+	Detail   string
+}
+
+// Report is the outcome of a Verifier run. Repaired lists the teams whose
+// version was reset.
+type Report struct {
+	Streams  int
+	Events   int
+	Issues   []Issue
+	Repaired []domain.TeamID
+}
+
+// Verifier scans every roster stream for problems that would otherwise only
+// surface as replay errors when the stream is projected: duplicate or missing
+// sequences, a recorded version that differs from the events, events filed
+// under the wrong team, and events that violate roster invariants on replay.
+type Verifier struct {
+	Streams ports.RosterStreams
+	Store   ports.RosterStore
+}
+
+// Run checks every stream. A stream that cannot be loaded is reported and the
+// scan moves on. With repair set, streams whose only problem is a version
+// mismatch have their version reset to their last sequence. Nothing else is
+// changed: duplicates, gaps and every other issue are only reported, for a
+// person to look at.
+func (v Verifier) Run(ctx context.Context, repair bool) (Report, error) {
+	streams, err := v.Streams.Streams(ctx)
+	if err != nil {
+		return Report{}, err
+	}
+
+	report := Report{Streams: len(streams)}
+	for _, s := range streams {
+		events, _, err := v.Store.Load(ctx, s.TeamID)
+		if ctx.Err() != nil {
+			return Report{}, ctx.Err()
+		}
+		if err != nil {
+			report.Issues = append(report.Issues, Issue{
+				TeamID: s.TeamID,
+				Kind:   IssueUnreadable,
+				Detail: err.Error(),
+			})
+			continue
+		}
+
+		report.Events += len(events)
+
+		issues := checkStream(s, events)
+		report.Issues = append(report.Issues, issues...)
+
+		if !repair || len(issues) != 1 || issues[0].Kind != IssueVersionMismatch {
+			continue
+		}
+
+		err = v.Streams.ResetVersion(ctx, s.TeamID, lastVersion(events))
+		if err != nil {
+			return Report{}, fmt.Errorf("resetting version for team %v: %w", s.TeamID, err)
+		}
+		report.Repaired = append(report.Repaired, s.TeamID)
+	}
+
+	return report, nil
+}
+
+// checkStream returns the stream's issues in the order they are checked:
+// sequencing, version, team and then replay. Only the first event at a
+// duplicated sequence is replayed, so a duplicate is not reported again as the
+// invariant it breaks.
+func checkStream(s ports.StreamVersion, events []eventlog.Recorded[domain.RosterEvent]) []Issue {
+	var issues []Issue
+	add := func(kind IssueKind, seq eventlog.Sequence, detail string) {
+		issues = append(issues, Issue{TeamID: s.TeamID, Kind: kind, Sequence: seq, Detail: detail})
+	}
+
+	sorted := slices.Clone(events)
+	slices.SortStableFunc(sorted, func(a, b eventlog.Recorded[domain.RosterEvent]) int {
+		return cmp.Compare(a.Sequence, b.Sequence)
+	})
+
+	unique := make([]eventlog.Recorded[domain.RosterEvent], 0, len(sorted))
+	var want eventlog.Sequence = 1
+	for i, re := range sorted {
+		if i > 0 && re.Sequence == sorted[i-1].Sequence {
+			add(IssueDuplicateSequence, re.Sequence, fmt.Sprintf("sequence %d is recorded more than once", re.Sequence))
+			continue
+		}
+
+		unique = append(unique, re)
+		if re.Sequence != want {
+			add(IssueSequenceGap, re.Sequence, fmt.Sprintf("expected sequence %d, found %d", want, re.Sequence))
+		}
+		want = re.Sequence + 1
+	}
+
+	last := lastVersion(sorted)
+	if s.Version != last {
+		add(IssueVersionMismatch, 0, fmt.Sprintf("stream version is %d, last sequence is %d", s.Version, last))
+	}
+
+	for _, re := range sorted {
+		if re.Event.Team() != s.TeamID {
+			add(IssueWrongTeam, re.Sequence, fmt.Sprintf("%T belongs to team %v", re.Event, re.Event.Team()))
+		}
+	}
+
+	seq, err := replay(s.TeamID, unique)
+	if err != nil {
+		add(IssueInvariantViolation, seq, err.Error())
+	}
+
+	return issues
+}
+
+// replay applies the team's events in sequence order to a view projected
+// through the latest of them, and then counts it. It stops at the first event
+// that breaks an invariant and returns its sequence with the error. Events for
+// other teams are skipped, having been reported already.
+func replay(team domain.TeamID, sorted []eventlog.Recorded[domain.RosterEvent]) (eventlog.Sequence, error) {
+	var through time.Time
+	for _, re := range sorted {
+		at := re.Event.OccurredAt()
+		if at.After(through) {
+			through = at
+		}
+	}
+
+	rv := domain.RosterView{TeamID: team, EffectiveThrough: through}
+	for _, re := range sorted {
+		if re.Event.Team() != team {
+			continue
+		}
+
+		err := rv.TryApplyRecorded(re)
+		if err != nil {
+			return re.Sequence, err
+		}
+	}
+
+	_, err := rv.TryCounts()
+	if err != nil {
+		return 0, err
+	}
+
+	return 0, nil
+}
+
+// lastVersion is the version a stream holding events should record: its
+// highest sequence.
+func lastVersion(events []eventlog.Recorded[domain.RosterEvent]) ports.Version {
+	var last eventlog.Sequence
+	for _, re := range events {
+		last = max(last, re.Sequence)
+	}
+
+	return ports.Version(last)
+}
+
+func NewVerifier(streams ports.RosterStreams, store ports.RosterStore) Verifier {
+	return Verifier{
+		Streams: streams,
+		Store:   store,
+	}
+}
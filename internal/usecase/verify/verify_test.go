@@ -0,0 +1,201 @@
+package verify_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/spcameron/dugout/internal/domain"
+	"github.com/spcameron/dugout/internal/eventlog"
+	"github.com/spcameron/dugout/internal/ports"
+	"github.com/spcameron/dugout/internal/testsupport/assert"
+	"github.com/spcameron/dugout/internal/testsupport/require"
+	"github.com/spcameron/dugout/internal/testsupport/testkit"
+	"github.com/spcameron/dugout/internal/usecase/verify"
+)
+
+var errUnreadable = errors.New("unreadable stream")
+
+// rawStreams holds streams exactly as given, so tests can describe corrupt ones
+// that no store would write.
+type rawStreams struct {
+	versions map[domain.TeamID]ports.Version
+	events   map[domain.TeamID][]eventlog.Recorded[domain.RosterEvent]
+	broken   map[domain.TeamID]bool
+	reset    map[domain.TeamID]ports.Version
+}
+
+func newRawStreams() *rawStreams {
+	return &rawStreams{
+		versions: make(map[domain.TeamID]ports.Version),
+		events:   make(map[domain.TeamID][]eventlog.Recorded[domain.RosterEvent]),
+		broken:   make(map[domain.TeamID]bool),
+		reset:    make(map[domain.TeamID]ports.Version),
+	}
+}
+
+func (s *rawStreams) put(team domain.TeamID, version ports.Version, events ...eventlog.Recorded[domain.RosterEvent]) {
+	s.versions[team] = version
+	s.events[team] = events
+}
+
+func (s *rawStreams) Streams(ctx context.Context) ([]ports.StreamVersion, error) {
+	var streams []ports.StreamVersion
+	for _, team := range []domain.TeamID{testkit.TeamA(), testkit.TeamB(), testkit.TeamC()} {
+		version, ok := s.versions[team]
+		if ok {
+			streams = append(streams, ports.StreamVersion{TeamID: team, Version: version})
+		}
+	}
+
+	return streams, nil
+}
+
+func (s *rawStreams) ResetVersion(ctx context.Context, team domain.TeamID, version ports.Version) error {
+	s.reset[team] = version
+
+	return nil
+}
+
+func (s *rawStreams) Load(ctx context.Context, team domain.TeamID) ([]eventlog.Recorded[domain.RosterEvent], ports.Version, error) {
+	if s.broken[team] {
+		return nil, 0, errUnreadable
+	}
+
+	return s.events[team], s.versions[team], nil
+}
+
+func (s *rawStreams) Append(ctx context.Context, team domain.TeamID, events []domain.RosterEvent, expected ports.Version) (ports.Version, error) {
+	panic("not used")
+}
+
+func (s *rawStreams) AppendMany(ctx context.Context, appends []ports.StreamAppend) ([]ports.Version, error) {
+	panic("not used")
+}
+
+func at(seq eventlog.Sequence, e domain.RosterEvent) eventlog.Recorded[domain.RosterEvent] {
+	return eventlog.Recorded[domain.RosterEvent]{Sequence: seq, Event: e}
+}
+
+func added(team domain.TeamID, player domain.PlayerID) domain.RosterEvent {
+	return domain.AddedPlayerToRoster{TeamID: team, PlayerID: player, Eligibility: domain.MustRoleSet(domain.RoleHitter), EffectiveAt: testkit.TodayLock()}
+}
+
+func activated(team domain.TeamID, player domain.PlayerID) domain.RosterEvent {
+	return domain.ActivatedPlayerOnRoster{TeamID: team, PlayerID: player, PlayerRole: domain.RoleHitter, EffectiveAt: testkit.TomorrowLock()}
+}
+
+func TestVerifier(t *testing.T) {
+	team := testkit.TeamA()
+
+	testCases := []struct {
+		name    string
+		version ports.Version
+		events  []eventlog.Recorded[domain.RosterEvent]
+		want    []verify.Issue
+	}{
+		{
+			name:    "a sound stream has no issues",
+			version: 2,
+			events:  []eventlog.Recorded[domain.RosterEvent]{at(1, added(team, 1)), at(2, activated(team, 1))},
+		},
+		{
+			name:    "reports a duplicated sequence",
+			version: 2,
+			events:  []eventlog.Recorded[domain.RosterEvent]{at(1, added(team, 1)), at(2, added(team, 2)), at(2, added(team, 3))},
+			want: []verify.Issue{
+				{TeamID: team, Kind: verify.IssueDuplicateSequence, Sequence: 2, Detail: "sequence 2 is recorded more than once"},
+			},
+		},
+		{
+			name:    "replays only the first event at a duplicated sequence",
+			version: 2,
+			events:  []eventlog.Recorded[domain.RosterEvent]{at(1, added(team, 1)), at(2, added(team, 2)), at(2, added(team, 2))},
+			want: []verify.Issue{
+				{TeamID: team, Kind: verify.IssueDuplicateSequence, Sequence: 2, Detail: "sequence 2 is recorded more than once"},
+			},
+		},
+		{
+			name:    "reports a gap",
+			version: 3,
+			events:  []eventlog.Recorded[domain.RosterEvent]{at(3, added(team, 2)), at(1, added(team, 1))},
+			want: []verify.Issue{
+				{TeamID: team, Kind: verify.IssueSequenceGap, Sequence: 3, Detail: "expected sequence 2, found 3"},
+			},
+		},
+		{
+			name:    "reports a version that differs from the last sequence",
+			version: 4,
+			events:  []eventlog.Recorded[domain.RosterEvent]{at(1, added(team, 1))},
+			want: []verify.Issue{
+				{TeamID: team, Kind: verify.IssueVersionMismatch, Detail: "stream version is 4, last sequence is 1"},
+			},
+		},
+		{
+			name:    "reports an event for another team and replays without it",
+			version: 2,
+			events:  []eventlog.Recorded[domain.RosterEvent]{at(1, added(team, 1)), at(2, added(testkit.TeamB(), 1))},
+			want: []verify.Issue{
+				{TeamID: team, Kind: verify.IssueWrongTeam, Sequence: 2, Detail: "domain.AddedPlayerToRoster belongs to team 222"},
+			},
+		},
+		{
+			name:    "reports the first event that breaks an invariant",
+			version: 3,
+			events:  []eventlog.Recorded[domain.RosterEvent]{at(1, added(team, 1)), at(2, activated(team, 2)), at(3, added(team, 1))},
+			want: []verify.Issue{
+				{TeamID: team, Kind: verify.IssueInvariantViolation, Sequence: 2, Detail: "player is not on the roster: player ID 2"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			streams := newRawStreams()
+			streams.put(team, tc.version, tc.events...)
+
+			report, err := verify.NewVerifier(streams, streams).Run(t.Context(), false)
+			require.NoError(t, err)
+
+			assert.Equal(t, report.Streams, 1)
+			assert.Equal(t, report.Events, len(tc.events))
+			assert.Equal(t, report.Issues, tc.want)
+			assert.Equal(t, len(streams.reset), 0)
+		})
+	}
+
+	t.Run("reports an unreadable stream and checks the rest", func(t *testing.T) {
+		streams := newRawStreams()
+		streams.put(testkit.TeamA(), 0)
+		streams.broken[testkit.TeamA()] = true
+		streams.put(testkit.TeamB(), 2, at(1, added(testkit.TeamB(), 1)))
+
+		report, err := verify.NewVerifier(streams, streams).Run(t.Context(), false)
+		require.NoError(t, err)
+
+		assert.Equal(t, report.Streams, 2)
+		assert.Equal(t, report.Issues, []verify.Issue{
+			{TeamID: testkit.TeamA(), Kind: verify.IssueUnreadable, Detail: errUnreadable.Error()},
+			{TeamID: testkit.TeamB(), Kind: verify.IssueVersionMismatch, Detail: "stream version is 2, last sequence is 1"},
+		})
+	})
+
+	t.Run("repair resets only versions that are the stream's sole issue", func(t *testing.T) {
+		streams := newRawStreams()
+		streams.put(testkit.TeamA(), 5, at(1, added(testkit.TeamA(), 1)), at(2, added(testkit.TeamA(), 2)))
+		streams.put(testkit.TeamB(), 5, at(1, added(testkit.TeamB(), 1)), at(3, added(testkit.TeamB(), 2)))
+
+		report, err := verify.NewVerifier(streams, streams).Run(t.Context(), true)
+		require.NoError(t, err)
+
+		assert.Equal(t, report.Repaired, []domain.TeamID{testkit.TeamA()})
+		assert.Equal(t, streams.reset, map[domain.TeamID]ports.Version{testkit.TeamA(): 2})
+		assert.Equal(t, len(report.Issues), 3)
+	})
+}
+
+func TestIssueKindCode(t *testing.T) {
+	assert.Equal(t, verify.IssueInvariantViolation.Code(), "invariant_violation")
+	assert.Equal(t, verify.IssueKind(0).Code(), "")
+	assert.Equal(t, verify.IssueKind(0).String(), "IssueKind(0)")
+}